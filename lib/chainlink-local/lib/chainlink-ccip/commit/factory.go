@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 
 	sel "github.com/smartcontractkit/chain-selectors"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/commit/internal/builder"
 	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn"
 	"github.com/smartcontractkit/chainlink-ccip/commit/metrics"
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal/reader"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
@@ -77,6 +79,10 @@ type PluginFactory struct {
 	chainWriters      map[cciptypes.ChainSelector]types.ContractWriter
 	rmnPeerClient     rmn.PeerClient
 	rmnCrypto         cciptypes.RMNCrypto
+	// typeAndVersionReaders optionally read typeAndVersion() of the discovered contracts.
+	typeAndVersionReaders map[cciptypes.ChainSelector]readerpkg.TypeAndVersionReader
+	// plugin is the plugin instance created by the factory, kept for its health report.
+	plugin *atomic.Value
 }
 
 type CommitPluginFactoryParams struct {
@@ -92,24 +98,29 @@ type CommitPluginFactoryParams struct {
 	ContractWriters   map[cciptypes.ChainSelector]types.ContractWriter
 	RmnPeerClient     rmn.PeerClient
 	RmnCrypto         cciptypes.RMNCrypto
+	// TypeAndVersionReaders are optional, on chains without one the discovered contracts are verified through the
+	// contract readers.
+	TypeAndVersionReaders map[cciptypes.ChainSelector]readerpkg.TypeAndVersionReader
 }
 
 // NewCommitPluginFactory creates a new PluginFactory instance. For commit plugin, oracle instances are not managed by
 // the factory. It is safe to assume that a factory instance will create exactly one plugin instance.
 func NewCommitPluginFactory(params CommitPluginFactoryParams) *PluginFactory {
 	return &PluginFactory{
-		baseLggr:          params.Lggr,
-		donID:             params.DonID,
-		ocrConfig:         params.OcrConfig,
-		commitCodec:       params.CommitCodec,
-		msgHasher:         params.MsgHasher,
-		addrCodec:         params.AddrCodec,
-		homeChainReader:   params.HomeChainReader,
-		homeChainSelector: params.HomeChainSelector,
-		contractReaders:   params.ContractReaders,
-		chainWriters:      params.ContractWriters,
-		rmnPeerClient:     params.RmnPeerClient,
-		rmnCrypto:         params.RmnCrypto,
+		baseLggr:              params.Lggr,
+		donID:                 params.DonID,
+		ocrConfig:             params.OcrConfig,
+		commitCodec:           params.CommitCodec,
+		msgHasher:             params.MsgHasher,
+		addrCodec:             params.AddrCodec,
+		homeChainReader:       params.HomeChainReader,
+		homeChainSelector:     params.HomeChainSelector,
+		contractReaders:       params.ContractReaders,
+		chainWriters:          params.ContractWriters,
+		rmnPeerClient:         params.RmnPeerClient,
+		rmnCrypto:             params.RmnCrypto,
+		typeAndVersionReaders: params.TypeAndVersionReaders,
+		plugin:                &atomic.Value{},
 	}
 }
 
@@ -185,6 +196,7 @@ func (p *PluginFactory) NewReportingPlugin(ctx context.Context, config ocr3types
 		p.ocrConfig.Config.ChainSelector,
 		p.ocrConfig.Config.OfframpAddress,
 		p.addrCodec,
+		readerpkg.WithTypeAndVersionReaders(p.typeAndVersionReaders),
 	)
 
 	// The node supports the chain that the token prices are on.
@@ -226,34 +238,39 @@ func (p *PluginFactory) NewReportingPlugin(ctx context.Context, config ocr3types
 		return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to create report builder: %w", err)
 	}
 
-	return NewPlugin(
-			p.donID,
-			oracleIDToP2PID,
-			offchainConfig,
-			p.ocrConfig.Config.ChainSelector,
-			ccipReader,
-			onChainTokenPricesReader,
-			p.commitCodec,
-			p.msgHasher,
-			lggr,
-			p.homeChainReader,
-			rmnHomeReader,
-			p.rmnCrypto,
-			p.rmnPeerClient,
-			config,
-			metricsReporter,
-			p.addrCodec,
-			reportBuilder,
-		), ocr3types.ReportingPluginInfo{
-			Name: "CCIPRoleCommit",
-			Limits: ocr3types.ReportingPluginLimits{
-				MaxQueryLength:       maxQueryLength,
-				MaxObservationLength: maxObservationLength,
-				MaxOutcomeLength:     maxOutcomeLength,
-				MaxReportLength:      maxReportLength,
-				MaxReportCount:       maxReportCount,
-			},
-		}, nil
+	plugin := NewPlugin(
+		p.donID,
+		oracleIDToP2PID,
+		offchainConfig,
+		p.ocrConfig.Config.ChainSelector,
+		ccipReader,
+		onChainTokenPricesReader,
+		p.commitCodec,
+		p.msgHasher,
+		lggr,
+		p.homeChainReader,
+		rmnHomeReader,
+		p.rmnCrypto,
+		p.rmnPeerClient,
+		config,
+		metricsReporter,
+		p.addrCodec,
+		reportBuilder,
+	)
+	if p.plugin != nil {
+		p.plugin.Store(plugin)
+	}
+
+	return plugin, ocr3types.ReportingPluginInfo{
+		Name: "CCIPRoleCommit",
+		Limits: ocr3types.ReportingPluginLimits{
+			MaxQueryLength:       maxQueryLength,
+			MaxObservationLength: maxObservationLength,
+			MaxOutcomeLength:     maxOutcomeLength,
+			MaxReportLength:      maxReportLength,
+			MaxReportCount:       maxReportCount,
+		},
+	}, nil
 }
 
 func validateOcrConfig(cfg readerpkg.OCR3Config) error {
@@ -284,8 +301,15 @@ func (p PluginFactory) Ready() error {
 	panic("should not be called")
 }
 
+// HealthReport reports the health of the plugin created by the factory, if any.
 func (p PluginFactory) HealthReport() map[string]error {
-	panic("should not be called")
+	if p.plugin == nil {
+		return map[string]error{}
+	}
+	if hr, ok := p.plugin.Load().(plugincommon.HealthReporter); ok {
+		return hr.HealthReport()
+	}
+	return map[string]error{}
 }
 
 // Interface compatibility checks.
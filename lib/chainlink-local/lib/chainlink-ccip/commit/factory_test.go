@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -319,12 +320,16 @@ func TestPluginFactory_NewReportingPlugin(t *testing.T) {
 				},
 			},
 			addrCodec: mockAddrCodec,
+			plugin:    &atomic.Value{},
 		}
+		require.Empty(t, p.HealthReport())
 
 		plugin, pluginInfo, err := p.NewReportingPlugin(ctx, ocr3types.ReportingPluginConfig{
 			OffchainConfig: b,
 		})
 		require.NoError(t, err)
+		require.Equal(t, plugin, p.plugin.Load())
+		require.Empty(t, p.HealthReport())
 
 		pluginCommit, is := plugin.(*Plugin)
 		require.True(t, is)
@@ -1,6 +1,9 @@
 package merkleroot
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
 	"testing"
 	"time"
@@ -8,13 +11,21 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	chainsel "github.com/smartcontractkit/chain-selectors"
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
 	rmnpb "github.com/smartcontractkit/chainlink-protos/rmn/v1.6/go/serialization"
 
 	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn"
+	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn/standin"
+	rmntypes "github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn/types"
+	"github.com/smartcontractkit/chainlink-ccip/internal"
 	"github.com/smartcontractkit/chainlink-ccip/internal/libs/testhelpers"
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
 	rmnmocks "github.com/smartcontractkit/chainlink-ccip/mocks/commit/merkleroot/rmn"
 	"github.com/smartcontractkit/chainlink-ccip/mocks/pkg/reader"
@@ -327,3 +338,172 @@ func TestProcessor_Query(t *testing.T) {
 		})
 	}
 }
+
+// TestProcessor_RMNStandIn runs the RMN-enabled commit flow, from the query to the outcome,
+// against a real RMN controller talking to stand-in RMN nodes.
+func TestProcessor_RMNStandIn(t *testing.T) {
+	srcChain1 := ccipocr3.ChainSelector(chainsel.TEST_90000002.Selector)
+	srcChain2 := ccipocr3.ChainSelector(chainsel.TEST_90000003.Selector)
+	dstChain := ccipocr3.ChainSelector(chainsel.TEST_90000004.Selector)
+
+	onRamps := map[ccipocr3.ChainSelector][]byte{
+		srcChain1: bytes.Repeat([]byte{1}, 20),
+		srcChain2: bytes.Repeat([]byte{2}, 20),
+	}
+	offRamp := bytes.Repeat([]byte{3}, 20)
+	signObservationPrefix := "chainlink ccip 1.6 rmn observation"
+
+	roots := func(laneSource *rmnpb.LaneSource, interval *rmnpb.ClosedInterval) (ccipocr3.Bytes32, error) {
+		b := make([]byte, 24)
+		binary.BigEndian.PutUint64(b[0:8], laneSource.SourceChainSelector)
+		binary.BigEndian.PutUint64(b[8:16], interval.MinMsgNr)
+		binary.BigEndian.PutUint64(b[16:24], interval.MaxMsgNr)
+		return sha256.Sum256(b), nil
+	}
+
+	// sorted by chain selector, like the roots of the outcome
+	ranges := []plugintypes.ChainRange{
+		{ChainSel: srcChain2, SeqNumRange: ccipocr3.NewSeqNumRange(50, 51)},
+		{ChainSel: srcChain1, SeqNumRange: ccipocr3.NewSeqNumRange(10, 20)},
+	}
+
+	testCases := []struct {
+		name      string
+		behaviors []standin.Behavior
+	}{
+		{
+			name:      "honest nodes",
+			behaviors: make([]standin.Behavior, 3),
+		},
+		{
+			name: "faulty minority",
+			behaviors: []standin.Behavior{
+				{WrongRoots: true},
+				{WithholdObservations: true, WithholdSignatures: true},
+				{Lag: 50 * time.Millisecond},
+				{},
+				{},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := tests.Context(t)
+			lggr := logger.Test(t)
+
+			rmnRemoteCfg := ccipocr3.RemoteConfig{
+				ContractAddress:  bytes.Repeat([]byte{4}, 20),
+				ConfigDigest:     ccipocr3.Bytes32{0x1, 0x2, 0x3},
+				FSign:            1,
+				ConfigVersion:    1,
+				RmnReportVersion: ccipocr3.Bytes32{0xa},
+			}
+
+			nodes := make([]*standin.Node, len(tc.behaviors))
+			homeNodes := make([]rmntypes.HomeNodeInfo, len(tc.behaviors))
+			for i, b := range tc.behaviors {
+				n, err := standin.NewRandomNode(lggr, standin.Config{
+					NodeID:                rmntypes.NodeID(i + 1),
+					SignObservationPrefix: signObservationPrefix,
+					RMNHomeConfigDigest:   rmnRemoteCfg.ConfigDigest,
+					ReportVersionDigest:   rmnRemoteCfg.RmnReportVersion,
+					Roots:                 roots,
+					Behavior:              b,
+				})
+				require.NoError(t, err)
+				nodes[i] = n
+				homeNodes[i] = n.HomeNodeInfo(srcChain1, srcChain2)
+				rmnRemoteCfg.Signers = append(rmnRemoteCfg.Signers, n.SignerInfo())
+			}
+
+			ccipReader := reader.NewMockCCIPReader(t)
+			ccipReader.EXPECT().GetContractAddress(consts.ContractNameOffRamp, dstChain).Return(offRamp, nil)
+			for chainSel, onRamp := range onRamps {
+				ccipReader.EXPECT().GetContractAddress(consts.ContractNameOnRamp, chainSel).Return(onRamp, nil)
+			}
+
+			rmnHomeReader := reader.NewMockRMNHome(t)
+			rmnHomeReader.EXPECT().GetRMNNodesInfo(rmnRemoteCfg.ConfigDigest).Return(homeNodes, nil)
+			rmnHomeReader.EXPECT().GetFObserve(rmnRemoteCfg.ConfigDigest).Return(
+				map[ccipocr3.ChainSelector]int{srcChain1: 1, srcChain2: 1}, nil)
+			rmnHomeReader.EXPECT().GetRMNEnabledSourceChains(rmnRemoteCfg.ConfigDigest).Return(
+				map[ccipocr3.ChainSelector]bool{srcChain1: true, srcChain2: true}, nil)
+
+			rmnCrypto := standin.NewCrypto(standin.EVMReportHasher{})
+			controller := rmn.NewController(
+				lggr,
+				rmnCrypto,
+				signObservationPrefix,
+				standin.NewPeerClient(lggr, nodes...),
+				rmnHomeReader,
+				10*time.Millisecond,
+				10*time.Millisecond,
+				rmn.NoopMetrics{},
+			)
+			t.Cleanup(func() { require.NoError(t, controller.Close()) })
+
+			p := Processor{
+				offchainCfg: pluginconfig.CommitOffchainConfig{
+					RMNEnabled:           true,
+					RMNSignaturesTimeout: tests.WaitTimeout(t),
+				},
+				destChain:       dstChain,
+				ccipReader:      ccipReader,
+				reportingCfg:    ocr3types.ReportingPluginConfig{F: 1},
+				rmnController:   controller,
+				rmnCrypto:       rmnCrypto,
+				rmnHomeReader:   rmnHomeReader,
+				lggr:            lggr,
+				metricsReporter: NoopMetrics{},
+				addressCodec:    internal.NewMockAddressCodecHex(t),
+			}
+
+			prevOutcome := Outcome{
+				OutcomeType:             ReportIntervalsSelected,
+				RangesSelectedForReport: ranges,
+				RMNRemoteCfg:            rmnRemoteCfg,
+			}
+
+			q, err := p.Query(ctx, prevOutcome)
+			require.NoError(t, err)
+			require.False(t, q.RetryRMNSignatures)
+			require.True(t, q.ContainsRmnSignatures())
+			require.NoError(t, p.verifyQuery(ctx, prevOutcome, q))
+
+			obs := Observation{
+				RMNEnabledChains: map[ccipocr3.ChainSelector]bool{srcChain1: true, srcChain2: true},
+				FChain:           map[ccipocr3.ChainSelector]int{srcChain1: 1, srcChain2: 1, dstChain: 1},
+			}
+			expRoots := make([]ccipocr3.MerkleRootChain, 0, len(ranges))
+			for _, r := range ranges {
+				root, err := roots(&rmnpb.LaneSource{SourceChainSelector: uint64(r.ChainSel)}, &rmnpb.ClosedInterval{
+					MinMsgNr: uint64(r.SeqNumRange.Start()),
+					MaxMsgNr: uint64(r.SeqNumRange.End()),
+				})
+				require.NoError(t, err)
+				expRoots = append(expRoots, ccipocr3.MerkleRootChain{
+					ChainSel:      r.ChainSel,
+					OnRampAddress: onRamps[r.ChainSel],
+					SeqNumsRange:  r.SeqNumRange,
+					MerkleRoot:    root,
+				})
+			}
+			obs.MerkleRoots = expRoots
+
+			aos := make([]plugincommon.AttributedObservation[Observation], 0, 3)
+			for i := range 3 {
+				aos = append(aos, plugincommon.AttributedObservation[Observation]{
+					OracleID:    commontypes.OracleID(i),
+					Observation: obs,
+				})
+			}
+
+			outcome, err := p.Outcome(ctx, prevOutcome, q, aos)
+			require.NoError(t, err)
+			require.Equal(t, ReportGenerated, outcome.OutcomeType)
+			require.Equal(t, expRoots, outcome.RootsToReport)
+			require.Len(t, outcome.RMNReportSignatures, int(rmnRemoteCfg.FSign)+1)
+		})
+	}
+}
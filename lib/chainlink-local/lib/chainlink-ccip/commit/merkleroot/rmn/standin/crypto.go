@@ -0,0 +1,196 @@
+// crypto.go contains the report hashing and signature verification used by the stand-in RMN nodes.
+
+package standin
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// ReportHasher computes the digest of an RMN report that is signed by the RMN nodes.
+type ReportHasher interface {
+	Hash(report cciptypes.RMNReport) ([32]byte, error)
+}
+
+// EVMReportHasher hashes the report the same way the EVM RMNRemote contract does,
+// i.e. keccak256(abi.encode(reportVersionDigest, report)).
+type EVMReportHasher struct{}
+
+type evmRMNLaneUpdate struct {
+	SourceChainSelector uint64
+	OnRampAddress       []byte
+	MinSeqNr            uint64
+	MaxSeqNr            uint64
+	MerkleRoot          [32]byte
+}
+
+type evmRMNReport struct {
+	DestChainId                 *big.Int //nolint:revive // must match the abi field name
+	DestChainSelector           uint64
+	RmnRemoteContractAddress    common.Address
+	OfframpAddress              common.Address
+	RmnHomeContractConfigDigest [32]byte
+	MerkleRoots                 []evmRMNLaneUpdate
+}
+
+var rmnReportArguments = abi.Arguments{
+	{Name: "rmnReportVersion", Type: mustNewABIType("bytes32", nil)},
+	{Name: "report", Type: mustNewABIType("tuple", []abi.ArgumentMarshaling{
+		{Name: "destChainId", Type: "uint256"},
+		{Name: "destChainSelector", Type: "uint64"},
+		{Name: "rmnRemoteContractAddress", Type: "address"},
+		{Name: "offrampAddress", Type: "address"},
+		{Name: "rmnHomeContractConfigDigest", Type: "bytes32"},
+		{Name: "merkleRoots", Type: "tuple[]", Components: []abi.ArgumentMarshaling{
+			{Name: "sourceChainSelector", Type: "uint64"},
+			{Name: "onRampAddress", Type: "bytes"},
+			{Name: "minSeqNr", Type: "uint64"},
+			{Name: "maxSeqNr", Type: "uint64"},
+			{Name: "merkleRoot", Type: "bytes32"},
+		}},
+	})},
+}
+
+func mustNewABIType(t string, components []abi.ArgumentMarshaling) abi.Type {
+	typ, err := abi.NewType(t, "", components)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
+
+func (EVMReportHasher) Hash(report cciptypes.RMNReport) ([32]byte, error) {
+	destChainID := big.NewInt(0)
+	if report.DestChainID.Int != nil {
+		destChainID = report.DestChainID.Int
+	}
+
+	laneUpdates := make([]evmRMNLaneUpdate, 0, len(report.LaneUpdates))
+	for _, lu := range report.LaneUpdates {
+		laneUpdates = append(laneUpdates, evmRMNLaneUpdate{
+			SourceChainSelector: uint64(lu.SourceChainSelector),
+			OnRampAddress:       lu.OnRampAddress,
+			MinSeqNr:            uint64(lu.MinSeqNr),
+			MaxSeqNr:            uint64(lu.MaxSeqNr),
+			MerkleRoot:          lu.MerkleRoot,
+		})
+	}
+
+	encoded, err := rmnReportArguments.Pack(
+		[32]byte(report.ReportVersionDigest),
+		evmRMNReport{
+			DestChainId:                 destChainID,
+			DestChainSelector:           uint64(report.DestChainSelector),
+			RmnRemoteContractAddress:    common.BytesToAddress(report.RmnRemoteContractAddress),
+			OfframpAddress:              common.BytesToAddress(report.OfframpAddress),
+			RmnHomeContractConfigDigest: report.RmnHomeContractConfigDigest,
+			MerkleRoots:                 laneUpdates,
+		},
+	)
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("abi encode rmn report: %w", err)
+	}
+
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// signReport signs the report digest with the provided ECDSA key and returns the R and S values.
+func signReport(hasher ReportHasher, key *ecdsa.PrivateKey, report cciptypes.RMNReport) ([]byte, []byte, error) {
+	digest, err := hasher.Hash(report)
+	if err != nil {
+		return nil, nil, fmt.Errorf("hash report: %w", err)
+	}
+
+	sig, err := crypto.Sign(digest[:], key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("sign report: %w", err)
+	}
+
+	return sig[:32], sig[32:64], nil
+}
+
+// Crypto is a cciptypes.RMNCrypto that verifies the report signatures produced by the stand-in nodes.
+// Since RMN signatures do not carry the recovery id, both possible values are tried.
+type Crypto struct {
+	hasher ReportHasher
+}
+
+var _ cciptypes.RMNCrypto = Crypto{}
+
+// NewCrypto creates a new Crypto that verifies signatures over digests computed by the provided hasher.
+func NewCrypto(hasher ReportHasher) Crypto {
+	return Crypto{hasher: hasher}
+}
+
+func (c Crypto) VerifyReportSignatures(
+	_ context.Context,
+	sigs []cciptypes.RMNECDSASignature,
+	report cciptypes.RMNReport,
+	signerAddresses []cciptypes.UnknownAddress,
+) error {
+	if len(sigs) == 0 {
+		return errors.New("no signatures provided")
+	}
+
+	digest, err := c.hasher.Hash(report)
+	if err != nil {
+		return fmt.Errorf("hash report: %w", err)
+	}
+
+	// Every signature must come from a distinct signer of the provided set.
+	usedSigners := make(map[common.Address]struct{}, len(sigs))
+	for i, sig := range sigs {
+		signer, ok := signerOf(digest, sig, signerAddresses, usedSigners)
+		if !ok {
+			return fmt.Errorf("signature %d does not match any of the remaining signers", i)
+		}
+		usedSigners[signer] = struct{}{}
+	}
+
+	return nil
+}
+
+func signerOf(
+	digest [32]byte,
+	sig cciptypes.RMNECDSASignature,
+	signerAddresses []cciptypes.UnknownAddress,
+	usedSigners map[common.Address]struct{},
+) (common.Address, bool) {
+	for _, signerAddr := range signerAddresses {
+		addr := common.BytesToAddress(signerAddr)
+		if _, used := usedSigners[addr]; used {
+			continue
+		}
+		if signedBy(digest, sig, addr) {
+			return addr, true
+		}
+	}
+	return common.Address{}, false
+}
+
+func signedBy(digest [32]byte, sig cciptypes.RMNECDSASignature, addr common.Address) bool {
+	for _, v := range []byte{0, 1} {
+		rawSig := make([]byte, 0, 65)
+		rawSig = append(rawSig, sig.R[:]...)
+		rawSig = append(rawSig, sig.S[:]...)
+		rawSig = append(rawSig, v)
+
+		pubKey, err := crypto.SigToPub(digest[:], rawSig)
+		if err != nil {
+			continue
+		}
+		if crypto.PubkeyToAddress(*pubKey) == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,384 @@
+// Package standin provides an in-process stand-in for RMN nodes.
+// The stand-in implements the rmnpb request/response protocol and can be plugged into the RMN controller
+// through the PeerClient, so RMN-enabled commit flows can be tested end-to-end without the real RMN.
+package standin
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	rmnpb "github.com/smartcontractkit/chainlink-protos/rmn/v1.6/go/serialization"
+
+	rmntypes "github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn/types"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// RootSource returns the merkle root that an honest RMN node observes for the given lane and interval.
+type RootSource func(laneSource *rmnpb.LaneSource, interval *rmnpb.ClosedInterval) (cciptypes.Bytes32, error)
+
+// Behavior controls how a stand-in node deviates from an honest RMN node.
+type Behavior struct {
+	// Lag delays every response by the given duration.
+	Lag time.Duration
+	// WithholdObservations makes the node ignore observation requests.
+	WithholdObservations bool
+	// WithholdSignatures makes the node ignore report signature requests.
+	WithholdSignatures bool
+	// WrongRoots makes the node observe corrupted roots and sign reports with corrupted roots.
+	WrongRoots bool
+}
+
+// Config contains the configuration of a stand-in node.
+type Config struct {
+	// NodeID is the index of the node in both the RMNHome and the RMNRemote configs.
+	NodeID rmntypes.NodeID
+	// SignObservationPrefix is the prefix used to sign observations, e.g. "chainlink ccip 1.6 rmn observation".
+	SignObservationPrefix string
+	// RMNHomeConfigDigest is the RMNHome config digest included in the observations.
+	RMNHomeConfigDigest cciptypes.Bytes32
+	// ReportVersionDigest is the report version digest included in the signed reports.
+	ReportVersionDigest cciptypes.Bytes32
+	// Roots provides the roots that are observed by the node.
+	Roots RootSource
+	// ReportHasher computes the digest of the signed reports, defaults to EVMReportHasher.
+	ReportHasher ReportHasher
+	// Behavior is the initial behavior of the node, it can be changed later using SetBehavior.
+	Behavior Behavior
+}
+
+// Node is a stand-in RMN node. It signs observations with an ed25519 key and reports with an ECDSA key.
+type Node struct {
+	lggr        logger.Logger
+	cfg         Config
+	offchainKey ed25519.PrivateKey
+	onchainKey  *ecdsa.PrivateKey
+
+	behavior Behavior
+	mu       *sync.RWMutex
+}
+
+// NewNode creates a new stand-in node with the provided keys.
+func NewNode(
+	lggr logger.Logger,
+	cfg Config,
+	offchainKey ed25519.PrivateKey,
+	onchainKey *ecdsa.PrivateKey,
+) (*Node, error) {
+	if cfg.Roots == nil {
+		return nil, errors.New("root source is required")
+	}
+	if len(offchainKey) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("invalid offchain key size %d", len(offchainKey))
+	}
+	if onchainKey == nil {
+		return nil, errors.New("onchain key is required")
+	}
+	if cfg.ReportHasher == nil {
+		cfg.ReportHasher = EVMReportHasher{}
+	}
+
+	return &Node{
+		lggr:        logger.With(lggr, "rmnStandInNodeID", cfg.NodeID),
+		cfg:         cfg,
+		offchainKey: offchainKey,
+		onchainKey:  onchainKey,
+		behavior:    cfg.Behavior,
+		mu:          &sync.RWMutex{},
+	}, nil
+}
+
+// NewRandomNode creates a new stand-in node with freshly generated keys.
+func NewRandomNode(lggr logger.Logger, cfg Config) (*Node, error) {
+	_, offchainKey, err := ed25519.GenerateKey(crand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generate offchain key: %w", err)
+	}
+
+	onchainKey, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, fmt.Errorf("generate onchain key: %w", err)
+	}
+
+	return NewNode(lggr, cfg, offchainKey, onchainKey)
+}
+
+// ID returns the node id.
+func (n *Node) ID() rmntypes.NodeID {
+	return n.cfg.NodeID
+}
+
+// HomeNodeInfo returns the RMNHome node info of the node, as it would be read from the RMNHome contract.
+func (n *Node) HomeNodeInfo(supportedSourceChains ...cciptypes.ChainSelector) rmntypes.HomeNodeInfo {
+	pubKey, ok := n.offchainKey.Public().(ed25519.PublicKey)
+	if !ok {
+		panic("ed25519 private key returned a non-ed25519 public key")
+	}
+
+	return rmntypes.HomeNodeInfo{
+		ID:                    n.cfg.NodeID,
+		PeerID:                sha256.Sum256(pubKey),
+		SupportedSourceChains: mapset.NewSet(supportedSourceChains...),
+		OffchainPublicKey:     &pubKey,
+	}
+}
+
+// SignerInfo returns the RMNRemote signer info of the node, as it would be read from the RMNRemote contract.
+func (n *Node) SignerInfo() cciptypes.RemoteSignerInfo {
+	return cciptypes.RemoteSignerInfo{
+		OnchainPublicKey: crypto.PubkeyToAddress(n.onchainKey.PublicKey).Bytes(),
+		NodeIndex:        uint64(n.cfg.NodeID),
+	}
+}
+
+// SetBehavior changes the behavior of the node, it applies to the requests that are handled afterwards.
+func (n *Node) SetBehavior(b Behavior) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.behavior = b
+}
+
+func (n *Node) getBehavior() Behavior {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.behavior
+}
+
+// HandleRequest handles a serialized rmnpb.Request and returns the serialized rmnpb.Response.
+// A nil response without an error means that the node chose not to respond.
+func (n *Node) HandleRequest(reqBytes []byte) ([]byte, error) {
+	return n.handleRequest(reqBytes, n.getBehavior())
+}
+
+// handleRequest handles the request with the behavior the node had when the request was received.
+func (n *Node) handleRequest(reqBytes []byte, behavior Behavior) ([]byte, error) {
+	req := &rmnpb.Request{}
+	if err := proto.Unmarshal(reqBytes, req); err != nil {
+		return nil, fmt.Errorf("proto unmarshal request: %w", err)
+	}
+
+	var resp *rmnpb.Response
+	var err error
+	switch r := req.Request.(type) {
+	case *rmnpb.Request_ObservationRequest:
+		if behavior.WithholdObservations {
+			n.lggr.Debugw("withholding observation", "requestID", req.RequestId)
+			return nil, nil
+		}
+		resp, err = n.observe(req.RequestId, r.ObservationRequest, behavior)
+	case *rmnpb.Request_ReportSignatureRequest:
+		if behavior.WithholdSignatures {
+			n.lggr.Debugw("withholding report signature", "requestID", req.RequestId)
+			return nil, nil
+		}
+		resp, err = n.signReport(req.RequestId, r.ReportSignatureRequest, behavior)
+	default:
+		return nil, fmt.Errorf("unexpected request type %T", req.Request)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, nil
+	}
+
+	respBytes, err := proto.Marshal(resp)
+	if err != nil {
+		return nil, fmt.Errorf("proto marshal response: %w", err)
+	}
+	return respBytes, nil
+}
+
+func (n *Node) observe(
+	requestID uint64,
+	obsReq *rmnpb.ObservationRequest,
+	behavior Behavior,
+) (*rmnpb.Response, error) {
+	laneUpdates := make([]*rmnpb.FixedDestLaneUpdate, 0, len(obsReq.FixedDestLaneUpdateRequests))
+	for _, lur := range obsReq.FixedDestLaneUpdateRequests {
+		root, err := n.root(lur.LaneSource, lur.ClosedInterval, behavior)
+		if err != nil {
+			return nil, fmt.Errorf("get root for source chain %d: %w", lur.LaneSource.SourceChainSelector, err)
+		}
+
+		laneUpdates = append(laneUpdates, &rmnpb.FixedDestLaneUpdate{
+			LaneSource:     lur.LaneSource,
+			ClosedInterval: lur.ClosedInterval,
+			Root:           root[:],
+		})
+	}
+
+	obs := &rmnpb.Observation{
+		RmnHomeContractConfigDigest: n.cfg.RMNHomeConfigDigest[:],
+		LaneDest:                    obsReq.LaneDest,
+		FixedDestLaneUpdates:        laneUpdates,
+		Timestamp:                   uint64(time.Now().UnixMilli()),
+	}
+
+	sig, err := n.signObservation(obs)
+	if err != nil {
+		return nil, fmt.Errorf("sign observation: %w", err)
+	}
+
+	return &rmnpb.Response{
+		RequestId: requestID,
+		Response: &rmnpb.Response_SignedObservation{
+			SignedObservation: &rmnpb.SignedObservation{
+				Observation: obs,
+				Signature:   sig,
+			},
+		},
+	}, nil
+}
+
+// signObservation signs the observation the way it is verified by the RMN controller, i.e.
+// ed25519.sign(sha256(signObservationPrefix|sha256(observation))).
+func (n *Node) signObservation(obs *rmnpb.Observation) ([]byte, error) {
+	observationBytes, err := proto.Marshal(obs)
+	if err != nil {
+		return nil, fmt.Errorf("proto marshal observation: %w", err)
+	}
+
+	observationBytesSha256 := sha256.Sum256(observationBytes)
+	msg := append([]byte(n.cfg.SignObservationPrefix), observationBytesSha256[:]...)
+	msgSha256 := sha256.Sum256(msg)
+
+	return ed25519.Sign(n.offchainKey, msgSha256[:]), nil
+}
+
+func (n *Node) signReport(
+	requestID uint64,
+	sigReq *rmnpb.ReportSignatureRequest,
+	behavior Behavior,
+) (*rmnpb.Response, error) {
+	if sigReq.Context == nil || sigReq.Context.LaneDest == nil {
+		return nil, errors.New("report signature request without context")
+	}
+
+	laneUpdates, err := n.laneUpdatesFromObservations(sigReq.AttributedSignedObservations, behavior)
+	if err != nil {
+		// An honest node does not sign roots that it disagrees with.
+		n.lggr.Warnw("not signing report", "requestID", requestID, "err", err)
+		return nil, nil
+	}
+
+	var configDigest cciptypes.Bytes32
+	copy(configDigest[:], sigReq.Context.RmnHomeContractConfigDigest)
+
+	report := cciptypes.NewRMNReport(
+		n.cfg.ReportVersionDigest,
+		cciptypes.NewBigIntFromInt64(int64(sigReq.Context.EvmDestChainId)),
+		cciptypes.ChainSelector(sigReq.Context.LaneDest.DestChainSelector),
+		sigReq.Context.RmnRemoteContractAddress,
+		sigReq.Context.LaneDest.OfframpAddress,
+		configDigest,
+		laneUpdates,
+	)
+
+	r, s, err := signReport(n.cfg.ReportHasher, n.onchainKey, report)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rmnpb.Response{
+		RequestId: requestID,
+		Response: &rmnpb.Response_ReportSignature{
+			ReportSignature: &rmnpb.ReportSignature{
+				Signature: &rmnpb.EcdsaSignature{R: r, S: s},
+			},
+		},
+	}, nil
+}
+
+// laneUpdatesFromObservations reconstructs the lane updates of the report from the attributed observations.
+// For each source chain the most voted root is selected and checked against the root observed by the node.
+func (n *Node) laneUpdatesFromObservations(
+	attributedObservations []*rmnpb.AttributedSignedObservation,
+	behavior Behavior,
+) ([]cciptypes.RMNLaneUpdate, error) {
+	type rootVotes struct {
+		update *rmnpb.FixedDestLaneUpdate
+		votes  map[cciptypes.Bytes32]int
+	}
+
+	votesPerChain := make(map[uint64]*rootVotes)
+	for _, ao := range attributedObservations {
+		if ao.SignedObservation == nil || ao.SignedObservation.Observation == nil {
+			continue
+		}
+		for _, lu := range ao.SignedObservation.Observation.FixedDestLaneUpdates {
+			chain := lu.LaneSource.SourceChainSelector
+			if _, ok := votesPerChain[chain]; !ok {
+				votesPerChain[chain] = &rootVotes{update: lu, votes: make(map[cciptypes.Bytes32]int)}
+			}
+			var root cciptypes.Bytes32
+			copy(root[:], lu.Root)
+			votesPerChain[chain].votes[root]++
+		}
+	}
+
+	laneUpdates := make([]cciptypes.RMNLaneUpdate, 0, len(votesPerChain))
+	for chain, rv := range votesPerChain {
+		var selectedRoot cciptypes.Bytes32
+		maxVotes := 0
+		for root, votes := range rv.votes {
+			if votes > maxVotes || (votes == maxVotes && bytes.Compare(root[:], selectedRoot[:]) < 0) {
+				selectedRoot, maxVotes = root, votes
+			}
+		}
+
+		ownRoot, err := n.root(rv.update.LaneSource, rv.update.ClosedInterval, behavior)
+		if err != nil {
+			return nil, fmt.Errorf("get root for source chain %d: %w", chain, err)
+		}
+		if behavior.WrongRoots {
+			selectedRoot = ownRoot
+		} else if selectedRoot != ownRoot {
+			return nil, fmt.Errorf("selected root %s for source chain %d does not match the observed root %s",
+				selectedRoot.String(), chain, ownRoot.String())
+		}
+
+		laneUpdates = append(laneUpdates, cciptypes.RMNLaneUpdate{
+			SourceChainSelector: cciptypes.ChainSelector(chain),
+			OnRampAddress:       rv.update.LaneSource.OnrampAddress,
+			MinSeqNr:            cciptypes.SeqNum(rv.update.ClosedInterval.MinMsgNr),
+			MaxSeqNr:            cciptypes.SeqNum(rv.update.ClosedInterval.MaxMsgNr),
+			MerkleRoot:          selectedRoot,
+		})
+	}
+
+	sort.Slice(laneUpdates, func(i, j int) bool {
+		return laneUpdates[i].SourceChainSelector < laneUpdates[j].SourceChainSelector
+	})
+	return laneUpdates, nil
+}
+
+func (n *Node) root(
+	laneSource *rmnpb.LaneSource,
+	interval *rmnpb.ClosedInterval,
+	behavior Behavior,
+) (cciptypes.Bytes32, error) {
+	root, err := n.cfg.Roots(laneSource, interval)
+	if err != nil {
+		return cciptypes.Bytes32{}, err
+	}
+	if behavior.WrongRoots {
+		for i := range root {
+			root[i] = ^root[i]
+		}
+	}
+	return root, nil
+}
@@ -0,0 +1,182 @@
+// peerclient.go contains an rmn.PeerClient that talks to in-process stand-in nodes over rmn.Stream.
+
+package standin
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	ragep2ptypes "github.com/smartcontractkit/libocr/ragep2p/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn"
+	rmntypes "github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn/types"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// responseBufferSize is the size of the buffers used for responses, large enough to not block the stand-in nodes.
+const responseBufferSize = 1000
+
+// PeerClient is an rmn.PeerClient that routes the requests to stand-in nodes instead of the RMN peers.
+type PeerClient struct {
+	lggr     logger.Logger
+	nodes    map[rmntypes.NodeID]*Node
+	streams  map[rmntypes.NodeID]*stream
+	respChan chan rmn.PeerResponse
+	// connected is true after InitConnection and until Close is called.
+	connected bool
+	mu        *sync.RWMutex
+}
+
+var _ rmn.PeerClient = (*PeerClient)(nil)
+
+// NewPeerClient creates a new PeerClient for the provided stand-in nodes.
+func NewPeerClient(lggr logger.Logger, nodes ...*Node) *PeerClient {
+	nodesByID := make(map[rmntypes.NodeID]*Node, len(nodes))
+	for _, n := range nodes {
+		nodesByID[n.ID()] = n
+	}
+
+	return &PeerClient{
+		lggr:     lggr,
+		nodes:    nodesByID,
+		streams:  make(map[rmntypes.NodeID]*stream),
+		respChan: make(chan rmn.PeerResponse, responseBufferSize),
+		mu:       &sync.RWMutex{},
+	}
+}
+
+func (p *PeerClient) InitConnection(
+	_ context.Context,
+	_, _ cciptypes.Bytes32,
+	_ []ragep2ptypes.PeerID,
+	_ []rmntypes.HomeNodeInfo,
+) error {
+	if err := p.Close(); err != nil {
+		return fmt.Errorf("close existing streams: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.connected = true
+	return nil
+}
+
+func (p *PeerClient) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, s := range p.streams {
+		if err := s.Close(); err != nil {
+			return fmt.Errorf("close stream: %w", err)
+		}
+	}
+	p.streams = make(map[rmntypes.NodeID]*stream)
+	p.connected = false
+	return nil
+}
+
+func (p *PeerClient) Send(rmnNode rmntypes.HomeNodeInfo, request []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.connected {
+		return rmn.ErrNoConn
+	}
+
+	s, ok := p.streams[rmnNode.ID]
+	if !ok {
+		node, exists := p.nodes[rmnNode.ID]
+		if !exists {
+			return fmt.Errorf("stand-in node %d: %w", rmnNode.ID, rmn.ErrNotFound)
+		}
+		s = newStream(p.lggr, node)
+		p.streams[rmnNode.ID] = s
+		go p.listenToStream(rmnNode.ID, s)
+	}
+
+	s.SendMessage(request)
+	return nil
+}
+
+func (p *PeerClient) listenToStream(rmnNodeID rmntypes.NodeID, s *stream) {
+	for {
+		select {
+		case msg := <-s.ReceiveMessages():
+			select {
+			case p.respChan <- rmn.PeerResponse{RMNNodeID: rmnNodeID, Body: msg}:
+			case <-s.done:
+				return
+			}
+		case <-s.done:
+			return
+		}
+	}
+}
+
+func (p *PeerClient) Recv() <-chan rmn.PeerResponse {
+	return p.respChan
+}
+
+// stream is an rmn.Stream connected to a stand-in node.
+type stream struct {
+	lggr      logger.Logger
+	node      *Node
+	responses chan []byte
+	done      chan struct{}
+	closeOnce *sync.Once
+}
+
+var _ rmn.Stream = (*stream)(nil)
+
+func newStream(lggr logger.Logger, node *Node) *stream {
+	return &stream{
+		lggr:      lggr,
+		node:      node,
+		responses: make(chan []byte, responseBufferSize),
+		done:      make(chan struct{}),
+		closeOnce: &sync.Once{},
+	}
+}
+
+// SendMessage hands the request to the stand-in node, the response (if any) is delivered asynchronously
+// after the configured lag. The behavior of the node is captured when the request is sent, so a later
+// SetBehavior does not affect the requests that are already in flight.
+func (s *stream) SendMessage(data []byte) {
+	behavior := s.node.getBehavior()
+	go func() {
+		resp, err := s.node.handleRequest(data, behavior)
+		if err != nil {
+			s.lggr.Errorw("stand-in node failed to handle request", "nodeID", s.node.ID(), "err", err)
+			return
+		}
+		if resp == nil {
+			return
+		}
+
+		if lag := behavior.Lag; lag > 0 {
+			select {
+			case <-time.After(lag):
+			case <-s.done:
+				return
+			}
+		}
+
+		select {
+		case s.responses <- resp:
+		case <-s.done:
+		}
+	}()
+}
+
+func (s *stream) ReceiveMessages() <-chan []byte {
+	return s.responses
+}
+
+func (s *stream) Close() error {
+	s.closeOnce.Do(func() { close(s.done) })
+	return nil
+}
@@ -0,0 +1,262 @@
+package standin
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	rmnpb "github.com/smartcontractkit/chainlink-protos/rmn/v1.6/go/serialization"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn"
+	rmntypes "github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn/types"
+	readerpkg_mock "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+var (
+	chainS1       = cciptypes.ChainSelector(chainsel.TEST_90000002.Selector)
+	chainS1OnRamp = []byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+
+	chainS2       = cciptypes.ChainSelector(chainsel.TEST_90000003.Selector)
+	chainS2OnRamp = []byte{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2}
+
+	chainD1        = cciptypes.ChainSelector(chainsel.TEST_90000004.Selector)
+	chainD1OffRamp = []byte{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3}
+
+	signObservationPrefix = "chainlink ccip 1.6 rmn observation"
+	configDigest          = cciptypes.Bytes32{0x1, 0x2, 0x3}
+)
+
+func deterministicRoot(laneSource *rmnpb.LaneSource, interval *rmnpb.ClosedInterval) (cciptypes.Bytes32, error) {
+	b := make([]byte, 24)
+	binary.BigEndian.PutUint64(b[0:8], laneSource.SourceChainSelector)
+	binary.BigEndian.PutUint64(b[8:16], interval.MinMsgNr)
+	binary.BigEndian.PutUint64(b[16:24], interval.MaxMsgNr)
+	return sha256.Sum256(b), nil
+}
+
+type testSetup struct {
+	nodes        []*Node
+	controller   rmn.Controller
+	remoteConfig cciptypes.RemoteConfig
+}
+
+func newTestSetup(t *testing.T, behaviors []Behavior, timerDuration time.Duration) testSetup {
+	lggr := logger.Test(t)
+
+	nodes := make([]*Node, len(behaviors))
+	homeNodes := make([]rmntypes.HomeNodeInfo, len(behaviors))
+	signers := make([]cciptypes.RemoteSignerInfo, len(behaviors))
+	for i, b := range behaviors {
+		n, err := NewRandomNode(lggr, Config{
+			NodeID:                rmntypes.NodeID(i + 1),
+			SignObservationPrefix: signObservationPrefix,
+			RMNHomeConfigDigest:   configDigest,
+			ReportVersionDigest:   cciptypes.Bytes32{0xa},
+			Roots:                 deterministicRoot,
+			Behavior:              b,
+		})
+		require.NoError(t, err)
+		nodes[i] = n
+		homeNodes[i] = n.HomeNodeInfo(chainS1, chainS2)
+		signers[i] = n.SignerInfo()
+	}
+
+	rmnHome := readerpkg_mock.NewMockRMNHome(t)
+	rmnHome.On("GetRMNNodesInfo", configDigest).Return(homeNodes, nil).Maybe()
+	rmnHome.On("GetFObserve", configDigest).Return(
+		map[cciptypes.ChainSelector]int{chainS1: 1, chainS2: 1}, nil).Maybe()
+
+	peerClient := NewPeerClient(lggr, nodes...)
+	controller := rmn.NewController(
+		lggr,
+		NewCrypto(EVMReportHasher{}),
+		signObservationPrefix,
+		peerClient,
+		rmnHome,
+		timerDuration,
+		timerDuration,
+		rmn.NoopMetrics{},
+	)
+	require.NoError(t, controller.InitConnection(tests.Context(t), cciptypes.Bytes32{}, configDigest, nil, homeNodes))
+	t.Cleanup(func() { assert.NoError(t, controller.Close()) })
+
+	return testSetup{
+		nodes:      nodes,
+		controller: controller,
+		remoteConfig: cciptypes.RemoteConfig{
+			ContractAddress:  []byte{4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4},
+			ConfigDigest:     configDigest,
+			FSign:            1,
+			Signers:          signers,
+			ConfigVersion:    1,
+			RmnReportVersion: cciptypes.Bytes32{0xa},
+		},
+	}
+}
+
+func TestStandIn_ComputeReportSignatures(t *testing.T) {
+	destChain := &rmnpb.LaneDest{
+		DestChainSelector: uint64(chainD1),
+		OfframpAddress:    chainD1OffRamp,
+	}
+
+	updateRequests := []*rmnpb.FixedDestLaneUpdateRequest{
+		{
+			LaneSource:     &rmnpb.LaneSource{SourceChainSelector: uint64(chainS1), OnrampAddress: chainS1OnRamp},
+			ClosedInterval: &rmnpb.ClosedInterval{MinMsgNr: 10, MaxMsgNr: 20},
+		},
+		{
+			LaneSource:     &rmnpb.LaneSource{SourceChainSelector: uint64(chainS2), OnrampAddress: chainS2OnRamp},
+			ClosedInterval: &rmnpb.ClosedInterval{MinMsgNr: 100, MaxMsgNr: 110},
+		},
+	}
+
+	assertHonestRoots := func(t *testing.T, sigs *rmn.ReportSignatures) {
+		require.Len(t, sigs.LaneUpdates, len(updateRequests))
+		for _, lu := range sigs.LaneUpdates {
+			expRoot, err := deterministicRoot(lu.LaneSource, lu.ClosedInterval)
+			require.NoError(t, err)
+			assert.Equal(t, expRoot[:], lu.Root)
+		}
+	}
+
+	t.Run("honest nodes", func(t *testing.T) {
+		ts := newTestSetup(t, make([]Behavior, 4), time.Minute)
+
+		sigs, err := ts.controller.ComputeReportSignatures(
+			tests.Context(t), destChain, updateRequests, ts.remoteConfig)
+		require.NoError(t, err)
+		assertHonestRoots(t, sigs)
+		assert.Len(t, sigs.Signatures, int(ts.remoteConfig.FSign)+1)
+	})
+
+	t.Run("faulty minority", func(t *testing.T) {
+		ts := newTestSetup(t, []Behavior{
+			{WrongRoots: true},
+			{WithholdObservations: true, WithholdSignatures: true},
+			{Lag: 50 * time.Millisecond},
+			{},
+			{},
+		}, 10*time.Millisecond)
+
+		sigs, err := ts.controller.ComputeReportSignatures(
+			tests.Context(t), destChain, updateRequests, ts.remoteConfig)
+		require.NoError(t, err)
+		assertHonestRoots(t, sigs)
+		assert.Len(t, sigs.Signatures, int(ts.remoteConfig.FSign)+1)
+	})
+
+	t.Run("not enough signers", func(t *testing.T) {
+		ts := newTestSetup(t, []Behavior{
+			{WithholdSignatures: true},
+			{WithholdSignatures: true},
+			{WithholdSignatures: true},
+			{},
+		}, 10*time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(tests.Context(t), 500*time.Millisecond)
+		defer cancel()
+
+		_, err := ts.controller.ComputeReportSignatures(ctx, destChain, updateRequests, ts.remoteConfig)
+		require.Error(t, err)
+	})
+
+	t.Run("behavior change", func(t *testing.T) {
+		ts := newTestSetup(t, []Behavior{
+			{WithholdObservations: true},
+			{WithholdObservations: true},
+			{WithholdObservations: true},
+		}, 10*time.Millisecond)
+
+		for _, n := range ts.nodes {
+			n.SetBehavior(Behavior{})
+		}
+
+		sigs, err := ts.controller.ComputeReportSignatures(
+			tests.Context(t), destChain, updateRequests, ts.remoteConfig)
+		require.NoError(t, err)
+		assertHonestRoots(t, sigs)
+	})
+}
+
+func TestPeerClient_LagCapturedWithRequest(t *testing.T) {
+	const lag = 200 * time.Millisecond
+
+	n, err := NewRandomNode(logger.Test(t), Config{
+		NodeID:                1,
+		SignObservationPrefix: signObservationPrefix,
+		RMNHomeConfigDigest:   configDigest,
+		Roots:                 deterministicRoot,
+		Behavior:              Behavior{Lag: lag},
+	})
+	require.NoError(t, err)
+
+	pc := NewPeerClient(logger.Test(t), n)
+	require.NoError(t, pc.InitConnection(tests.Context(t), cciptypes.Bytes32{}, configDigest, nil, nil))
+	t.Cleanup(func() { assert.NoError(t, pc.Close()) })
+
+	req, err := proto.Marshal(&rmnpb.Request{
+		RequestId: 1,
+		Request: &rmnpb.Request_ObservationRequest{
+			ObservationRequest: &rmnpb.ObservationRequest{
+				LaneDest: &rmnpb.LaneDest{DestChainSelector: uint64(chainD1), OfframpAddress: chainD1OffRamp},
+				FixedDestLaneUpdateRequests: []*rmnpb.FixedDestLaneUpdateRequest{{
+					LaneSource:     &rmnpb.LaneSource{SourceChainSelector: uint64(chainS1), OnrampAddress: chainS1OnRamp},
+					ClosedInterval: &rmnpb.ClosedInterval{MinMsgNr: 1, MaxMsgNr: 2},
+				}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	start := time.Now()
+	require.NoError(t, pc.Send(n.HomeNodeInfo(chainS1), req))
+	// the new behavior only applies to the requests sent afterwards
+	n.SetBehavior(Behavior{})
+
+	select {
+	case resp := <-pc.Recv():
+		assert.Equal(t, n.ID(), resp.RMNNodeID)
+		assert.GreaterOrEqual(t, time.Since(start), lag)
+	case <-time.After(tests.WaitTimeout(t)):
+		t.Fatal("no response received")
+	}
+}
+
+func TestCrypto_VerifyReportSignatures(t *testing.T) {
+	n, err := NewRandomNode(logger.Test(t), Config{Roots: deterministicRoot})
+	require.NoError(t, err)
+
+	report := cciptypes.NewRMNReport(
+		cciptypes.Bytes32{0xa},
+		cciptypes.NewBigIntFromInt64(1),
+		chainD1,
+		[]byte{1},
+		chainD1OffRamp,
+		configDigest,
+		[]cciptypes.RMNLaneUpdate{{SourceChainSelector: chainS1, OnRampAddress: chainS1OnRamp, MinSeqNr: 1, MaxSeqNr: 2}},
+	)
+
+	r, s, err := signReport(EVMReportHasher{}, n.onchainKey, report)
+	require.NoError(t, err)
+	sig := cciptypes.RMNECDSASignature{R: cciptypes.Bytes32(r), S: cciptypes.Bytes32(s)}
+
+	c := NewCrypto(EVMReportHasher{})
+	signer := []cciptypes.UnknownAddress{n.SignerInfo().OnchainPublicKey}
+	require.NoError(t, c.VerifyReportSignatures(tests.Context(t), []cciptypes.RMNECDSASignature{sig}, report, signer))
+
+	report.LaneUpdates[0].MaxSeqNr = 3
+	require.Error(t, c.VerifyReportSignatures(tests.Context(t), []cciptypes.RMNECDSASignature{sig}, report, signer))
+}
@@ -542,6 +542,16 @@ func (p *Plugin) getMainOutcome(
 	}
 }
 
+// HealthReport reports the health of the plugin processors, e.g. the discovered contracts rejected because
+// their typeAndVersion does not match the expectations.
+func (p *Plugin) HealthReport() map[string]error {
+	report := make(map[string]error)
+	if hr, ok := p.discoveryProcessor.(plugincommon.HealthReporter); ok {
+		services.CopyHealth(report, hr.HealthReport())
+	}
+	return report
+}
+
 func (p *Plugin) Close() error {
 	p.lggr.Infow("closing commit plugin")
 
@@ -0,0 +1,215 @@
+package execute
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/execute/metrics"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+const (
+	// maxCurseTransitions is the number of curse transitions kept in memory for audits.
+	maxCurseTransitions = 1000
+
+	CurseSubjectGlobal      = "global"
+	CurseSubjectDestination = "destination"
+	CurseSubjectSourceChain = "sourceChain"
+)
+
+// CurseTransition records a change of the RMN curse state as observed by the plugin.
+type CurseTransition struct {
+	Timestamp time.Time
+	// Subject is one of CurseSubjectGlobal, CurseSubjectDestination or CurseSubjectSourceChain.
+	Subject string
+	// SourceChain is only set when the subject is CurseSubjectSourceChain.
+	SourceChain cciptypes.ChainSelector
+	// Cursed is true if the subject became cursed and false if the curse was lifted.
+	Cursed bool
+}
+
+// curseTracker keeps track of the last observed curse state and records every transition.
+// Messages of cursed lanes are held back by the plugin and automatically resume once the curse is lifted,
+// the tracker makes these state changes visible in logs, metrics and for audits.
+type curseTracker struct {
+	lggr     logger.Logger
+	reporter metrics.Reporter
+
+	initialized bool
+	last        reader.CurseInfo
+	transitions []CurseTransition
+	// heldBackChains are the source chains with messages held back in the last round.
+	heldBackChains map[cciptypes.ChainSelector]struct{}
+	mu             *sync.RWMutex
+}
+
+func newCurseTracker(lggr logger.Logger, reporter metrics.Reporter) *curseTracker {
+	return &curseTracker{
+		lggr:           lggr,
+		reporter:       reporter,
+		transitions:    make([]CurseTransition, 0),
+		heldBackChains: make(map[cciptypes.ChainSelector]struct{}),
+		mu:             &sync.RWMutex{},
+	}
+}
+
+// update compares the provided curse info with the last observed one and records the transitions.
+// The first call records the subjects that are cursed at the time, since there is no previous state.
+func (ct *curseTracker) update(ci reader.CurseInfo) []CurseTransition {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+
+	now := time.Now().UTC()
+	var transitions []CurseTransition
+
+	if ct.last.GlobalCurse != ci.GlobalCurse || (!ct.initialized && ci.GlobalCurse) {
+		transitions = append(transitions,
+			CurseTransition{Timestamp: now, Subject: CurseSubjectGlobal, Cursed: ci.GlobalCurse})
+	}
+	if ct.last.CursedDestination != ci.CursedDestination || (!ct.initialized && ci.CursedDestination) {
+		transitions = append(transitions,
+			CurseTransition{Timestamp: now, Subject: CurseSubjectDestination, Cursed: ci.CursedDestination})
+	}
+
+	sourceChains := make(map[cciptypes.ChainSelector]struct{})
+	for ch := range ct.last.CursedSourceChains {
+		sourceChains[ch] = struct{}{}
+	}
+	for ch := range ci.CursedSourceChains {
+		sourceChains[ch] = struct{}{}
+	}
+	sortedSourceChains := make([]cciptypes.ChainSelector, 0, len(sourceChains))
+	for ch := range sourceChains {
+		sortedSourceChains = append(sortedSourceChains, ch)
+	}
+	sort.Slice(sortedSourceChains, func(i, j int) bool { return sortedSourceChains[i] < sortedSourceChains[j] })
+
+	for _, ch := range sortedSourceChains {
+		if ct.last.CursedSourceChains[ch] != ci.CursedSourceChains[ch] {
+			transitions = append(transitions, CurseTransition{
+				Timestamp:   now,
+				Subject:     CurseSubjectSourceChain,
+				SourceChain: ch,
+				Cursed:      ci.CursedSourceChains[ch],
+			})
+		}
+	}
+
+	for _, tr := range transitions {
+		if tr.Cursed {
+			ct.lggr.Warnw("rmn curse state transition: cursed",
+				"subject", tr.Subject, "sourceChain", tr.SourceChain)
+		} else {
+			ct.lggr.Infow("rmn curse state transition: curse lifted, execution resumes",
+				"subject", tr.Subject, "sourceChain", tr.SourceChain)
+		}
+		ct.reporter.TrackCurseState(tr.Subject, tr.SourceChain, tr.Cursed)
+	}
+
+	ct.transitions = append(ct.transitions, transitions...)
+	if len(ct.transitions) > maxCurseTransitions {
+		ct.transitions = ct.transitions[len(ct.transitions)-maxCurseTransitions:]
+	}
+	ct.last = ci
+	ct.initialized = true
+
+	return transitions
+}
+
+// history returns a copy of the recorded curse transitions, oldest first.
+func (ct *curseTracker) history() []CurseTransition {
+	ct.mu.RLock()
+	defer ct.mu.RUnlock()
+
+	res := make([]CurseTransition, len(ct.transitions))
+	copy(res, ct.transitions)
+	return res
+}
+
+// trackHeldBackCommits logs and reports the pending messages of the commit reports that are held back by a curse.
+// It is called every round, the chains that no longer have messages held back are reported with zero messages.
+func (ct *curseTracker) trackHeldBackCommits(lggr logger.Logger, heldBack []exectypes.CommitData) {
+	numMessagesPerChain := make(map[cciptypes.ChainSelector]int)
+	for _, commit := range heldBack {
+		numPending := commit.SequenceNumberRange.Length() - len(commit.ExecutedMessages)
+		numMessagesPerChain[commit.SourceChain] += numPending
+		lggr.Infow("messages held back by rmn curse",
+			"sourceChain", commit.SourceChain,
+			"merkleRoot", commit.MerkleRoot.String(),
+			"seqNumRange", commit.SequenceNumberRange,
+			"numPendingMessages", numPending,
+		)
+	}
+
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+
+	for chain := range ct.heldBackChains {
+		if _, ok := numMessagesPerChain[chain]; !ok {
+			ct.reporter.TrackMessagesHeldByCurse(chain, 0)
+			delete(ct.heldBackChains, chain)
+		}
+	}
+	for chain, numMessages := range numMessagesPerChain {
+		ct.reporter.TrackMessagesHeldByCurse(chain, numMessages)
+		ct.heldBackChains[chain] = struct{}{}
+	}
+}
+
+// isReportCursed checks the report against the current RMN curse state. A global or destination curse rejects
+// the report. Cursed lanes alone do not reject it: during DON execution the offramp skips the reports of cursed
+// source chains instead of reverting, so the messages of the other lanes are still executed. The report is only
+// rejected when all of its lanes are cursed.
+func (p *Plugin) isReportCursed(
+	ctx context.Context,
+	lggr logger.Logger,
+	report cciptypes.ExecutePluginReport,
+) (bool, error) {
+	if len(report.ChainReports) == 0 {
+		return false, nil
+	}
+
+	curseInfo, err := p.ccipReader.GetRmnCurseInfo(ctx)
+	if err != nil {
+		return false, fmt.Errorf("error while fetching curse info: %w", err)
+	}
+	p.curseTracker.update(curseInfo)
+
+	if curseInfo.GlobalCurse || curseInfo.CursedDestination {
+		lggr.Warnw("report not accepted due to RMN curse", "curseInfo", curseInfo)
+		return true, nil
+	}
+
+	numCursedLanes := 0
+	for _, chainReport := range report.ChainReports {
+		if !curseInfo.CursedSourceChains[chainReport.SourceChainSelector] {
+			continue
+		}
+		numCursedLanes++
+		lggr.Warnw("messages held back by rmn curse, lane will be skipped by the offramp",
+			"sourceChain", chainReport.SourceChainSelector,
+			"numMessages", len(chainReport.Messages),
+		)
+	}
+
+	if numCursedLanes == len(report.ChainReports) {
+		lggr.Warnw("report not accepted due to cursing, all source chains were cursed during report generation",
+			"cursedSourceChains", curseInfo.CursedSourceChains,
+		)
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// CurseTransitions returns the RMN curse state transitions observed by the plugin, oldest first.
+func (p *Plugin) CurseTransitions() []CurseTransition {
+	return p.curseTracker.history()
+}
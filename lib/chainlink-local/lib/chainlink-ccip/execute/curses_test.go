@@ -0,0 +1,199 @@
+package execute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/execute/metrics"
+	readerpkg_mock "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/reader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+func Test_curseTracker_update(t *testing.T) {
+	ct := newCurseTracker(logger.Test(t), &metrics.Noop{})
+
+	// nothing cursed, nothing to record
+	require.Empty(t, ct.update(reader.CurseInfo{}))
+
+	// a lane gets cursed
+	transitions := ct.update(reader.CurseInfo{
+		CursedSourceChains: map[cciptypes.ChainSelector]bool{1: true, 2: false},
+	})
+	require.Len(t, transitions, 1)
+	assert.Equal(t, CurseSubjectSourceChain, transitions[0].Subject)
+	assert.Equal(t, cciptypes.ChainSelector(1), transitions[0].SourceChain)
+	assert.True(t, transitions[0].Cursed)
+
+	// same state, no transitions
+	require.Empty(t, ct.update(reader.CurseInfo{
+		CursedSourceChains: map[cciptypes.ChainSelector]bool{1: true},
+	}))
+
+	// global curse on top of the lane curse
+	transitions = ct.update(reader.CurseInfo{
+		CursedSourceChains: map[cciptypes.ChainSelector]bool{1: true},
+		GlobalCurse:        true,
+	})
+	require.Len(t, transitions, 1)
+	assert.Equal(t, CurseSubjectGlobal, transitions[0].Subject)
+	assert.True(t, transitions[0].Cursed)
+
+	// all curses are lifted
+	transitions = ct.update(reader.CurseInfo{})
+	require.Len(t, transitions, 2)
+	assert.Equal(t, CurseSubjectGlobal, transitions[0].Subject)
+	assert.False(t, transitions[0].Cursed)
+	assert.Equal(t, CurseSubjectSourceChain, transitions[1].Subject)
+	assert.False(t, transitions[1].Cursed)
+
+	assert.Len(t, ct.history(), 4)
+}
+
+func Test_curseTracker_initialState(t *testing.T) {
+	ct := newCurseTracker(logger.Test(t), &metrics.Noop{})
+
+	transitions := ct.update(reader.CurseInfo{CursedDestination: true})
+	require.Len(t, transitions, 1)
+	assert.Equal(t, CurseSubjectDestination, transitions[0].Subject)
+	assert.True(t, transitions[0].Cursed)
+}
+
+func Test_curseTracker_historyIsBounded(t *testing.T) {
+	ct := newCurseTracker(logger.Test(t), &metrics.Noop{})
+
+	for i := 0; i < maxCurseTransitions; i++ {
+		ct.update(reader.CurseInfo{GlobalCurse: i%2 == 0})
+	}
+	history := ct.history()
+	require.Len(t, history, maxCurseTransitions)
+	assert.False(t, history[len(history)-1].Cursed)
+}
+
+type heldByCurseReporter struct {
+	metrics.Noop
+	heldBack map[cciptypes.ChainSelector]int
+}
+
+func (r *heldByCurseReporter) TrackMessagesHeldByCurse(sourceChain cciptypes.ChainSelector, numMessages int) {
+	r.heldBack[sourceChain] = numMessages
+}
+
+func Test_curseTracker_trackHeldBackCommits(t *testing.T) {
+	reporter := &heldByCurseReporter{heldBack: make(map[cciptypes.ChainSelector]int)}
+	ct := newCurseTracker(logger.Test(t), reporter)
+
+	ct.trackHeldBackCommits(logger.Test(t), []exectypes.CommitData{
+		{SourceChain: 1, SequenceNumberRange: cciptypes.NewSeqNumRange(1, 10), ExecutedMessages: []cciptypes.SeqNum{1, 2}},
+		{SourceChain: 1, SequenceNumberRange: cciptypes.NewSeqNumRange(11, 12)},
+		{SourceChain: 2, SequenceNumberRange: cciptypes.NewSeqNumRange(1, 5)},
+	})
+	// executed messages are not held back
+	assert.Equal(t, map[cciptypes.ChainSelector]int{1: 10, 2: 5}, reporter.heldBack)
+
+	// the gauge follows the messages that are executed while the lane is cursed
+	ct.trackHeldBackCommits(logger.Test(t), []exectypes.CommitData{
+		{SourceChain: 1, SequenceNumberRange: cciptypes.NewSeqNumRange(11, 12), ExecutedMessages: []cciptypes.SeqNum{11}},
+	})
+	assert.Equal(t, map[cciptypes.ChainSelector]int{1: 1, 2: 0}, reporter.heldBack)
+
+	ct.trackHeldBackCommits(logger.Test(t), nil)
+	assert.Equal(t, map[cciptypes.ChainSelector]int{1: 0, 2: 0}, reporter.heldBack)
+}
+
+func Test_commitsHeldBackByCurse(t *testing.T) {
+	reports := []cciptypes.CommitPluginReportWithMeta{
+		{
+			Report: cciptypes.CommitPluginReport{
+				BlessedMerkleRoots: []cciptypes.MerkleRootChain{
+					{ChainSel: 1, MerkleRoot: cciptypes.Bytes32{1}, SeqNumsRange: cciptypes.NewSeqNumRange(1, 10)},
+					{ChainSel: 2, MerkleRoot: cciptypes.Bytes32{2}, SeqNumsRange: cciptypes.NewSeqNumRange(1, 5)},
+				},
+				UnblessedMerkleRoots: []cciptypes.MerkleRootChain{
+					{ChainSel: 1, MerkleRoot: cciptypes.Bytes32{3}, SeqNumsRange: cciptypes.NewSeqNumRange(11, 12)},
+				},
+			},
+		},
+	}
+	canExecute := func(_ cciptypes.ChainSelector, root cciptypes.Bytes32) bool {
+		return root != cciptypes.Bytes32{3}
+	}
+
+	heldBack := commitsHeldBackByCurse(reports, map[cciptypes.ChainSelector]bool{1: true}, canExecute)
+	require.Len(t, heldBack, 1)
+	require.Len(t, heldBack[1], 1)
+	assert.Equal(t, cciptypes.ChainSelector(1), heldBack[1][0].SourceChain)
+	assert.Equal(t, cciptypes.Bytes32{1}, heldBack[1][0].MerkleRoot)
+
+	assert.Empty(t, commitsHeldBackByCurse(reports, nil, canExecute))
+}
+
+func TestPlugin_isReportCursed(t *testing.T) {
+	report := cciptypes.ExecutePluginReport{
+		ChainReports: []cciptypes.ExecutePluginReportSingleChain{
+			{SourceChainSelector: 1},
+			{SourceChainSelector: 2},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		curseInfo  reader.CurseInfo
+		wantCursed bool
+	}{
+		{
+			name:       "not cursed",
+			curseInfo:  reader.CurseInfo{},
+			wantCursed: false,
+		},
+		{
+			name: "one lane cursed, other lane is still executed",
+			curseInfo: reader.CurseInfo{
+				CursedSourceChains: map[cciptypes.ChainSelector]bool{1: true},
+			},
+			wantCursed: false,
+		},
+		{
+			name: "all lanes cursed",
+			curseInfo: reader.CurseInfo{
+				CursedSourceChains: map[cciptypes.ChainSelector]bool{1: true, 2: true},
+			},
+			wantCursed: true,
+		},
+		{
+			name:       "global curse",
+			curseInfo:  reader.CurseInfo{GlobalCurse: true},
+			wantCursed: true,
+		},
+		{
+			name:       "destination curse",
+			curseInfo:  reader.CurseInfo{CursedDestination: true},
+			wantCursed: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lggr := logger.Test(t)
+			ccipReader := readerpkg_mock.NewMockCCIPReader(t)
+			ccipReader.EXPECT().GetRmnCurseInfo(mock.Anything).Return(tc.curseInfo, nil)
+
+			p := &Plugin{
+				lggr:         lggr,
+				ccipReader:   ccipReader,
+				curseTracker: newCurseTracker(lggr, &metrics.Noop{}),
+			}
+
+			cursed, err := p.isReportCursed(tests.Context(t), lggr, report)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantCursed, cursed)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package execute
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 
 	sel "github.com/smartcontractkit/chain-selectors"
 
@@ -16,6 +17,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/metrics"
 	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/observer"
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal/reader"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
@@ -80,6 +82,10 @@ type PluginFactory struct {
 	tokenDataEncoder cciptypes.TokenDataEncoder
 	contractReaders  map[cciptypes.ChainSelector]types.ContractReader
 	chainWriters     map[cciptypes.ChainSelector]types.ContractWriter
+	// typeAndVersionReaders optionally read typeAndVersion() of the discovered contracts.
+	typeAndVersionReaders map[cciptypes.ChainSelector]readerpkg.TypeAndVersionReader
+	// plugin is the plugin instance created by the factory, kept for its health report.
+	plugin *atomic.Value
 }
 
 type PluginFactoryParams struct {
@@ -94,23 +100,28 @@ type PluginFactoryParams struct {
 	EstimateProvider cciptypes.EstimateProvider
 	ContractReaders  map[cciptypes.ChainSelector]types.ContractReader
 	ContractWriters  map[cciptypes.ChainSelector]types.ContractWriter
+	// TypeAndVersionReaders are optional, on chains without one the discovered contracts are verified through the
+	// contract readers.
+	TypeAndVersionReaders map[cciptypes.ChainSelector]readerpkg.TypeAndVersionReader
 }
 
 // NewExecutePluginFactory creates a new PluginFactory instance. For execute plugin, oracle instances are not managed by
 // the factory. It is safe to assume that a factory instance will create exactly one plugin instance.
 func NewExecutePluginFactory(params PluginFactoryParams) *PluginFactory {
 	return &PluginFactory{
-		baseLggr:         params.Lggr,
-		donID:            params.DonID,
-		ocrConfig:        params.OcrConfig,
-		execCodec:        params.ExecCodec,
-		msgHasher:        params.MsgHasher,
-		addrCodec:        params.AddrCodec,
-		homeChainReader:  params.HomeChainReader,
-		estimateProvider: params.EstimateProvider,
-		tokenDataEncoder: params.TokenDataEncoder,
-		contractReaders:  params.ContractReaders,
-		chainWriters:     params.ContractWriters,
+		baseLggr:              params.Lggr,
+		donID:                 params.DonID,
+		ocrConfig:             params.OcrConfig,
+		execCodec:             params.ExecCodec,
+		msgHasher:             params.MsgHasher,
+		addrCodec:             params.AddrCodec,
+		homeChainReader:       params.HomeChainReader,
+		estimateProvider:      params.EstimateProvider,
+		tokenDataEncoder:      params.TokenDataEncoder,
+		contractReaders:       params.ContractReaders,
+		chainWriters:          params.ContractWriters,
+		typeAndVersionReaders: params.TypeAndVersionReaders,
+		plugin:                &atomic.Value{},
 	}
 }
 
@@ -154,6 +165,7 @@ func (p PluginFactory) NewReportingPlugin(
 		p.ocrConfig.Config.ChainSelector,
 		p.ocrConfig.Config.OfframpAddress,
 		p.addrCodec,
+		readerpkg.WithTypeAndVersionReaders(p.typeAndVersionReaders),
 	)
 
 	tokenDataObserver, err := observer.NewConfigBasedCompositeObservers(
@@ -174,32 +186,37 @@ func (p PluginFactory) NewReportingPlugin(
 		return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to create metrics reporter: %w", err)
 	}
 
-	return NewPlugin(
-			p.donID,
-			config,
-			offchainConfig,
-			p.ocrConfig.Config.ChainSelector,
-			oracleIDToP2PID,
-			ccipReader,
-			p.execCodec,
-			p.msgHasher,
-			p.homeChainReader,
-			tokenDataObserver,
-			p.estimateProvider,
-			lggr,
-			metricsReporter,
-			p.addrCodec,
-		), ocr3types.ReportingPluginInfo{
-			Name: "CCIPRoleExecute",
-			Limits: ocr3types.ReportingPluginLimits{
-				// No query for this execute implementation.
-				MaxQueryLength:       maxQueryLength,
-				MaxObservationLength: maxObservationLength,
-				MaxOutcomeLength:     maxOutcomeLength,
-				MaxReportLength:      maxReportLength,
-				MaxReportCount:       maxReportCount,
-			},
-		}, nil
+	plugin := NewPlugin(
+		p.donID,
+		config,
+		offchainConfig,
+		p.ocrConfig.Config.ChainSelector,
+		oracleIDToP2PID,
+		ccipReader,
+		p.execCodec,
+		p.msgHasher,
+		p.homeChainReader,
+		tokenDataObserver,
+		p.estimateProvider,
+		lggr,
+		metricsReporter,
+		p.addrCodec,
+	)
+	if p.plugin != nil {
+		p.plugin.Store(plugin)
+	}
+
+	return plugin, ocr3types.ReportingPluginInfo{
+		Name: "CCIPRoleExecute",
+		Limits: ocr3types.ReportingPluginLimits{
+			// No query for this execute implementation.
+			MaxQueryLength:       maxQueryLength,
+			MaxObservationLength: maxObservationLength,
+			MaxOutcomeLength:     maxOutcomeLength,
+			MaxReportLength:      maxReportLength,
+			MaxReportCount:       maxReportCount,
+		},
+	}, nil
 }
 
 func (p PluginFactory) Name() string {
@@ -218,8 +235,15 @@ func (p PluginFactory) Ready() error {
 	panic("implement me")
 }
 
+// HealthReport reports the health of the plugin created by the factory, if any.
 func (p PluginFactory) HealthReport() map[string]error {
-	panic("implement me")
+	if p.plugin == nil {
+		return map[string]error{}
+	}
+	if hr, ok := p.plugin.Load().(plugincommon.HealthReporter); ok {
+		return hr.HealthReport()
+	}
+	return map[string]error{}
 }
 
 // Interface compatibility checks.
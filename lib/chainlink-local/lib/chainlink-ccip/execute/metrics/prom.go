@@ -85,6 +85,20 @@ var (
 		},
 		[]string{"chainID", "sourceChain", "method"},
 	)
+	PromExecCurseState = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ccip_exec_curse_state",
+			Help: "This metric tracks the RMN curse state observed by the exec plugin (1 cursed, 0 not cursed)",
+		},
+		[]string{"chainID", "subject", "sourceChain"},
+	)
+	PromExecMessagesHeldByCurse = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ccip_exec_messages_held_by_curse",
+			Help: "This metric tracks the number of messages currently held back by the exec plugin due to an RMN curse",
+		},
+		[]string{"chainID", "sourceChain"},
+	)
 )
 
 type PromReporter struct {
@@ -98,6 +112,8 @@ type PromReporter struct {
 	sequenceNumbers           *prometheus.GaugeVec
 	processorLatencyHistogram *prometheus.HistogramVec
 	processorErrors           *prometheus.CounterVec
+	curseState                *prometheus.GaugeVec
+	messagesHeldByCurse       *prometheus.GaugeVec
 }
 
 func NewPromReporter(lggr logger.Logger, selector cciptypes.ChainSelector) (*PromReporter, error) {
@@ -116,6 +132,8 @@ func NewPromReporter(lggr logger.Logger, selector cciptypes.ChainSelector) (*Pro
 		sequenceNumbers:           PromSequenceNumbers,
 		processorLatencyHistogram: PromExecProcessorLatencyHistogram,
 		processorErrors:           PromExecProcessorErrors,
+		curseState:                PromExecCurseState,
+		messagesHeldByCurse:       PromExecMessagesHeldByCurse,
 	}, nil
 }
 
@@ -180,6 +198,39 @@ func (p *PromReporter) TrackProcessorOutput(
 	// noop
 }
 
+func (p *PromReporter) TrackCurseState(subject string, sourceChainSelector cciptypes.ChainSelector, cursed bool) {
+	sourceChain := ""
+	if sourceChainSelector != 0 {
+		var err error
+		sourceChain, err = sel.GetChainIDFromSelector(uint64(sourceChainSelector))
+		if err != nil {
+			p.lggr.Errorw("failed to get chain ID from selector", "err", err)
+			return
+		}
+	}
+
+	value := 0.0
+	if cursed {
+		value = 1
+	}
+
+	p.curseState.
+		WithLabelValues(p.chainID, subject, sourceChain).
+		Set(value)
+}
+
+func (p *PromReporter) TrackMessagesHeldByCurse(sourceChainSelector cciptypes.ChainSelector, numMessages int) {
+	sourceChain, err := sel.GetChainIDFromSelector(uint64(sourceChainSelector))
+	if err != nil {
+		p.lggr.Errorw("failed to get chain ID from selector", "err", err)
+		return
+	}
+
+	p.messagesHeldByCurse.
+		WithLabelValues(p.chainID, sourceChain).
+		Set(float64(numMessages))
+}
+
 func (p *PromReporter) trackMaxSequenceNumber(
 	sourceChainSelector cciptypes.ChainSelector,
 	maxSeqNr int,
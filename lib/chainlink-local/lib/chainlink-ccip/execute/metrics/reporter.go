@@ -6,6 +6,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
 // Reporter is a simple interface used for tracking observations and outcomes of the execution plugin.
@@ -19,6 +20,10 @@ type Reporter interface {
 	TrackLatency(state exectypes.PluginState, method plugincommon.MethodType, latency time.Duration, err error)
 	TrackProcessorOutput(string, plugincommon.MethodType, plugintypes.Trackable)
 	TrackProcessorLatency(processor string, method plugincommon.MethodType, latency time.Duration, err error)
+	// TrackCurseState tracks a change of the RMN curse state, sourceChain is only set for source chain curses.
+	TrackCurseState(subject string, sourceChain cciptypes.ChainSelector, cursed bool)
+	// TrackMessagesHeldByCurse tracks the number of messages that are currently not executed because their lane is cursed.
+	TrackMessagesHeldByCurse(sourceChain cciptypes.ChainSelector, numMessages int)
 }
 
 type Noop struct{}
@@ -33,5 +38,9 @@ func (n *Noop) TrackProcessorOutput(string, plugincommon.MethodType, plugintypes
 
 func (n *Noop) TrackProcessorLatency(string, plugincommon.MethodType, time.Duration, error) {}
 
+func (n *Noop) TrackCurseState(string, cciptypes.ChainSelector, bool) {}
+
+func (n *Noop) TrackMessagesHeldByCurse(cciptypes.ChainSelector, int) {}
+
 var _ Reporter = &Noop{}
 var _ Reporter = &PromReporter{}
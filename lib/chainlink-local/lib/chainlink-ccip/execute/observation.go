@@ -159,9 +159,9 @@ func (p *Plugin) getCurseInfo(ctx context.Context, lggr logger.Logger) (reader.C
 //   - This prevents duplicate executions while allowing recovery from reorgs
 //
 // 3. For cursed chains:
-//   - All commit reports from cursed source chains are snoozed
-//   - This temporarily prevents execution until the curse is lifted
-//   - Messages become available again after the snooze period if the chain is no longer cursed
+//   - All commit reports from cursed source chains are held back, reports of other lanes are still executed
+//   - Held back reports are kept in the query window, so they are executed automatically once the curse is lifted
+//   - Curse state transitions are recorded by the curse tracker
 func (p *Plugin) getCommitReportsObservation(
 	ctx context.Context,
 	lggr logger.Logger,
@@ -192,13 +192,14 @@ func (p *Plugin) getCommitReportsObservation(
 		// The error is logged by getCurseInfo.
 		return observation, nil
 	}
+	p.curseTracker.update(ci)
 	if ci.GlobalCurse || ci.CursedDestination {
 		lggr.Warnw("nothing to observe: rmn curse", "curseInfo", ci)
 		return observation, nil
 	}
 
 	// Get pending exec reports.
-	groupedCommits, fullyExecutedFinalized, fullyExecutedUnfinalized, latestEmptyRootTimestamp,
+	groupedCommits, fullyExecutedFinalized, fullyExecutedUnfinalized, heldBackCommits, latestEmptyRootTimestamp,
 		err := getPendingReportsForExecution(
 		ctx,
 		p.ccipReader,
@@ -227,8 +228,13 @@ func (p *Plugin) getCommitReportsObservation(
 		p.commitRootsCache.Snooze(fullyExecutedCommit.SourceChain, fullyExecutedCommit.MerkleRoot)
 	}
 
-	// Update the earliest unexecuted root based on remaining reports
-	p.commitRootsCache.UpdateEarliestUnexecutedRoot(buildCombinedReports(groupedCommits, fullyExecutedUnfinalized))
+	// Messages of cursed lanes are held back, other lanes keep executing.
+	p.curseTracker.trackHeldBackCommits(lggr, heldBackCommits)
+
+	// Update the earliest unexecuted root based on remaining reports. Held back reports are included so that
+	// they are queried again and executed automatically once the curse is lifted.
+	p.commitRootsCache.UpdateEarliestUnexecutedRoot(
+		buildCombinedReports(groupedCommits, slices.Concat(fullyExecutedUnfinalized, heldBackCommits)))
 
 	observation.CommitReports = groupedCommits
 
@@ -88,6 +88,8 @@ type Plugin struct {
 	commitRootsCache cache.CommitsRootsCache
 	// inflightMessageCache prevents duplicate reports from being sent for the same message.
 	inflightMessageCache inflightMessageCache
+	// curseTracker records the RMN curse state transitions.
+	curseTracker *curseTracker
 }
 
 func NewPlugin(
@@ -145,6 +147,7 @@ func NewPlugin(
 			offchainCfg.RootSnoozeTime.Duration(),
 		),
 		inflightMessageCache: cache.NewInflightMessageCache(offchainCfg.InflightCacheExpiry.Duration()),
+		curseTracker:         newCurseTracker(logutil.WithComponent(lggr, "CurseTracker"), metricsReporter),
 		ocrTypeCodec:         ocrTypCodec,
 		addrCodec:            addrCodec,
 	}
@@ -261,6 +264,7 @@ func removeUnconfirmedAndFinalizedMessages(
 // - fullyExecutedFinalized: All messages executed with finality (mark as executed)
 // - fullyExecutedUnfinalized: All messages executed but not finalized (snooze)
 // - groupedCommits: Reports with unexecuted messages (available for execution)
+// - heldBackCommits: Pending reports of cursed source chains, they become available once the curse is lifted
 func getPendingReportsForExecution(
 	ctx context.Context,
 	ccipReader readerpkg.CCIPReader,
@@ -272,6 +276,7 @@ func getPendingReportsForExecution(
 	groupedCommits exectypes.CommitObservations,
 	fullyExecutedFinalized []exectypes.CommitData,
 	fullyExecutedUnfinalized []exectypes.CommitData,
+	heldBackCommits []exectypes.CommitData,
 	latestEmptyRootTimestamp time.Time,
 	err error,
 ) {
@@ -280,7 +285,7 @@ func getPendingReportsForExecution(
 		ctx, ts, primitives.Unconfirmed, maxCommitReportsToFetch,
 	)
 	if err != nil {
-		return nil, nil, nil, time.Time{}, err
+		return nil, nil, nil, nil, time.Time{}, err
 	}
 	lggr.Debugw("commit reports", "unfinalizedReports", unfinalizedReports,
 		"count", len(unfinalizedReports))
@@ -289,33 +294,50 @@ func getPendingReportsForExecution(
 		ctx, ts, primitives.Finalized, maxCommitReportsToFetch,
 	)
 	if err != nil {
-		return nil, nil, nil, time.Time{}, err
+		return nil, nil, nil, nil, time.Time{}, err
 	}
 
 	groupedCommits = groupByChainSelectorWithFilter(lggr, unfinalizedReports, cursedSourceChains)
+	heldBackByChain := commitsHeldBackByCurse(unfinalizedReports, cursedSourceChains, canExecute)
 	lggr.Debugw("grouped commits before removing fully executed reports",
 		"groupedCommits", groupedCommits, "count", len(groupedCommits))
 
 	rangesBySelector, executableReports, err := getExecutableReportRanges(lggr, groupedCommits, canExecute)
 	if err != nil {
-		return nil, nil, nil, time.UnixMilli(0), err
+		return nil, nil, nil, nil, time.UnixMilli(0), err
 	}
 
+	// The executed messages of the held back reports are fetched too, so that only the messages
+	// that are still pending are reported as held back.
+	heldBackRanges, heldBackReports, err := getExecutableReportRanges(lggr, heldBackByChain, canExecute)
+	if err != nil {
+		return nil, nil, nil, nil, time.UnixMilli(0), err
+	}
+	maps.Copy(rangesBySelector, heldBackRanges)
+
 	// Get all executed messages
 	unconfirmedMessages, err := ccipReader.ExecutedMessages(ctx, rangesBySelector, primitives.Unconfirmed)
 	if err != nil {
-		return nil, nil, nil, time.UnixMilli(0),
+		return nil, nil, nil, nil, time.UnixMilli(0),
 			fmt.Errorf("get executed messages in range %v: %w", rangesBySelector, err)
 	}
 	// Get finalized messages
 	finalizedMessages, err := ccipReader.ExecutedMessages(ctx, rangesBySelector, primitives.Finalized)
 	if err != nil {
-		return nil, nil, nil, time.UnixMilli(0),
+		return nil, nil, nil, nil, time.UnixMilli(0),
 			fmt.Errorf("get finalized executed messages in range %v: %w", rangesBySelector, err)
 	}
 
 	remainingReportsBySelector, fullyExecutedFinalized, fullyExecutedUnfinalized :=
 		removeUnconfirmedAndFinalizedMessages(executableReports, finalizedMessages, unconfirmedMessages)
+
+	remainingHeldBack, heldBackFinalized, heldBackUnfinalized :=
+		removeUnconfirmedAndFinalizedMessages(heldBackReports, finalizedMessages, unconfirmedMessages)
+	fullyExecutedFinalized = append(fullyExecutedFinalized, heldBackFinalized...)
+	fullyExecutedUnfinalized = append(fullyExecutedUnfinalized, heldBackUnfinalized...)
+	for _, reports := range remainingHeldBack {
+		heldBackCommits = append(heldBackCommits, reports...)
+	}
 	lggr.Debugw("grouped commits after removing fully executed reports",
 		"remainingReportsBySelector", remainingReportsBySelector,
 		"countFinalized", len(fullyExecutedFinalized),
@@ -324,6 +346,7 @@ func getPendingReportsForExecution(
 	return remainingReportsBySelector,
 		fullyExecutedFinalized,
 		fullyExecutedUnfinalized,
+		heldBackCommits,
 		getLatestEmptyRootTimestamp(finalizedReports),
 		nil
 }
@@ -785,11 +808,7 @@ func (p *Plugin) ShouldAcceptAttestedReport(
 
 	// TODO: consider doing this in validateReport,
 	// will end up doing it in both ShouldAccept and ShouldTransmit.
-	sourceChains := slicelib.Map(decodedReport.ChainReports,
-		func(r cciptypes.ExecutePluginReportSingleChain) cciptypes.ChainSelector {
-			return r.SourceChainSelector
-		})
-	isCursed, err := plugincommon.IsReportCursed(ctx, lggr, p.ccipReader, sourceChains)
+	isCursed, err := p.isReportCursed(ctx, lggr, decodedReport)
 	if err != nil {
 		lggr.Errorw(
 			"report not accepted due to curse checking error",
@@ -798,7 +817,7 @@ func (p *Plugin) ShouldAcceptAttestedReport(
 		return false, err
 	}
 	if isCursed {
-		// Detailed logging is already done by IsReportCursed.
+		// Detailed logging is already done by isReportCursed.
 		return false, nil
 	}
 
@@ -830,6 +849,16 @@ func (p *Plugin) ShouldTransmitAcceptedReport(
 	return true, nil
 }
 
+// HealthReport reports the health of the plugin processors, e.g. the discovered contracts rejected because
+// their typeAndVersion does not match the expectations.
+func (p *Plugin) HealthReport() map[string]error {
+	report := make(map[string]error)
+	if hr, ok := p.discovery.(plugincommon.HealthReporter); ok {
+		services.CopyHealth(report, hr.HealthReport())
+	}
+	return report
+}
+
 func (p *Plugin) Close() error {
 	p.lggr.Infow("closing exec plugin")
 
@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"time"
 
@@ -318,6 +319,37 @@ func groupByChainSelectorWithFilter(
 	return commitReportCache
 }
 
+// commitsHeldBackByCurse returns the roots of cursed source chains that would otherwise be executable, grouped by
+// source chain. These roots are skipped by groupByChainSelectorWithFilter and are picked up again once the curse
+// is lifted.
+func commitsHeldBackByCurse(
+	reports []cciptypes.CommitPluginReportWithMeta,
+	cursedSourceChains map[cciptypes.ChainSelector]bool,
+	canExecute CanExecuteHandle,
+) exectypes.CommitObservations {
+	heldBack := make(exectypes.CommitObservations)
+	for _, report := range reports {
+		merkleRoots := slices.Concat(report.Report.BlessedMerkleRoots, report.Report.UnblessedMerkleRoots)
+		for _, singleReport := range merkleRoots {
+			if !cursedSourceChains[singleReport.ChainSel] {
+				continue
+			}
+			if !canExecute(singleReport.ChainSel, singleReport.MerkleRoot) {
+				continue
+			}
+			heldBack[singleReport.ChainSel] = append(heldBack[singleReport.ChainSel], exectypes.CommitData{
+				SourceChain:         singleReport.ChainSel,
+				OnRampAddress:       singleReport.OnRampAddress,
+				Timestamp:           report.Timestamp,
+				BlockNum:            report.BlockNum,
+				MerkleRoot:          singleReport.MerkleRoot,
+				SequenceNumberRange: singleReport.SeqNumsRange,
+			})
+		}
+	}
+	return heldBack
+}
+
 // combineReportsAndMessages returns a new reports slice with fully executed messages removed.
 // Reports that have all of their messages executed are not included in the result.
 // The provided reports must be sorted by sequence number range starting sequence number.
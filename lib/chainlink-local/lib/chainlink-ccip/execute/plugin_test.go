@@ -250,6 +250,7 @@ func Test_getPendingReportsForExecution(t *testing.T) {
 		wantObs                 exectypes.CommitObservations
 		wantExecutedFinalized   []exectypes.CommitData
 		wantExecutedUnfinalized []exectypes.CommitData
+		wantHeldBack            []exectypes.CommitData
 		wantErr                 assert.ErrorAssertionFunc
 	}{
 		{
@@ -634,7 +635,15 @@ func Test_getPendingReportsForExecution(t *testing.T) {
 			wantObs:                 exectypes.CommitObservations{}, // Empty observations since all chains are cursed
 			wantExecutedFinalized:   nil,
 			wantExecutedUnfinalized: nil,
-			wantErr:                 assert.NoError,
+			wantHeldBack: []exectypes.CommitData{
+				{
+					SourceChain:         1,
+					SequenceNumberRange: cciptypes.NewSeqNumRange(1, 10),
+					Timestamp:           time.UnixMilli(10101010101),
+					BlockNum:            999,
+				},
+			},
+			wantErr: assert.NoError,
 		},
 		{
 			name: "multiple chains with one cursed",
@@ -681,7 +690,17 @@ func Test_getPendingReportsForExecution(t *testing.T) {
 			},
 			wantExecutedFinalized:   nil,
 			wantExecutedUnfinalized: nil,
-			wantErr:                 assert.NoError,
+			// the executed messages of the held back report are not held back
+			wantHeldBack: []exectypes.CommitData{
+				{
+					SourceChain:         1,
+					SequenceNumberRange: cciptypes.NewSeqNumRange(1, 10),
+					Timestamp:           time.UnixMilli(10101010101),
+					BlockNum:            1000,
+					ExecutedMessages:    []cciptypes.SeqNum{1, 2, 3, 4},
+				},
+			},
+			wantErr: assert.NoError,
 		},
 		{
 			name: "mixed blessed and unblessed roots with cursed chains",
@@ -728,7 +747,15 @@ func Test_getPendingReportsForExecution(t *testing.T) {
 			},
 			wantExecutedFinalized:   nil,
 			wantExecutedUnfinalized: nil,
-			wantErr:                 assert.NoError,
+			wantHeldBack: []exectypes.CommitData{
+				{
+					SourceChain:         2,
+					SequenceNumberRange: cciptypes.NewSeqNumRange(1, 5),
+					Timestamp:           time.UnixMilli(10101010101),
+					BlockNum:            1000,
+				},
+			},
+			wantErr: assert.NoError,
 		},
 		{
 			name: "nil cursed source chains map",
@@ -807,7 +834,7 @@ func Test_getPendingReportsForExecution(t *testing.T) {
 			}
 			mockReader.On("ExecutedMessages", mock.Anything, mock.Anything, primitives.Unconfirmed).Return(unfinalized, nil)
 
-			got, gotFinalized, gotUnfinalized, _, err := getPendingReportsForExecution(
+			got, gotFinalized, gotUnfinalized, gotHeldBack, _, err := getPendingReportsForExecution(
 				tests.Context(t),
 				mockReader,
 				tt.canExec,
@@ -821,6 +848,7 @@ func Test_getPendingReportsForExecution(t *testing.T) {
 			assert.Equalf(t, tt.wantObs, got, "getPendingReportsForExecution(...)")
 			assert.Equalf(t, tt.wantExecutedFinalized, gotFinalized, "getPendingReportsForExecution(...)")
 			assert.Equalf(t, tt.wantExecutedUnfinalized, gotUnfinalized, "getPendingReportsForExecution(...)")
+			assert.Equalf(t, tt.wantHeldBack, gotHeldBack, "getPendingReportsForExecution(...)")
 		})
 	}
 }
@@ -1496,10 +1524,11 @@ func TestPlugin_ShouldAcceptAttestedReport_ShouldAccept(t *testing.T) {
 			codec, homeChain, ccipReader := tc.getDeps()
 			lggr, obs := logger.TestObserved(t, zapcore.DebugLevel)
 			p := &Plugin{
-				lggr:        lggr,
-				reportCodec: codec,
-				homeChain:   homeChain,
-				ccipReader:  ccipReader,
+				lggr:         lggr,
+				reportCodec:  codec,
+				homeChain:    homeChain,
+				ccipReader:   ccipReader,
+				curseTracker: newCurseTracker(lggr, &metrics.Noop{}),
 				chainSupport: plugincommon.NewChainSupport(
 					logger.Test(t),
 					homeChain,
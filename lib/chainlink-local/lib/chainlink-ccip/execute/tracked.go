@@ -38,6 +38,14 @@ func NewTrackedPlugin(
 	}
 }
 
+// HealthReport returns the health report of the wrapped plugin, if it provides one.
+func (p *TrackedPlugin) HealthReport() map[string]error {
+	if hr, ok := p.ReportingPlugin.(plugincommon.HealthReporter); ok {
+		return hr.HealthReport()
+	}
+	return map[string]error{}
+}
+
 func (p *TrackedPlugin) Query(
 	ctx context.Context, outctx ocr3types.OutcomeContext,
 ) (types.Query, error) {
@@ -0,0 +1,160 @@
+package configdiff
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// FieldDiff describes a single field that changed between two versions of a config.
+type FieldDiff struct {
+	// Path is the dot separated path of the field, e.g. "Config.OptimisticConfirmations".
+	// Map entries and slice elements are addressed as "Field[key]" and "Field[index]".
+	Path string
+	// Old is the formatted value before the change, empty if the field did not exist.
+	Old string
+	// New is the formatted value after the change, empty if the field no longer exists.
+	New string
+}
+
+func (d FieldDiff) String() string {
+	return fmt.Sprintf("%s: %s -> %s", d.Path, d.Old, d.New)
+}
+
+// Diff walks the old and new values and returns the fields that differ, sorted by path.
+// Structs, maps, slices and arrays are compared element by element, byte slices and byte arrays
+// are compared as a whole and formatted as hex. Values implementing fmt.Stringer are compared by
+// their string representation. Sets exposing a ToSlice method (e.g. mapset.Set) are compared as sorted sets.
+// Unexported struct fields are ignored.
+func Diff(old, new any) []FieldDiff {
+	diffs := make([]FieldDiff, 0)
+	walk("", reflect.ValueOf(old), reflect.ValueOf(new), &diffs)
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Path < diffs[j].Path })
+	return diffs
+}
+
+var stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+
+func walk(path string, a, b reflect.Value, diffs *[]FieldDiff) {
+	if !a.IsValid() || !b.IsValid() || a.Type() != b.Type() {
+		appendIfChanged(path, format(a), format(b), diffs)
+		return
+	}
+
+	if isLeaf(a) {
+		appendIfChanged(path, format(a), format(b), diffs)
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			appendIfChanged(path, format(a), format(b), diffs)
+			return
+		}
+		walk(path, a.Elem(), b.Elem(), diffs)
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			field := a.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			walk(join(path, field.Name), a.Field(i), b.Field(i), diffs)
+		}
+	case reflect.Map:
+		keys := make(map[string]reflect.Value)
+		for _, k := range a.MapKeys() {
+			keys[format(k)] = k
+		}
+		for _, k := range b.MapKeys() {
+			keys[format(k)] = k
+		}
+		for name, k := range keys {
+			walk(fmt.Sprintf("%s[%s]", path, name), a.MapIndex(k), b.MapIndex(k), diffs)
+		}
+	case reflect.Slice, reflect.Array:
+		n := max(a.Len(), b.Len())
+		for i := 0; i < n; i++ {
+			var ea, eb reflect.Value
+			if i < a.Len() {
+				ea = a.Index(i)
+			}
+			if i < b.Len() {
+				eb = b.Index(i)
+			}
+			walk(fmt.Sprintf("%s[%d]", path, i), ea, eb, diffs)
+		}
+	default:
+		appendIfChanged(path, format(a), format(b), diffs)
+	}
+}
+
+// isLeaf returns true if the value should be compared as a whole instead of being traversed.
+func isLeaf(v reflect.Value) bool {
+	if isBytes(v) || toSlice(v).IsValid() {
+		return true
+	}
+	if (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) && v.IsNil() {
+		return false
+	}
+	return v.Type().Implements(stringerType)
+}
+
+func isBytes(v reflect.Value) bool {
+	return (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) && v.Type().Elem().Kind() == reflect.Uint8
+}
+
+// toSlice returns the result of the ToSlice method of set-like values, or an invalid value if there is none.
+func toSlice(v reflect.Value) reflect.Value {
+	if (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) && v.IsNil() {
+		return reflect.Value{}
+	}
+	m := v.MethodByName("ToSlice")
+	if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 || m.Type().Out(0).Kind() != reflect.Slice {
+		return reflect.Value{}
+	}
+	return m.Call(nil)[0]
+}
+
+func format(v reflect.Value) string {
+	if !v.IsValid() {
+		return ""
+	}
+	if (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface || v.Kind() == reflect.Map ||
+		v.Kind() == reflect.Slice) && v.IsNil() {
+		return "<nil>"
+	}
+
+	if isBytes(v) {
+		b := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(b), v)
+		return "0x" + hex.EncodeToString(b)
+	}
+
+	if s := toSlice(v); s.IsValid() {
+		elems := make([]string, s.Len())
+		for i := range elems {
+			elems[i] = format(s.Index(i))
+		}
+		sort.Strings(elems)
+		return "{" + strings.Join(elems, ", ") + "}"
+	}
+
+	return fmt.Sprintf("%v", v.Interface())
+}
+
+func appendIfChanged(path, old, new string, diffs *[]FieldDiff) {
+	if old == new {
+		return
+	}
+	*diffs = append(*diffs, FieldDiff{Path: path, Old: old, New: new})
+}
+
+func join(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
@@ -0,0 +1,78 @@
+package configdiff
+
+import (
+	"testing"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+type inner struct {
+	Confirmations uint32
+	Deviation     cciptypes.BigInt
+}
+
+type cfg struct {
+	F        int
+	Digest   [4]byte
+	Address  []byte
+	Nodes    mapset.Set[string]
+	Inner    inner
+	PerChain map[cciptypes.ChainSelector]int
+	List     []string
+	hidden   int
+}
+
+func TestDiff(t *testing.T) {
+	base := cfg{
+		F:        1,
+		Digest:   [4]byte{1, 2, 3, 4},
+		Address:  []byte{0xaa},
+		Nodes:    mapset.NewSet("a", "b"),
+		Inner:    inner{Confirmations: 1, Deviation: cciptypes.NewBigIntFromInt64(10)},
+		PerChain: map[cciptypes.ChainSelector]int{1: 1},
+		List:     []string{"x"},
+		hidden:   1,
+	}
+
+	t.Run("equal", func(t *testing.T) {
+		other := base
+		other.Nodes = mapset.NewSet("b", "a")
+		other.Inner.Deviation = cciptypes.NewBigIntFromInt64(10)
+		other.hidden = 2
+		assert.Empty(t, Diff(base, other))
+	})
+
+	t.Run("changed fields", func(t *testing.T) {
+		other := base
+		other.F = 2
+		other.Digest = [4]byte{1, 2, 3, 5}
+		other.Nodes = mapset.NewSet("a", "c")
+		other.Inner = inner{Confirmations: 2, Deviation: cciptypes.NewBigIntFromInt64(20)}
+		other.PerChain = map[cciptypes.ChainSelector]int{2: 1}
+		other.List = []string{"x", "y"}
+
+		diffs := Diff(base, other)
+		require.Equal(t, []FieldDiff{
+			{Path: "Digest", Old: "0x01020304", New: "0x01020305"},
+			{Path: "F", Old: "1", New: "2"},
+			{Path: "Inner.Confirmations", Old: "1", New: "2"},
+			{Path: "Inner.Deviation", Old: "10", New: "20"},
+			{Path: "List[1]", Old: "", New: "y"},
+			{Path: "Nodes", Old: "{a, b}", New: "{a, c}"},
+			{Path: "PerChain[ChainSelector(1)]", Old: "1", New: ""},
+			{Path: "PerChain[ChainSelector(2)]", Old: "", New: "1"},
+		}, diffs)
+	})
+
+	t.Run("from zero value", func(t *testing.T) {
+		diffs := Diff(cfg{}, cfg{F: 1, Address: []byte{0xbb}})
+		require.Equal(t, []FieldDiff{
+			{Path: "Address", Old: "<nil>", New: "0xbb"},
+			{Path: "F", Old: "0", New: "1"},
+		}, diffs)
+	})
+}
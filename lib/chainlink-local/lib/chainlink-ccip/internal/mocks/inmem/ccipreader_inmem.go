@@ -215,6 +215,13 @@ func (r InMemoryCCIPReader) LinkPriceUSD(ctx context.Context) (cciptypes.BigInt,
 	return cciptypes.NewBigIntFromInt64(100), nil
 }
 
+func (r InMemoryCCIPReader) GetContractTypeAndVersions(
+	_ context.Context,
+	_ reader.ContractAddresses,
+) (map[string]map[cciptypes.ChainSelector]string, error) {
+	return nil, nil
+}
+
 // Sync can be used to perform frequent syncing operations inside the reader implementation.
 // Returns a bool indicating whether something was updated.
 func (r InMemoryCCIPReader) Sync(_ context.Context, _ reader.ContractAddresses) error {
@@ -3,6 +3,7 @@ package discovery
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/smartcontractkit/libocr/commontypes"
 	ragep2ptypes "github.com/smartcontractkit/libocr/ragep2p/types"
@@ -30,6 +31,14 @@ type ContractDiscoveryProcessor struct {
 	dest            cciptypes.ChainSelector
 	fRoleDON        int
 	oracleIDToP2PID map[commontypes.OracleID]ragep2ptypes.PeerID
+	expectations    map[string]ContractExpectation
+
+	// typeAndVersions caches the typeAndVersion of the discovered contracts, only accessed by Outcome.
+	typeAndVersions typeAndVersionCache
+
+	mu sync.RWMutex
+	// mismatches are the contracts rejected by the last outcome because of their typeAndVersion.
+	mismatches map[string]error
 }
 
 func NewContractDiscoveryProcessor(
@@ -48,6 +57,9 @@ func NewContractDiscoveryProcessor(
 		dest:            dest,
 		fRoleDON:        fRoleDON,
 		oracleIDToP2PID: oracleIDToP2PID,
+		expectations:    DefaultContractExpectations,
+		typeAndVersions: make(typeAndVersionCache),
+		mismatches:      make(map[string]error),
 	}
 	return plugincommon.NewTrackedProcessor(lggr, p, "discovery", reporter)
 }
@@ -337,6 +349,12 @@ func (cdp *ContractDiscoveryProcessor) Outcome(
 	}
 	contracts[consts.ContractNameRouter] = routerConsensus
 
+	// Reject the contracts that are not of the expected type and major version before binding them.
+	mismatches := verifyContracts(ctx, lggr, *cdp.reader, cdp.expectations, cdp.typeAndVersions, contracts)
+	cdp.mu.Lock()
+	cdp.mismatches = mismatches
+	cdp.mu.Unlock()
+
 	// call Sync to bind contracts.
 	// NOTE: since Sync may make network calls, it could potentially fail and we don't want to
 	// fail the entire outcome because of that. The reason being is that if this node is a leader
@@ -352,6 +370,19 @@ func (cdp *ContractDiscoveryProcessor) Outcome(
 	return dt.Outcome{}, nil
 }
 
+// HealthReport reports the discovered contracts that were rejected because their typeAndVersion
+// does not match the expected contract type or major version.
+func (cdp *ContractDiscoveryProcessor) HealthReport() map[string]error {
+	cdp.mu.RLock()
+	defer cdp.mu.RUnlock()
+
+	report := make(map[string]error, len(cdp.mismatches))
+	for name, err := range cdp.mismatches {
+		report["discovery:"+name] = err
+	}
+	return report
+}
+
 func (cdp *ContractDiscoveryProcessor) Close() error {
 	return nil
 }
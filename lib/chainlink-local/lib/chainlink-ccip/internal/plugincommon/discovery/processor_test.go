@@ -1,6 +1,7 @@
 package discovery
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -240,6 +241,10 @@ func TestContractDiscoveryProcessor_Outcome_HappyPath(t *testing.T) {
 		},
 		consts.ContractNameRouter: {},
 	}
+	mockReader.
+		EXPECT().
+		GetContractTypeAndVersions(mock.Anything, mock.Anything).
+		RunAndReturn(readExpectedTypeAndVersions)
 	mockReader.
 		EXPECT().
 		Sync(mock.Anything, expectedContracts).
@@ -323,6 +328,10 @@ func TestContractDiscovery_Outcome_HappyPath_FRoleDONAndFDestChainAreDifferent(t
 		},
 		consts.ContractNameFeeQuoter: {}, // no consensus
 	}
+	mockReader.
+		EXPECT().
+		GetContractTypeAndVersions(mock.Anything, mock.Anything).
+		RunAndReturn(readExpectedTypeAndVersions)
 	mockReader.
 		EXPECT().
 		Sync(mock.Anything, expectedContracts).
@@ -499,6 +508,10 @@ func TestContractDiscoveryProcessor_Outcome_ErrorSyncingContracts(t *testing.T)
 		consts.ContractNameRouter:    {},
 	}
 	syncErr := errors.New("sync error")
+	mockReader.
+		EXPECT().
+		GetContractTypeAndVersions(mock.Anything, mock.Anything).
+		RunAndReturn(readExpectedTypeAndVersions)
 	mockReader.
 		EXPECT().
 		Sync(mock.Anything, expectedContracts).
@@ -541,6 +554,120 @@ func TestContractDiscoveryProcessor_Outcome_ErrorSyncingContracts(t *testing.T)
 	require.Equal(t, outcome, discoverytypes.Outcome{})
 }
 
+func TestContractDiscoveryProcessor_Outcome_RejectsMismatchedTypeAndVersion(t *testing.T) {
+	mockReader := mock_reader.NewMockCCIPReader(t)
+	mockReaderIface := reader.CCIPReader(mockReader)
+	mockHomeChain := mock_home_chain.NewMockHomeChain(t)
+	lggr := logger.Test(t)
+	dest := cciptypes.ChainSelector(1)
+	fRoleDON := 1
+
+	onRamp := cciptypes.UnknownAddress("onRamp")
+	nonceManager := cciptypes.UnknownAddress("nonceManager")
+	rmnRemote := cciptypes.UnknownAddress("rmnRemote")
+	router := cciptypes.UnknownAddress("router")
+
+	// the router could not be read, it is left unverified instead of being rejected
+	mockReader.
+		EXPECT().
+		GetContractTypeAndVersions(mock.Anything, reader.ContractAddresses{
+			consts.ContractNameOnRamp:       {dest: onRamp},
+			consts.ContractNameNonceManager: {dest: nonceManager},
+			consts.ContractNameRMNRemote:    {dest: rmnRemote},
+			consts.ContractNameRouter:       {dest: router},
+		}).
+		Return(map[string]map[cciptypes.ChainSelector]string{
+			consts.ContractNameOnRamp:       {dest: "OnRamp 1.6.0"},
+			consts.ContractNameNonceManager: {dest: "OffRamp 1.6.0"},
+			consts.ContractNameRMNRemote:    {dest: "RMNRemote 2.0.0"},
+		}, errors.New("rpc error")).
+		Once()
+	mockReader.
+		EXPECT().
+		Sync(mock.Anything, reader.ContractAddresses{
+			consts.ContractNameOnRamp:       {dest: onRamp},
+			consts.ContractNameNonceManager: {},
+			consts.ContractNameRMNRemote:    {},
+			consts.ContractNameFeeQuoter:    {},
+			consts.ContractNameRouter:       {dest: router},
+		}).
+		Return(nil).
+		Once()
+	defer mockReader.AssertExpectations(t)
+
+	cdp := internalNewContractDiscoveryProcessor(
+		lggr,
+		&mockReaderIface,
+		mockHomeChain,
+		dest,
+		fRoleDON,
+		nil, // oracleIDToP2PID, not needed for this test
+	)
+
+	obs := discoverytypes.Observation{
+		FChain: map[cciptypes.ChainSelector]int{dest: 1},
+		Addresses: map[string]map[cciptypes.ChainSelector]cciptypes.UnknownAddress{
+			consts.ContractNameOnRamp:       {dest: onRamp},
+			consts.ContractNameNonceManager: {dest: nonceManager},
+			consts.ContractNameRMNRemote:    {dest: rmnRemote},
+			consts.ContractNameRouter:       {dest: router},
+		},
+	}
+	aos := []plugincommon.AttributedObservation[discoverytypes.Observation]{
+		{Observation: obs},
+		{Observation: obs},
+		{Observation: obs},
+	}
+
+	ctx := tests.Context(t)
+	_, err := cdp.Outcome(ctx, discoverytypes.Outcome{}, discoverytypes.Query{}, aos)
+	require.NoError(t, err)
+
+	tracked, ok := cdp.(*plugincommon.TrackedProcessor[
+		discoverytypes.Query, discoverytypes.Observation, discoverytypes.Outcome])
+	require.True(t, ok)
+	report := tracked.HealthReport()
+	require.Len(t, report, 2)
+	assert.ErrorContains(t, report["discovery:NonceManager:1"], "unexpected contract type")
+	assert.ErrorContains(t, report["discovery:RMNRemote:1"], "unexpected major version")
+	assert.NotContains(t, report, "discovery:Router:1")
+
+	// the typeAndVersion of the onramp is cached, the unverified router and the new nonce manager are read again
+	newNonceManager := cciptypes.UnknownAddress("newNonceManager")
+	obs.Addresses[consts.ContractNameNonceManager] = map[cciptypes.ChainSelector]cciptypes.UnknownAddress{
+		dest: newNonceManager,
+	}
+	mockReader.
+		EXPECT().
+		GetContractTypeAndVersions(mock.Anything, reader.ContractAddresses{
+			consts.ContractNameNonceManager: {dest: newNonceManager},
+			consts.ContractNameRouter:       {dest: router},
+		}).
+		Return(map[string]map[cciptypes.ChainSelector]string{
+			consts.ContractNameNonceManager: {dest: "NonceManager 1.6.0"},
+			consts.ContractNameRouter:       {dest: "Router 1.2.0"},
+		}, nil).
+		Once()
+	mockReader.
+		EXPECT().
+		Sync(mock.Anything, reader.ContractAddresses{
+			consts.ContractNameOnRamp:       {dest: onRamp},
+			consts.ContractNameNonceManager: {dest: newNonceManager},
+			consts.ContractNameRMNRemote:    {},
+			consts.ContractNameFeeQuoter:    {},
+			consts.ContractNameRouter:       {dest: router},
+		}).
+		Return(nil).
+		Once()
+
+	_, err = cdp.Outcome(ctx, discoverytypes.Outcome{}, discoverytypes.Query{}, aos)
+	require.NoError(t, err)
+
+	report = tracked.HealthReport()
+	require.Len(t, report, 1)
+	assert.ErrorContains(t, report["discovery:RMNRemote:1"], "unexpected major version")
+}
+
 func TestContractDiscoveryProcessor_ValidateObservation_HappyPath(t *testing.T) {
 	mockHomeChain := mock_home_chain.NewMockHomeChain(t)
 	lggr := logger.Test(t)
@@ -778,6 +905,21 @@ var dummyObservation = discoverytypes.Observation{
 	},
 }
 
+// readExpectedTypeAndVersions returns the expected typeAndVersion of all the contracts.
+func readExpectedTypeAndVersions(
+	_ context.Context, contracts reader.ContractAddresses,
+) (map[string]map[cciptypes.ChainSelector]string, error) {
+	typeAndVersions := make(map[string]map[cciptypes.ChainSelector]string)
+	for contractName, chainSelToAddress := range contracts {
+		expected := DefaultContractExpectations[contractName]
+		typeAndVersions[contractName] = make(map[cciptypes.ChainSelector]string)
+		for chainSel := range chainSelToAddress {
+			typeAndVersions[contractName][chainSel] = fmt.Sprintf("%s %d.6.0", expected.Type, expected.MajorVersion)
+		}
+	}
+	return typeAndVersions, nil
+}
+
 func internalNewContractDiscoveryProcessor(
 	lggr logger.Logger,
 	reader *reader.CCIPReader,
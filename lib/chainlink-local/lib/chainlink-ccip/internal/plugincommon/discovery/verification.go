@@ -0,0 +1,167 @@
+package discovery
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// ContractExpectation is the type and major version a discovered contract must report via typeAndVersion().
+type ContractExpectation struct {
+	Type         string
+	MajorVersion int
+}
+
+// DefaultContractExpectations are the contract types and major versions supported by the plugins,
+// keyed by contract name.
+var DefaultContractExpectations = map[string]ContractExpectation{
+	consts.ContractNameOnRamp:       {Type: "OnRamp", MajorVersion: 1},
+	consts.ContractNameOffRamp:      {Type: "OffRamp", MajorVersion: 1},
+	consts.ContractNameFeeQuoter:    {Type: "FeeQuoter", MajorVersion: 1},
+	consts.ContractNameRouter:       {Type: "Router", MajorVersion: 1},
+	consts.ContractNameNonceManager: {Type: "NonceManager", MajorVersion: 1},
+	consts.ContractNameRMNRemote:    {Type: "RMNRemote", MajorVersion: 1},
+}
+
+// parseTypeAndVersion splits a typeAndVersion string, e.g. "OnRamp 1.6.0", into the contract type and
+// the major version.
+func parseTypeAndVersion(typeAndVersion string) (string, int, error) {
+	fields := strings.Fields(typeAndVersion)
+	if len(fields) < 2 {
+		return "", 0, fmt.Errorf("invalid typeAndVersion %q", typeAndVersion)
+	}
+
+	version := strings.TrimPrefix(fields[len(fields)-1], "v")
+	major, err := strconv.Atoi(strings.Split(version, ".")[0])
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid version in typeAndVersion %q: %w", typeAndVersion, err)
+	}
+
+	return strings.Join(fields[:len(fields)-1], " "), major, nil
+}
+
+// checkTypeAndVersion returns an error if typeAndVersion does not match the expectation.
+func checkTypeAndVersion(expected ContractExpectation, typeAndVersion string) error {
+	contractType, major, err := parseTypeAndVersion(typeAndVersion)
+	if err != nil {
+		return err
+	}
+	if contractType != expected.Type {
+		return fmt.Errorf("unexpected contract type %q, expected %q", contractType, expected.Type)
+	}
+	if major != expected.MajorVersion {
+		return fmt.Errorf("unexpected major version %d of %s, expected %d", major, contractType, expected.MajorVersion)
+	}
+	return nil
+}
+
+// verifiedContract is the typeAndVersion read from a discovered contract address.
+type verifiedContract struct {
+	address        cciptypes.UnknownAddress
+	typeAndVersion string
+}
+
+// typeAndVersionCache caches the typeAndVersion of the discovered contracts, keyed by contract name and chain.
+// Contract addresses rarely change, so typeAndVersion is only read again when the discovered address changes.
+type typeAndVersionCache map[string]map[cciptypes.ChainSelector]verifiedContract
+
+// get returns the cached typeAndVersion of the contract if it was read from the same address.
+func (c typeAndVersionCache) get(
+	contractName string, chainSel cciptypes.ChainSelector, address cciptypes.UnknownAddress,
+) (string, bool) {
+	cached, ok := c[contractName][chainSel]
+	if !ok || !bytes.Equal(cached.address, address) {
+		return "", false
+	}
+	return cached.typeAndVersion, true
+}
+
+func (c typeAndVersionCache) set(
+	contractName string, chainSel cciptypes.ChainSelector, address cciptypes.UnknownAddress, typeAndVersion string,
+) {
+	if c[contractName] == nil {
+		c[contractName] = make(map[cciptypes.ChainSelector]verifiedContract)
+	}
+	c[contractName][chainSel] = verifiedContract{address: address, typeAndVersion: typeAndVersion}
+}
+
+// verifyContracts checks the typeAndVersion() of the discovered contracts and removes the ones that do not match
+// the expectations from contracts. Only the addresses missing from the cache are read. Verification fails open:
+// contracts whose typeAndVersion could not be read, e.g. because the chain has no contract reader or the
+// typeAndVersion binding is not configured, are left as they are. The rejected contracts are returned keyed by
+// "<contract>:<chain>".
+func verifyContracts(
+	ctx context.Context,
+	lggr logger.Logger,
+	ccipReader reader.CCIPReader,
+	expectations map[string]ContractExpectation,
+	cache typeAndVersionCache,
+	contracts reader.ContractAddresses,
+) map[string]error {
+	toRead := make(reader.ContractAddresses)
+	for contractName, chainSelToAddress := range contracts {
+		if _, ok := expectations[contractName]; !ok {
+			continue
+		}
+		for chainSel, address := range chainSelToAddress {
+			if _, ok := cache.get(contractName, chainSel, address); ok {
+				continue
+			}
+			if toRead[contractName] == nil {
+				toRead[contractName] = make(map[cciptypes.ChainSelector]cciptypes.UnknownAddress)
+			}
+			toRead[contractName][chainSel] = address
+		}
+	}
+
+	if len(toRead) > 0 {
+		typeAndVersions, err := ccipReader.GetContractTypeAndVersions(ctx, toRead)
+		if err != nil {
+			lggr.Warnw("unable to read typeAndVersion of some contracts, they are not verified", "err", err)
+		}
+		for contractName, chainSelToAddress := range toRead {
+			for chainSel, address := range chainSelToAddress {
+				if typeAndVersion, ok := typeAndVersions[contractName][chainSel]; ok {
+					cache.set(contractName, chainSel, address, typeAndVersion)
+				}
+			}
+		}
+	}
+
+	mismatches := make(map[string]error)
+	for contractName, chainSelToAddress := range contracts {
+		expected, ok := expectations[contractName]
+		if !ok {
+			continue
+		}
+
+		for chainSel, address := range chainSelToAddress {
+			typeAndVersion, ok := cache.get(contractName, chainSel, address)
+			if !ok {
+				// typeAndVersion could not be read, the contract is left unverified.
+				continue
+			}
+			if err := checkTypeAndVersion(expected, typeAndVersion); err != nil {
+				lggr.Errorw("rejecting discovered contract, typeAndVersion does not match",
+					"contract", contractName,
+					"chain", chainSel,
+					"address", address,
+					"typeAndVersion", typeAndVersion,
+					"err", err)
+				mismatches[fmt.Sprintf("%s:%d", contractName, chainSel)] =
+					fmt.Errorf("%s at %s: %w", contractName, address, err)
+				delete(chainSelToAddress, chainSel)
+			}
+		}
+	}
+
+	return mismatches
+}
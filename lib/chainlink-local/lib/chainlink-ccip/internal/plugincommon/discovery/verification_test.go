@@ -0,0 +1,36 @@
+package discovery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckTypeAndVersion(t *testing.T) {
+	expected := ContractExpectation{Type: "OnRamp", MajorVersion: 1}
+
+	testCases := []struct {
+		name           string
+		typeAndVersion string
+		expErr         string
+	}{
+		{name: "matching", typeAndVersion: "OnRamp 1.6.0"},
+		{name: "dev version", typeAndVersion: "OnRamp 1.6.0-dev"},
+		{name: "wrong type", typeAndVersion: "OffRamp 1.6.0", expErr: "unexpected contract type"},
+		{name: "wrong major version", typeAndVersion: "OnRamp 2.0.0", expErr: "unexpected major version"},
+		{name: "no version", typeAndVersion: "OnRamp", expErr: "invalid typeAndVersion"},
+		{name: "invalid version", typeAndVersion: "OnRamp x.y.z", expErr: "invalid version"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkTypeAndVersion(expected, tc.typeAndVersion)
+			if tc.expErr != "" {
+				assert.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -82,6 +82,19 @@ func (p *TrackedProcessor[Query, Observation, Outcome]) Outcome(
 	return out, err
 }
 
+// HealthReporter is implemented by the processors reporting their health, e.g. the contract discovery.
+type HealthReporter interface {
+	HealthReport() map[string]error
+}
+
+// HealthReport returns the health report of the wrapped processor, if it provides one.
+func (p *TrackedProcessor[Query, Observation, Outcome]) HealthReport() map[string]error {
+	if hr, ok := p.PluginProcessor.(HealthReporter); ok {
+		return hr.HealthReport()
+	}
+	return map[string]error{}
+}
+
 func withTrackedMethod[T any, Query any, Observation plugintypes.Trackable, Outcome plugintypes.Trackable](
 	p *TrackedProcessor[Query, Observation, Outcome],
 	method string,
@@ -0,0 +1,205 @@
+package reader
+
+import (
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/internal/libs/configdiff"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// configChangeBufferSize is the number of events buffered per subscriber, events are dropped
+// for subscribers that fall further behind.
+const configChangeBufferSize = 100
+
+// ConfigKind identifies the config a ConfigChangeEvent refers to.
+type ConfigKind string
+
+const (
+	// ConfigKindChainConfig is a CCIPHome chain config of a single chain.
+	ConfigKindChainConfig ConfigKind = "chainConfig"
+	// ConfigKindOCR3Config is the active or candidate OCR3 config of a DON and plugin type.
+	ConfigKindOCR3Config ConfigKind = "ocr3Config"
+	// ConfigKindRMNHomeConfig is the active or candidate RMNHome config.
+	ConfigKindRMNHomeConfig ConfigKind = "rmnHomeConfig"
+	// ConfigKindSourceChainConfig is the offramp source chain config of a single source chain.
+	ConfigKindSourceChainConfig ConfigKind = "sourceChainConfig"
+)
+
+// ConfigChangeType describes how the config changed.
+type ConfigChangeType string
+
+const (
+	ConfigAdded   ConfigChangeType = "added"
+	ConfigUpdated ConfigChangeType = "updated"
+	ConfigRemoved ConfigChangeType = "removed"
+	// ConfigPromoted is used when the candidate config became the active config.
+	ConfigPromoted ConfigChangeType = "promoted"
+)
+
+// ConfigChangeEvent is emitted by the config pollers when they observe a change of a config.
+type ConfigChangeEvent struct {
+	Timestamp  time.Time
+	Kind       ConfigKind
+	ChangeType ConfigChangeType
+	// ChainSelector is the chain of a chain config or the destination chain of a source chain config.
+	ChainSelector cciptypes.ChainSelector
+	// SourceChainSelector is only set for ConfigKindSourceChainConfig.
+	SourceChainSelector cciptypes.ChainSelector
+	// DonID and PluginType are only set for ConfigKindOCR3Config.
+	DonID      uint32
+	PluginType uint8
+	// Diff contains the fields that changed, for added and removed configs all the non-zero fields are included.
+	Diff []configdiff.FieldDiff
+}
+
+// ConfigChangeFeed fans out config change events to its subscribers. Sending never blocks,
+// if a subscriber does not keep up the event is dropped for that subscriber and a warning is logged.
+type ConfigChangeFeed struct {
+	lggr   logger.Logger
+	mu     sync.Mutex
+	subs   map[int]chan ConfigChangeEvent
+	nextID int
+}
+
+func NewConfigChangeFeed(lggr logger.Logger) *ConfigChangeFeed {
+	return &ConfigChangeFeed{
+		lggr: lggr,
+		subs: make(map[int]chan ConfigChangeEvent),
+	}
+}
+
+// Subscribe returns a channel that receives all the events sent after the call and a function
+// that cancels the subscription and closes the channel.
+func (f *ConfigChangeFeed) Subscribe() (<-chan ConfigChangeEvent, func()) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	id := f.nextID
+	f.nextID++
+	ch := make(chan ConfigChangeEvent, configChangeBufferSize)
+	f.subs[id] = ch
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			delete(f.subs, id)
+			close(ch)
+		})
+	}
+}
+
+// Send logs the events and delivers them to all the subscribers.
+func (f *ConfigChangeFeed) Send(events ...ConfigChangeEvent) {
+	if len(events) == 0 {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, ev := range events {
+		f.lggr.Infow("config change detected",
+			"kind", ev.Kind,
+			"changeType", ev.ChangeType,
+			"chainSelector", ev.ChainSelector,
+			"sourceChainSelector", ev.SourceChainSelector,
+			"donID", ev.DonID,
+			"pluginType", ev.PluginType,
+			"diff", ev.Diff,
+		)
+		for id, ch := range f.subs {
+			select {
+			case ch <- ev:
+			default:
+				f.lggr.Warnw("config change subscriber is not keeping up, dropping event",
+					"subscription", id, "kind", ev.Kind)
+			}
+		}
+	}
+}
+
+// DiffConfigMaps compares two versions of a map of configs and returns an event for every added,
+// updated or removed entry. newEvent is used to set the identifying fields of the event.
+func DiffConfigMaps[K comparable, V any](
+	old, new map[K]V,
+	newEvent func(key K, changeType ConfigChangeType, diff []configdiff.FieldDiff) ConfigChangeEvent,
+) []ConfigChangeEvent {
+	var events []ConfigChangeEvent
+	for key, newCfg := range new {
+		oldCfg, exists := old[key]
+		if !exists {
+			var zero V
+			events = append(events, newEvent(key, ConfigAdded, configdiff.Diff(zero, newCfg)))
+			continue
+		}
+		if diff := configdiff.Diff(oldCfg, newCfg); len(diff) > 0 {
+			events = append(events, newEvent(key, ConfigUpdated, diff))
+		}
+	}
+	for key, oldCfg := range old {
+		if _, exists := new[key]; !exists {
+			var zero V
+			events = append(events, newEvent(key, ConfigRemoved, configdiff.Diff(oldCfg, zero)))
+		}
+	}
+	return events
+}
+
+// DiffChainConfigs returns the chain config change events between two versions of the home chain configs.
+func DiffChainConfigs(old, new map[cciptypes.ChainSelector]ChainConfig) []ConfigChangeEvent {
+	now := time.Now().UTC()
+	return DiffConfigMaps(old, new,
+		func(chain cciptypes.ChainSelector, ct ConfigChangeType, diff []configdiff.FieldDiff) ConfigChangeEvent {
+			return ConfigChangeEvent{
+				Timestamp:     now,
+				Kind:          ConfigKindChainConfig,
+				ChangeType:    ct,
+				ChainSelector: chain,
+				Diff:          diff,
+			}
+		})
+}
+
+// DiffActiveAndCandidate returns the change events between two versions of an active/candidate config pair
+// identified by their digests. A promotion (the previous candidate became the active config) is reported as a
+// single ConfigPromoted event, the diff then contains the changes between the previous and the new active config.
+// Otherwise, changes of the active and the candidate configs are reported with the "Active" and "Candidate" path
+// prefixes. template is used for the identifying fields of the event.
+func DiffActiveAndCandidate[T any](
+	template ConfigChangeEvent,
+	oldActiveDigest, oldCandidateDigest [32]byte, oldActive, oldCandidate T,
+	newActiveDigest, newCandidateDigest [32]byte, newActive, newCandidate T,
+) []ConfigChangeEvent {
+	type pair struct {
+		Active    T
+		Candidate T
+	}
+
+	diff := configdiff.Diff(pair{oldActive, oldCandidate}, pair{newActive, newCandidate})
+	if len(diff) == 0 {
+		return nil
+	}
+
+	ev := template
+	ev.Timestamp = time.Now().UTC()
+	ev.Diff = diff
+
+	var empty [32]byte
+	switch {
+	case oldActiveDigest == empty && oldCandidateDigest == empty:
+		ev.ChangeType = ConfigAdded
+	case newActiveDigest == empty && newCandidateDigest == empty:
+		ev.ChangeType = ConfigRemoved
+	case oldCandidateDigest != empty && newActiveDigest == oldCandidateDigest:
+		ev.ChangeType = ConfigPromoted
+		ev.Diff = configdiff.Diff(oldActive, newActive)
+	default:
+		ev.ChangeType = ConfigUpdated
+	}
+	return []ConfigChangeEvent{ev}
+}
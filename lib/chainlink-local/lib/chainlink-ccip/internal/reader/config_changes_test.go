@@ -0,0 +1,136 @@
+package reader
+
+import (
+	"testing"
+	"time"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	libocrtypes "github.com/smartcontractkit/libocr/ragep2p/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	readermock "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/contractreader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+func TestConfigChangeFeed(t *testing.T) {
+	feed := NewConfigChangeFeed(logger.Test(t))
+
+	sub1, cancel1 := feed.Subscribe()
+	sub2, cancel2 := feed.Subscribe()
+	defer cancel2()
+
+	feed.Send(ConfigChangeEvent{Kind: ConfigKindChainConfig, ChainSelector: 1})
+	assert.Equal(t, cciptypes.ChainSelector(1), (<-sub1).ChainSelector)
+	assert.Equal(t, cciptypes.ChainSelector(1), (<-sub2).ChainSelector)
+
+	cancel1()
+	cancel1() // cancelling twice is a no-op
+	_, open := <-sub1
+	assert.False(t, open)
+
+	// events are dropped instead of blocking when the subscriber does not keep up
+	for i := 0; i < configChangeBufferSize+10; i++ {
+		feed.Send(ConfigChangeEvent{Kind: ConfigKindChainConfig})
+	}
+	assert.Len(t, sub2, configChangeBufferSize)
+}
+
+func TestDiffActiveAndCandidate(t *testing.T) {
+	cfgA := OCR3ConfigWithMeta{Version: 1, ConfigDigest: [32]byte{1}, Config: OCR3Config{FRoleDON: 1}}
+	cfgB := OCR3ConfigWithMeta{Version: 2, ConfigDigest: [32]byte{2}, Config: OCR3Config{FRoleDON: 2}}
+	template := ConfigChangeEvent{Kind: ConfigKindOCR3Config, DonID: 3, PluginType: 1}
+
+	diff := func(oldActive, oldCandidate, newActive, newCandidate OCR3ConfigWithMeta) []ConfigChangeEvent {
+		return DiffActiveAndCandidate(template,
+			oldActive.ConfigDigest, oldCandidate.ConfigDigest, oldActive, oldCandidate,
+			newActive.ConfigDigest, newCandidate.ConfigDigest, newActive, newCandidate)
+	}
+	empty := OCR3ConfigWithMeta{}
+
+	testCases := []struct {
+		name       string
+		events     []ConfigChangeEvent
+		changeType ConfigChangeType
+	}{
+		{name: "no change", events: diff(cfgA, cfgB, cfgA, cfgB)},
+		{name: "first config", events: diff(empty, empty, cfgA, empty), changeType: ConfigAdded},
+		{name: "candidate set", events: diff(cfgA, empty, cfgA, cfgB), changeType: ConfigUpdated},
+		{name: "candidate promoted", events: diff(cfgA, cfgB, cfgB, empty), changeType: ConfigPromoted},
+		{name: "configs removed", events: diff(cfgA, cfgB, empty, empty), changeType: ConfigRemoved},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.changeType == "" {
+				require.Empty(t, tc.events)
+				return
+			}
+			require.Len(t, tc.events, 1)
+			ev := tc.events[0]
+			assert.Equal(t, tc.changeType, ev.ChangeType)
+			assert.Equal(t, uint32(3), ev.DonID)
+			assert.Equal(t, uint8(1), ev.PluginType)
+			assert.NotEmpty(t, ev.Diff)
+		})
+	}
+
+	// a promotion only reports the changes of the active config
+	promotion := diff(cfgA, cfgB, cfgB, empty)[0]
+	paths := make([]string, len(promotion.Diff))
+	for i, d := range promotion.Diff {
+		paths[i] = d.Path
+	}
+	assert.Equal(t, []string{"Config.FRoleDON", "ConfigDigest", "Version"}, paths)
+}
+
+func TestHomeChainPoller_SubscribeConfigChanges(t *testing.T) {
+	poller := NewHomeChainConfigPoller(
+		readermock.NewMockContractReaderFacade(t),
+		logger.Test(t),
+		time.Minute,
+		ccipConfigBoundContract,
+	).(*homeChainPoller)
+
+	changes, cancel := poller.SubscribeConfigChanges()
+	defer cancel()
+
+	p1 := libocrtypes.PeerID{1}
+	p2 := libocrtypes.PeerID{2}
+
+	poller.setState(map[cciptypes.ChainSelector]ChainConfig{
+		1: {FChain: 1, SupportedNodes: mapset.NewSet(p1)},
+	})
+	ev := <-changes
+	assert.Equal(t, ConfigKindChainConfig, ev.Kind)
+	assert.Equal(t, ConfigAdded, ev.ChangeType)
+	assert.Equal(t, cciptypes.ChainSelector(1), ev.ChainSelector)
+
+	poller.setState(map[cciptypes.ChainSelector]ChainConfig{
+		1: {FChain: 1, SupportedNodes: mapset.NewSet(p1, p2)},
+	})
+	ev = <-changes
+	assert.Equal(t, ConfigUpdated, ev.ChangeType)
+	require.Len(t, ev.Diff, 1)
+	assert.Equal(t, "SupportedNodes", ev.Diff[0].Path)
+
+	poller.setState(map[cciptypes.ChainSelector]ChainConfig{})
+	ev = <-changes
+	assert.Equal(t, ConfigRemoved, ev.ChangeType)
+
+	// the first OCR3 config of a DON is only recorded, a promotion afterwards is reported
+	active := OCR3ConfigWithMeta{ConfigDigest: [32]byte{1}, Config: OCR3Config{ChainSelector: 2}}
+	candidate := OCR3ConfigWithMeta{ConfigDigest: [32]byte{2}, Config: OCR3Config{ChainSelector: 2, FRoleDON: 1}}
+	poller.setOCRConfigs(1, 0, ActiveAndCandidate{ActiveConfig: active, CandidateConfig: candidate})
+	require.Empty(t, changes)
+
+	poller.setOCRConfigs(1, 0, ActiveAndCandidate{ActiveConfig: candidate})
+	ev = <-changes
+	assert.Equal(t, ConfigKindOCR3Config, ev.Kind)
+	assert.Equal(t, ConfigPromoted, ev.ChangeType)
+	assert.Equal(t, cciptypes.ChainSelector(2), ev.ChainSelector)
+	assert.Equal(t, uint32(1), ev.DonID)
+}
@@ -38,6 +38,9 @@ type HomeChain interface {
 	GetFChain() (map[cciptypes.ChainSelector]int, error)
 	// GetOCRConfigs Gets the OCR3Configs for a given donID and pluginType
 	GetOCRConfigs(ctx context.Context, donID uint32, pluginType uint8) (ActiveAndCandidate, error)
+	// SubscribeConfigChanges returns a channel of chain config and OCR3 config change events and a function to
+	// cancel the subscription. OCR3 configs are watched once they have been requested through GetOCRConfigs.
+	SubscribeConfigChanges() (<-chan ConfigChangeEvent, func())
 	services.Service
 }
 
@@ -50,6 +53,13 @@ type state struct {
 	knownSourceChains mapset.Set[cciptypes.ChainSelector]
 	// map of chain to FChain value, derived from chainConfigs
 	fChain map[cciptypes.ChainSelector]int
+	// OCR3 configs requested through GetOCRConfigs, refreshed by the polling loop to detect changes
+	ocrConfigs map[ocrConfigKey]ActiveAndCandidate
+}
+
+type ocrConfigKey struct {
+	donID      uint32
+	pluginType uint8
 }
 
 type homeChainPoller struct {
@@ -62,6 +72,7 @@ type homeChainPoller struct {
 	state                   state
 	failedPolls             atomic.Uint32
 	ccipConfigBoundContract types.BoundContract
+	changes                 *ConfigChangeFeed
 	// How frequently the poller fetches the chain configs
 	pollingDuration time.Duration
 }
@@ -77,7 +88,8 @@ func NewHomeChainConfigPoller(
 	return &homeChainPoller{
 		stopCh:                  make(chan struct{}),
 		homeChainReader:         homeChainReader,
-		state:                   state{},
+		state:                   state{ocrConfigs: make(map[ocrConfigKey]ActiveAndCandidate)},
+		changes:                 NewConfigChangeFeed(lggr),
 		mutex:                   &sync.RWMutex{},
 		failedPolls:             atomic.Uint32{},
 		lggr:                    lggr,
@@ -107,6 +119,7 @@ func (r *homeChainPoller) poll() {
 	} else {
 		r.failedPolls.Store(0)
 	}
+	r.refreshOCRConfigs(ctx)
 
 	ticker := time.NewTicker(r.pollingDuration)
 	defer ticker.Stop()
@@ -121,6 +134,7 @@ func (r *homeChainPoller) poll() {
 			} else {
 				r.failedPolls.Store(0)
 			}
+			r.refreshOCRConfigs(ctx)
 		}
 	}
 }
@@ -172,12 +186,65 @@ func (r *homeChainPoller) fetchAndSetConfigs(ctx context.Context) error {
 
 func (r *homeChainPoller) setState(chainConfigs map[cciptypes.ChainSelector]ChainConfig) {
 	r.mutex.Lock()
-	defer r.mutex.Unlock()
 	s := &r.state
+	events := DiffChainConfigs(s.chainConfigs, chainConfigs)
 	s.chainConfigs = chainConfigs
 	s.nodeSupportedChains = createNodesSupportedChains(chainConfigs)
 	s.knownSourceChains = createKnownChains(chainConfigs)
 	s.fChain = createFChain(chainConfigs)
+	r.mutex.Unlock()
+
+	r.changes.Send(events...)
+}
+
+// refreshOCRConfigs re-fetches the OCR3 configs that were requested through GetOCRConfigs so that
+// changes, e.g. a candidate promotion, are detected without waiting for the next request.
+func (r *homeChainPoller) refreshOCRConfigs(ctx context.Context) {
+	r.mutex.RLock()
+	keys := make([]ocrConfigKey, 0, len(r.state.ocrConfigs))
+	for key := range r.state.ocrConfigs {
+		keys = append(keys, key)
+	}
+	r.mutex.RUnlock()
+
+	for _, key := range keys {
+		if _, err := r.fetchOCRConfigs(ctx, key.donID, key.pluginType); err != nil {
+			r.lggr.Warnw("failed to refresh OCR configs",
+				"donID", key.donID, "pluginType", key.pluginType, "err", err)
+		}
+	}
+}
+
+// setOCRConfigs stores the latest OCR3 configs of the DON and plugin type and emits the change events.
+func (r *homeChainPoller) setOCRConfigs(donID uint32, pluginType uint8, configs ActiveAndCandidate) {
+	key := ocrConfigKey{donID: donID, pluginType: pluginType}
+
+	r.mutex.Lock()
+	prev, watched := r.state.ocrConfigs[key]
+	r.state.ocrConfigs[key] = configs
+	r.mutex.Unlock()
+
+	if !watched {
+		// first time this config is requested, there is nothing to compare against
+		return
+	}
+
+	r.changes.Send(DiffActiveAndCandidate(
+		ConfigChangeEvent{
+			Kind:          ConfigKindOCR3Config,
+			ChainSelector: configs.ActiveConfig.Config.ChainSelector,
+			DonID:         donID,
+			PluginType:    pluginType,
+		},
+		prev.ActiveConfig.ConfigDigest, prev.CandidateConfig.ConfigDigest,
+		prev.ActiveConfig, prev.CandidateConfig,
+		configs.ActiveConfig.ConfigDigest, configs.CandidateConfig.ConfigDigest,
+		configs.ActiveConfig, configs.CandidateConfig,
+	)...)
+}
+
+func (r *homeChainPoller) SubscribeConfigChanges() (<-chan ConfigChangeEvent, func()) {
+	return r.changes.Subscribe()
 }
 
 func (r *homeChainPoller) GetChainConfig(chainSelector cciptypes.ChainSelector) (ChainConfig, error) {
@@ -228,6 +295,23 @@ func (r *homeChainPoller) GetFChain() (map[cciptypes.ChainSelector]int, error) {
 
 func (r *homeChainPoller) GetOCRConfigs(
 	ctx context.Context, donID uint32, pluginType uint8,
+) (ActiveAndCandidate, error) {
+	activeAndCandidate, err := r.fetchOCRConfigs(ctx, donID, pluginType)
+	if err != nil {
+		return ActiveAndCandidate{}, err
+	}
+
+	r.lggr.Infow(
+		"GetOCRConfigs",
+		"activeConfig", activeAndCandidate.ActiveConfig,
+		"candidateConfig", activeAndCandidate.CandidateConfig,
+	)
+
+	return activeAndCandidate, nil
+}
+
+func (r *homeChainPoller) fetchOCRConfigs(
+	ctx context.Context, donID uint32, pluginType uint8,
 ) (ActiveAndCandidate, error) {
 	var (
 		activeAndCandidate ActiveAndCandidate
@@ -245,12 +329,7 @@ func (r *homeChainPoller) GetOCRConfigs(
 		return ActiveAndCandidate{}, fmt.Errorf("error fetching OCR configs: %w", err)
 	}
 
-	r.lggr.Infow(
-		"GetOCRConfigs",
-		"activeConfig", activeAndCandidate.ActiveConfig,
-		"candidateConfig", activeAndCandidate.CandidateConfig,
-	)
-
+	r.setOCRConfigs(donID, pluginType, activeAndCandidate)
 	return activeAndCandidate, nil
 }
 
@@ -413,6 +413,65 @@ func (_c *MockCCIPReader_GetContractAddress_Call) RunAndReturn(run func(string,
 	return _c
 }
 
+// GetContractTypeAndVersions provides a mock function with given fields: ctx, contracts
+func (_m *MockCCIPReader) GetContractTypeAndVersions(ctx context.Context, contracts reader.ContractAddresses) (map[string]map[ccipocr3.ChainSelector]string, error) {
+	ret := _m.Called(ctx, contracts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContractTypeAndVersions")
+	}
+
+	var r0 map[string]map[ccipocr3.ChainSelector]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, reader.ContractAddresses) (map[string]map[ccipocr3.ChainSelector]string, error)); ok {
+		return rf(ctx, contracts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, reader.ContractAddresses) map[string]map[ccipocr3.ChainSelector]string); ok {
+		r0 = rf(ctx, contracts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]map[ccipocr3.ChainSelector]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, reader.ContractAddresses) error); ok {
+		r1 = rf(ctx, contracts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCCIPReader_GetContractTypeAndVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContractTypeAndVersions'
+type MockCCIPReader_GetContractTypeAndVersions_Call struct {
+	*mock.Call
+}
+
+// GetContractTypeAndVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contracts reader.ContractAddresses
+func (_e *MockCCIPReader_Expecter) GetContractTypeAndVersions(ctx interface{}, contracts interface{}) *MockCCIPReader_GetContractTypeAndVersions_Call {
+	return &MockCCIPReader_GetContractTypeAndVersions_Call{Call: _e.mock.On("GetContractTypeAndVersions", ctx, contracts)}
+}
+
+func (_c *MockCCIPReader_GetContractTypeAndVersions_Call) Run(run func(ctx context.Context, contracts reader.ContractAddresses)) *MockCCIPReader_GetContractTypeAndVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(reader.ContractAddresses))
+	})
+	return _c
+}
+
+func (_c *MockCCIPReader_GetContractTypeAndVersions_Call) Return(_a0 map[string]map[ccipocr3.ChainSelector]string, _a1 error) *MockCCIPReader_GetContractTypeAndVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCCIPReader_GetContractTypeAndVersions_Call) RunAndReturn(run func(context.Context, reader.ContractAddresses) (map[string]map[ccipocr3.ChainSelector]string, error)) *MockCCIPReader_GetContractTypeAndVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetDestChainFeeComponents provides a mock function with given fields: ctx
 func (_m *MockCCIPReader) GetDestChainFeeComponents(ctx context.Context) (types.ChainFeeComponents, error) {
 	ret := _m.Called(ctx)
@@ -9,6 +9,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	reader "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+
 	types "github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn/types"
 )
 
@@ -590,6 +592,65 @@ func (_c *MockRMNHome_Start_Call) RunAndReturn(run func(context.Context) error)
 	return _c
 }
 
+// SubscribeConfigChanges provides a mock function with no fields
+func (_m *MockRMNHome) SubscribeConfigChanges() (<-chan reader.ConfigChangeEvent, func()) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeConfigChanges")
+	}
+
+	var r0 <-chan reader.ConfigChangeEvent
+	var r1 func()
+	if rf, ok := ret.Get(0).(func() (<-chan reader.ConfigChangeEvent, func())); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() <-chan reader.ConfigChangeEvent); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan reader.ConfigChangeEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() func()); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
+// MockRMNHome_SubscribeConfigChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeConfigChanges'
+type MockRMNHome_SubscribeConfigChanges_Call struct {
+	*mock.Call
+}
+
+// SubscribeConfigChanges is a helper method to define mock.On call
+func (_e *MockRMNHome_Expecter) SubscribeConfigChanges() *MockRMNHome_SubscribeConfigChanges_Call {
+	return &MockRMNHome_SubscribeConfigChanges_Call{Call: _e.mock.On("SubscribeConfigChanges")}
+}
+
+func (_c *MockRMNHome_SubscribeConfigChanges_Call) Run(run func()) *MockRMNHome_SubscribeConfigChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRMNHome_SubscribeConfigChanges_Call) Return(_a0 <-chan reader.ConfigChangeEvent, _a1 func()) *MockRMNHome_SubscribeConfigChanges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRMNHome_SubscribeConfigChanges_Call) RunAndReturn(run func() (<-chan reader.ConfigChangeEvent, func())) *MockRMNHome_SubscribeConfigChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockRMNHome creates a new instance of MockRMNHome. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockRMNHome(t interface {
@@ -16,11 +16,18 @@ const (
 	ContractNameRMNProxy               = "RMNProxy"
 	ContractNameRouter                 = "Router"
 	ContractNameCCTPMessageTransmitter = "MessageTransmitter"
+	// ContractNameTypeAndVersion is the ITypeAndVersion interface, any contract implementing it
+	// can be bound under this name to read its type and version. On EVM chains it is bound with the ABI of
+	// the shared gethwrappers type_and_version wrapper.
+	ContractNameTypeAndVersion = "ITypeAndVersion"
 )
 
 // Method Names
 // TODO: these should be better organized, maybe separate packages.
 const (
+	// ITypeAndVersion methods
+	MethodNameTypeAndVersion = "TypeAndVersion"
+
 	// Router methods
 	MethodNameRouterGetWrappedNative = "GetWrappedNative"
 
@@ -116,6 +123,13 @@ const (
 	EventNameExecutionStateChanged = "ExecutionStateChanged"
 	EventNameCommitReportAccepted  = "CommitReportAccepted"
 	EventNameCCTPMessageSent       = "MessageSent"
+
+	// Config-set and curse events, used to invalidate cached chain configs.
+	EventNameSourceChainConfigSet = "SourceChainConfigSet"
+	EventNameDynamicConfigSet     = "DynamicConfigSet"
+	EventNameConfigSet            = "ConfigSet"
+	EventNameCursed               = "Cursed"
+	EventNameUncursed             = "Uncursed"
 )
 
 // Event Attributes
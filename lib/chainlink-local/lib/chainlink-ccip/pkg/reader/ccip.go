@@ -32,6 +32,9 @@ import (
 // Default refresh period for cache if not specified
 const defaultRefreshPeriod = 30 * time.Second
 
+// Default period of checking for config-set events that invalidate the cache, roughly a block
+const defaultConfigEventPollPeriod = 2 * time.Second
+
 // TODO: unit test the implementation when the actual contract reader and writer interfaces are finalized and mocks
 // can be generated.
 type ccipChainReader struct {
@@ -42,6 +45,8 @@ type ccipChainReader struct {
 	offrampAddress  string
 	configPoller    ConfigPoller
 	addrCodec       cciptypes.AddressCodec
+	// typeAndVersionReaders read typeAndVersion() on the chains where they are set, instead of the contract readers.
+	typeAndVersionReaders map[cciptypes.ChainSelector]TypeAndVersionReader
 }
 
 func newCCIPChainReaderInternal(
@@ -73,7 +78,7 @@ func newCCIPChainReaderInternal(
 	}
 
 	// Initialize cache with readers
-	reader.configPoller = newConfigPoller(lggr, reader, defaultRefreshPeriod)
+	reader.configPoller = newConfigPoller(lggr, reader, defaultRefreshPeriod, defaultConfigEventPollPeriod)
 
 	contracts := ContractAddresses{
 		consts.ContractNameOffRamp: {
@@ -1310,6 +1315,101 @@ func (r *ccipChainReader) Sync(ctx context.Context, contracts ContractAddresses)
 	return errors.Join(errs...)
 }
 
+func (r *ccipChainReader) GetContractTypeAndVersions(
+	ctx context.Context,
+	contracts ContractAddresses,
+) (map[string]map[cciptypes.ChainSelector]string, error) {
+	typeAndVersions := make(map[string]map[cciptypes.ChainSelector]string)
+	var errs []error
+	for contractName, chainSelToAddress := range contracts {
+		for chainSel, address := range chainSelToAddress {
+			if len(address) == 0 {
+				continue
+			}
+
+			var typeAndVersion string
+			var err error
+			if tvReader, ok := r.typeAndVersionReaders[chainSel]; ok {
+				typeAndVersion, err = r.readTypeAndVersionWithReader(ctx, tvReader, chainSel, address)
+			} else if extendedReader, ok := r.contractReaders[chainSel]; ok {
+				typeAndVersion, err = r.readTypeAndVersion(ctx, extendedReader, chainSel, address)
+			} else {
+				// don't support this chain
+				continue
+			}
+			if err != nil {
+				errs = append(errs, fmt.Errorf("read typeAndVersion of %s on chain %d: %w", contractName, chainSel, err))
+				continue
+			}
+
+			if typeAndVersions[contractName] == nil {
+				typeAndVersions[contractName] = make(map[cciptypes.ChainSelector]string)
+			}
+			typeAndVersions[contractName][chainSel] = typeAndVersion
+		}
+	}
+
+	return typeAndVersions, errors.Join(errs...)
+}
+
+// readTypeAndVersionWithReader reads the typeAndVersion of the address with the TypeAndVersionReader of the chain.
+func (r *ccipChainReader) readTypeAndVersionWithReader(
+	ctx context.Context,
+	tvReader TypeAndVersionReader,
+	chainSel cciptypes.ChainSelector,
+	address []byte,
+) (string, error) {
+	addressStr, err := r.addrCodec.AddressBytesToString(address, chainSel)
+	if err != nil {
+		return "", fmt.Errorf("convert address %x to string: %w", address, err)
+	}
+
+	typeAndVersion, err := tvReader.TypeAndVersion(ctx, addressStr)
+	if err != nil {
+		return "", fmt.Errorf("read typeAndVersion of %s: %w", addressStr, err)
+	}
+	return typeAndVersion, nil
+}
+
+// readTypeAndVersion binds the address as ITypeAndVersion and reads its typeAndVersion. Binding under the interface
+// name instead of the contract name allows reading addresses before they are bound by Sync. The binding is removed
+// after the read so that the temporary bindings do not accumulate in the contract reader.
+func (r *ccipChainReader) readTypeAndVersion(
+	ctx context.Context,
+	extendedReader contractreader.Extended,
+	chainSel cciptypes.ChainSelector,
+	address []byte,
+) (string, error) {
+	addressStr, err := r.addrCodec.AddressBytesToString(address, chainSel)
+	if err != nil {
+		return "", fmt.Errorf("convert address %x to string: %w", address, err)
+	}
+
+	contract := types.BoundContract{Address: addressStr, Name: consts.ContractNameTypeAndVersion}
+	if err := extendedReader.Bind(ctx, []types.BoundContract{contract}); err != nil {
+		return "", fmt.Errorf("bind %s: %w", addressStr, err)
+	}
+	defer func() {
+		if err := extendedReader.Unbind(ctx, []types.BoundContract{contract}); err != nil {
+			r.lggr.Warnw("failed to unbind typeAndVersion contract", "address", addressStr, "err", err)
+		}
+	}()
+
+	var typeAndVersion string
+	err = extendedReader.GetLatestValue(
+		ctx,
+		contract.ReadIdentifier(consts.MethodNameTypeAndVersion),
+		primitives.Unconfirmed,
+		map[string]any{},
+		&typeAndVersion,
+	)
+	if err != nil {
+		return "", fmt.Errorf("get latest value of %s: %w", consts.MethodNameTypeAndVersion, err)
+	}
+
+	return typeAndVersion, nil
+}
+
 func (r *ccipChainReader) GetContractAddress(contractName string, chain cciptypes.ChainSelector) ([]byte, error) {
 	extendedReader, ok := r.contractReaders[chain]
 	if !ok {
@@ -117,6 +117,24 @@ func (s StaticSourceChainConfig) check() (bool /* enabled */, error) {
 	return s.IsEnabled, nil
 }
 
+// TypeAndVersionReader reads typeAndVersion() of the contract at the provided address. On EVM chains it is
+// implemented with the type_and_version wrapper of the shared gethwrappers, see client.TypeAndVersionReader of
+// chainlink-evm.
+type TypeAndVersionReader interface {
+	TypeAndVersion(ctx context.Context, address string) (string, error)
+}
+
+// CCIPReaderOption configures optional dependencies of the reader returned by NewCCIPChainReader.
+type CCIPReaderOption func(r *ccipChainReader)
+
+// WithTypeAndVersionReaders sets the readers used by GetContractTypeAndVersions. On chains without one the
+// typeAndVersion is read through the contract reader, which must then be configured with the ITypeAndVersion binding.
+func WithTypeAndVersionReaders(readers map[cciptypes.ChainSelector]TypeAndVersionReader) CCIPReaderOption {
+	return func(r *ccipChainReader) {
+		r.typeAndVersionReaders = readers
+	}
+}
+
 func NewCCIPChainReader(
 	ctx context.Context,
 	lggr logger.Logger,
@@ -125,20 +143,21 @@ func NewCCIPChainReader(
 	destChain cciptypes.ChainSelector,
 	offrampAddress []byte,
 	addrCodec cciptypes.AddressCodec,
+	opts ...CCIPReaderOption,
 ) CCIPReader {
-	return NewObservedCCIPReader(
-		newCCIPChainReaderInternal(
-			ctx,
-			lggr,
-			contractReaders,
-			contractWriters,
-			destChain,
-			offrampAddress,
-			addrCodec,
-		),
+	reader := newCCIPChainReaderInternal(
+		ctx,
 		lggr,
+		contractReaders,
+		contractWriters,
 		destChain,
+		offrampAddress,
+		addrCodec,
 	)
+	for _, opt := range opts {
+		opt(reader)
+	}
+	return NewObservedCCIPReader(reader, lggr, destChain)
 }
 
 // NewCCIPReaderWithExtendedContractReaders can be used when you want to directly provide contractreader.Extended
@@ -249,6 +268,15 @@ type CCIPReader interface {
 	// the price of ETH not in ETH but in wei (1e-18 ETH).
 	LinkPriceUSD(ctx context.Context) (cciptypes.BigInt, error)
 
+	// GetContractTypeAndVersions reads typeAndVersion() of the provided contracts, e.g. "OnRamp 1.6.0".
+	// Contracts on chains without a TypeAndVersionReader or a contract reader are skipped. Contracts that could not be
+	// read are omitted from the result and their errors are joined in the returned error.
+	// NOTE: this method makes network calls.
+	GetContractTypeAndVersions(
+		ctx context.Context,
+		contracts ContractAddresses,
+	) (map[string]map[cciptypes.ChainSelector]string, error)
+
 	// Sync can be used to perform frequent syncing operations inside the reader implementation.
 	// NOTE: this method may make network calls.
 	Sync(ctx context.Context, contracts ContractAddresses) error
@@ -710,6 +710,62 @@ func TestCCIPChainReader_Sync_BindError(t *testing.T) {
 
 // The round1 version returns NoBindingFound errors for onramp contracts to simulate
 // the two-phase approach to discovering those contracts.
+type fakeTypeAndVersionReader map[string]string
+
+func (f fakeTypeAndVersionReader) TypeAndVersion(_ context.Context, address string) (string, error) {
+	typeAndVersion, ok := f[address]
+	if !ok {
+		return "", errors.New("not a contract")
+	}
+	return typeAndVersion, nil
+}
+
+func TestCCIPChainReader_GetContractTypeAndVersions(t *testing.T) {
+	ctx := tests.Context(t)
+	addrCodec := internal.NewMockAddressCodecHex(t)
+	onRampA, onRampB, onRampC := []byte{0x1}, []byte{0x2}, []byte{0x3}
+	onRampAStr, err := addrCodec.AddressBytesToString(onRampA, chainA)
+	require.NoError(t, err)
+	onRampBStr, err := addrCodec.AddressBytesToString(onRampB, chainB)
+	require.NoError(t, err)
+
+	// chainB has no TypeAndVersionReader, the address is bound as ITypeAndVersion, read and unbound again.
+	binding := types.BoundContract{Address: onRampBStr, Name: consts.ContractNameTypeAndVersion}
+	crB := reader_mocks.NewMockExtended(t)
+	crB.EXPECT().Bind(mock.Anything, []types.BoundContract{binding}).Return(nil).Once()
+	crB.EXPECT().GetLatestValue(
+		mock.Anything,
+		binding.ReadIdentifier(consts.MethodNameTypeAndVersion),
+		primitives.Unconfirmed,
+		map[string]any{},
+		mock.Anything,
+	).Return(nil).Run(func(_ context.Context, _ string, _ primitives.ConfidenceLevel, _ any, returnVal any) {
+		*returnVal.(*string) = "OnRamp 1.6.0"
+	}).Once()
+	crB.EXPECT().Unbind(mock.Anything, []types.BoundContract{binding}).Return(nil).Once()
+
+	ccipReader := &ccipChainReader{
+		lggr: logger.Test(t),
+		contractReaders: map[cciptypes.ChainSelector]contractreader.Extended{
+			chainB: crB,
+		},
+		typeAndVersionReaders: map[cciptypes.ChainSelector]TypeAndVersionReader{
+			chainA: fakeTypeAndVersionReader{onRampAStr: "OnRamp 1.5.0"},
+		},
+		addrCodec: addrCodec,
+	}
+
+	// chainC has neither reader and is skipped, the router on chainA is not a contract.
+	typeAndVersions, err := ccipReader.GetContractTypeAndVersions(ctx, ContractAddresses{
+		consts.ContractNameOnRamp: {chainA: onRampA, chainB: onRampB, chainC: onRampC},
+		consts.ContractNameRouter: {chainA: []byte{0x4}},
+	})
+	require.ErrorContains(t, err, "read typeAndVersion of Router on chain 1")
+	assert.Equal(t, map[string]map[cciptypes.ChainSelector]string{
+		consts.ContractNameOnRamp: {chainA: "OnRamp 1.5.0", chainB: "OnRamp 1.6.0"},
+	}, typeAndVersions)
+}
+
 func TestCCIPChainReader_DiscoverContracts_HappyPath_Round1(t *testing.T) {
 	ctx := tests.Context(t)
 	destChain := cciptypes.ChainSelector(1)
@@ -2196,6 +2252,11 @@ func (m *mockConfigCache) GetOfframpSourceChainConfigs(
 	return args.Get(0).(map[cciptypes.ChainSelector]StaticSourceChainConfig), args.Error(1)
 }
 
+func (m *mockConfigCache) SubscribeConfigChanges() (<-chan ConfigChangeEvent, func()) {
+	args := m.Called()
+	return args.Get(0).(<-chan ConfigChangeEvent), args.Get(1).(func())
+}
+
 // Update Start method to accept context parameter
 func (m *mockConfigCache) Start(ctx context.Context) error {
 	return m.Called(ctx).Error(0)
@@ -7,6 +7,8 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/smartcontractkit/chainlink-ccip/internal/libs/configdiff"
+	reader_internal "github.com/smartcontractkit/chainlink-ccip/internal/reader"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
@@ -32,6 +34,9 @@ type ConfigPoller interface {
 		ctx context.Context,
 		destChain cciptypes.ChainSelector,
 		sourceChains []cciptypes.ChainSelector) (map[cciptypes.ChainSelector]StaticSourceChainConfig, error)
+	// SubscribeConfigChanges returns a channel of source chain config change events and a function
+	// to cancel the subscription.
+	SubscribeConfigChanges() (<-chan ConfigChangeEvent, func())
 	services.Service
 }
 
@@ -45,6 +50,13 @@ type configPoller struct {
 	reader        ccipReaderInternal
 	lggr          logger.Logger
 
+	// How frequently the contract readers are checked for config-set events, 0 disables the checks
+	eventPollPeriod time.Duration
+	// Last seen block of each config-set event, only accessed by the background polling goroutine
+	eventCursors map[configEventKey]string
+	// Config-set events that are not bound in the contract readers, only accessed by the background polling goroutine
+	unboundEvents map[configEventKey]struct{}
+
 	// Track known source chains for each destination chain
 	knownSourceChains map[cciptypes.ChainSelector]map[cciptypes.ChainSelector]bool
 
@@ -54,6 +66,9 @@ type configPoller struct {
 
 	// Track consecutive failed polls
 	failedPolls atomic.Uint32
+
+	// Notifies subscribers about source chain config changes
+	changes *reader_internal.ConfigChangeFeed
 }
 
 // chainCache represents the cache for a single chain.
@@ -71,19 +86,25 @@ type chainCache struct {
 	sourceChainRefresh       time.Time // Single timestamp for all source chain configs
 }
 
-// newConfigPoller creates a new config cache instance
+// newConfigPoller creates a new config cache instance. Cached configs are refreshed every refreshPeriod and
+// additionally invalidated as soon as a config-set event is found, which is checked every eventPollPeriod.
 func newConfigPoller(
 	lggr logger.Logger,
 	reader ccipReaderInternal,
 	refreshPeriod time.Duration,
+	eventPollPeriod time.Duration,
 ) *configPoller {
 	return &configPoller{
 		chainCaches:       make(map[cciptypes.ChainSelector]*chainCache),
 		refreshPeriod:     refreshPeriod,
 		reader:            reader,
 		lggr:              lggr,
+		eventPollPeriod:   eventPollPeriod,
+		eventCursors:      make(map[configEventKey]string),
+		unboundEvents:     make(map[configEventKey]struct{}),
 		knownSourceChains: make(map[cciptypes.ChainSelector]map[cciptypes.ChainSelector]bool),
 		stopChan:          make(chan struct{}),
+		changes:           reader_internal.NewConfigChangeFeed(lggr),
 	}
 }
 
@@ -95,10 +116,20 @@ func (c *configPoller) startBackgroundPolling() {
 		ticker := time.NewTicker(c.refreshPeriod)
 		defer ticker.Stop()
 
+		// A nil channel blocks forever, so event checks are skipped when they are disabled
+		var eventTick <-chan time.Time
+		if c.eventPollPeriod > 0 {
+			eventTicker := time.NewTicker(c.eventPollPeriod)
+			defer eventTicker.Stop()
+			eventTick = eventTicker.C
+		}
+
 		for {
 			select {
 			case <-ticker.C:
 				c.refreshAllKnownChains()
+			case <-eventTick:
+				c.invalidateOnConfigEvents()
 			case <-c.stopChan:
 				return
 			}
@@ -413,18 +444,7 @@ func (c *configPoller) batchRefreshChainAndSourceConfigs(
 
 		// Update source chain config cache with any successful results
 		if len(sourceConfigs) > 0 {
-			chainCache.sourceChainMu.Lock()
-
-			// Update configs in the map
-			for chain, config := range sourceConfigs {
-				cachedConfig := staticSourceChainConfigFromSourceChainConfig(config)
-				chainCache.staticSourceChainConfigs[chain] = cachedConfig
-			}
-
-			// Update the refresh timestamp
-			chainCache.sourceChainRefresh = time.Now()
-
-			chainCache.sourceChainMu.Unlock()
+			c.setSourceChainConfigs(destChain, chainCache, sourceConfigs)
 		}
 	}
 
@@ -686,6 +706,28 @@ func (c *configPoller) refreshSourceChainConfigs(
 	}
 
 	// Update the cache with new configs
+	c.setSourceChainConfigs(destChain, chainCache, sourceChainConfigs)
+
+	c.lggr.Debugw("Successfully refreshed source chain configs",
+		"destChain", destChain,
+		"chainsCount", len(sourceChainConfigs),
+		"fetchConfigLatency", fetchConfigLatency)
+
+	result := make(map[cciptypes.ChainSelector]StaticSourceChainConfig, len(sourceChainConfigs))
+	for chain, config := range sourceChainConfigs {
+		result[chain] = staticSourceChainConfigFromSourceChainConfig(config)
+	}
+
+	return result, nil
+}
+
+// setSourceChainConfigs updates the cached source chain configs of the destination chain and notifies
+// the subscribers about the configs that were added or changed.
+func (c *configPoller) setSourceChainConfigs(
+	destChain cciptypes.ChainSelector,
+	chainCache *chainCache,
+	sourceChainConfigs map[cciptypes.ChainSelector]SourceChainConfig,
+) {
 	chainCache.sourceChainMu.Lock()
 
 	// Initialize the map if needed
@@ -693,9 +735,17 @@ func (c *configPoller) refreshSourceChainConfigs(
 		chainCache.staticSourceChainConfigs = make(map[cciptypes.ChainSelector]StaticSourceChainConfig)
 	}
 
+	// Only the updated chains are compared, chains that were not refreshed are not reported as removed
+	oldConfigs := make(map[cciptypes.ChainSelector]StaticSourceChainConfig, len(sourceChainConfigs))
+	newConfigs := make(map[cciptypes.ChainSelector]StaticSourceChainConfig, len(sourceChainConfigs))
+
 	// Update configs in the map
 	for chain, config := range sourceChainConfigs {
+		if oldConfig, exists := chainCache.staticSourceChainConfigs[chain]; exists {
+			oldConfigs[chain] = oldConfig
+		}
 		cachedConfig := staticSourceChainConfigFromSourceChainConfig(config)
+		newConfigs[chain] = cachedConfig
 		chainCache.staticSourceChainConfigs[chain] = cachedConfig
 	}
 
@@ -704,17 +754,22 @@ func (c *configPoller) refreshSourceChainConfigs(
 
 	chainCache.sourceChainMu.Unlock()
 
-	c.lggr.Debugw("Successfully refreshed source chain configs",
-		"destChain", destChain,
-		"chainsCount", len(sourceChainConfigs),
-		"fetchConfigLatency", fetchConfigLatency)
-
-	result := make(map[cciptypes.ChainSelector]StaticSourceChainConfig, len(sourceChainConfigs))
-	for chain, config := range sourceChainConfigs {
-		result[chain] = staticSourceChainConfigFromSourceChainConfig(config)
-	}
+	now := time.Now().UTC()
+	c.changes.Send(reader_internal.DiffConfigMaps(oldConfigs, newConfigs,
+		func(chain cciptypes.ChainSelector, ct ConfigChangeType, diff []configdiff.FieldDiff) ConfigChangeEvent {
+			return ConfigChangeEvent{
+				Timestamp:           now,
+				Kind:                ConfigKindSourceChainConfig,
+				ChangeType:          ct,
+				ChainSelector:       destChain,
+				SourceChainSelector: chain,
+				Diff:                diff,
+			}
+		})...)
+}
 
-	return result, nil
+func (c *configPoller) SubscribeConfigChanges() (<-chan ConfigChangeEvent, func()) {
+	return c.changes.Subscribe()
 }
 
 func (c *configPoller) fetchChainConfig(
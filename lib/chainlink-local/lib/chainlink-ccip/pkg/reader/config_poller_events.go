@@ -0,0 +1,186 @@
+package reader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// configEvent is a config-set or curse event that invalidates the cached configs of the destination chain.
+type configEvent struct {
+	contract string
+	event    string
+	// sourceChainConfigs is true when the event invalidates the offramp source chain configs
+	// instead of the chain config snapshot.
+	sourceChainConfigs bool
+}
+
+// configEvents are the events the config poller checks for between the periodic refreshes. Only the configs
+// of the destination chain contracts are invalidated this way, they are all emitted on the destination chain.
+var configEvents = []configEvent{
+	{contract: consts.ContractNameOffRamp, event: consts.EventNameSourceChainConfigSet, sourceChainConfigs: true},
+	{contract: consts.ContractNameOffRamp, event: consts.EventNameDynamicConfigSet},
+	{contract: consts.ContractNameRMNRemote, event: consts.EventNameConfigSet},
+	// The curse state is part of the chain config snapshot.
+	{contract: consts.ContractNameRMNRemote, event: consts.EventNameCursed},
+	{contract: consts.ContractNameRMNRemote, event: consts.EventNameUncursed},
+}
+
+type configEventKey struct {
+	chain    cciptypes.ChainSelector
+	contract string
+	event    string
+}
+
+// invalidateOnConfigEvents checks the destination chain for new config events and refreshes the
+// configs they invalidate right away, instead of waiting for the next periodic refresh.
+func (c *configPoller) invalidateOnConfigEvents() {
+	chainSelectors, sourceChainsMap := c.getChainsToRefresh()
+
+	destChain := c.reader.getDestChain()
+	if !slices.Contains(chainSelectors, destChain) {
+		// the destination chain config is not cached yet, there is nothing to invalidate
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), bgRefreshTimeout)
+	defer cancel()
+	c.invalidateChainOnConfigEvents(ctx, destChain, sourceChainsMap[destChain])
+}
+
+func (c *configPoller) invalidateChainOnConfigEvents(
+	ctx context.Context,
+	chainSel cciptypes.ChainSelector,
+	sourceChains []cciptypes.ChainSelector,
+) {
+	chainConfigStale := false
+	sourceChainConfigsStale := false
+	chainConfigCursors := make(map[configEventKey]string)
+	sourceChainCursors := make(map[configEventKey]string)
+
+	for _, ev := range configEvents {
+		key := configEventKey{chain: chainSel, contract: ev.contract, event: ev.event}
+		if _, unbound := c.unboundEvents[key]; unbound {
+			continue
+		}
+		height, found, err := c.latestConfigEvent(ctx, key)
+		if isUnboundErr(err) {
+			// The event is not bound in the contract reader, so it is not checked again.
+			c.unboundEvents[key] = struct{}{}
+			c.lggr.Warnw("Config event is not bound in the contract reader, the cached config is only refreshed periodically",
+				"chain", chainSel, "contract", ev.contract, "event", ev.event, "error", err)
+			continue
+		}
+		if err != nil {
+			// The periodic refresh is the fallback, but the cached config might be stale until then.
+			c.lggr.Warnw("Failed to check for config events",
+				"chain", chainSel, "contract", ev.contract, "event", ev.event, "error", err)
+			continue
+		}
+		if !found {
+			continue
+		}
+
+		c.lggr.Infow("Config event found, invalidating cached config",
+			"chain", chainSel, "contract", ev.contract, "event", ev.event, "block", height)
+		if ev.sourceChainConfigs {
+			sourceChainConfigsStale = true
+			sourceChainCursors[key] = height
+		} else {
+			chainConfigStale = true
+			chainConfigCursors[key] = height
+		}
+	}
+
+	// Cursors only move forward after a successful refresh, so a failed refresh is retried on the next tick.
+	if chainConfigStale {
+		if _, err := c.refreshChainConfig(ctx, chainSel); err != nil {
+			c.lggr.Warnw("Failed to refresh invalidated chain config", "chain", chainSel, "error", err)
+		} else {
+			c.advanceEventCursors(chainConfigCursors)
+		}
+	}
+
+	if sourceChainConfigsStale {
+		if len(sourceChains) > 0 {
+			if _, err := c.refreshSourceChainConfigs(ctx, chainSel, sourceChains); err != nil {
+				c.lggr.Warnw("Failed to refresh invalidated source chain configs",
+					"destChain", chainSel, "sourceChains", sourceChains, "error", err)
+				return
+			}
+		}
+		c.advanceEventCursors(sourceChainCursors)
+	}
+}
+
+// latestConfigEvent returns the block of the latest event after the cursor of the key. The first check of a
+// key only initializes the cursor to the latest existing event, since the cache was populated after it.
+func (c *configPoller) latestConfigEvent(ctx context.Context, key configEventKey) (string, bool, error) {
+	reader, exists := c.reader.getContractReader(key.chain)
+	if !exists {
+		return "", false, fmt.Errorf("no contract reader for chain %d", key.chain)
+	}
+
+	cursor, initialized := c.eventCursors[key]
+	expressions := []query.Expression{query.Confidence(primitives.Unconfirmed)}
+	if initialized {
+		expressions = append(expressions, query.Block(cursor, primitives.Gt))
+	}
+
+	seqs, err := reader.ExtendedQueryKey(
+		ctx,
+		key.contract,
+		query.KeyFilter{Key: key.event, Expressions: expressions},
+		query.LimitAndSort{
+			SortBy: []query.SortBy{query.NewSortBySequence(query.Desc)},
+			Limit:  query.Limit{Count: 1},
+		},
+		&map[string]any{},
+	)
+	if err != nil {
+		return "", false, fmt.Errorf("query %s events of %s: %w", key.event, key.contract, err)
+	}
+
+	if !initialized {
+		c.eventCursors[key] = "0"
+		if len(seqs) > 0 {
+			c.eventCursors[key] = seqs[0].Head.Height
+		}
+		return "", false, nil
+	}
+
+	if len(seqs) == 0 {
+		return "", false, nil
+	}
+	return seqs[0].Head.Height, true, nil
+}
+
+// isUnboundErr returns true if err is returned by the contract reader because the contract or the event is not bound,
+// rather than because of a transient failure.
+func isUnboundErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, contractreader.ErrNoBindings) {
+		return true
+	}
+	var invalidArgument types.InvalidArgumentError
+	var notFound types.NotFoundError
+	var unimplemented types.UnimplementedError
+	return errors.As(err, &invalidArgument) || errors.As(err, &notFound) || errors.As(err, &unimplemented)
+}
+
+func (c *configPoller) advanceEventCursors(cursors map[configEventKey]string) {
+	for key, height := range cursors {
+		c.eventCursors[key] = height
+	}
+}
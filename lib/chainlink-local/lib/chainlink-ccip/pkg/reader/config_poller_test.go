@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"testing"
 	"time"
 
@@ -13,6 +14,8 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
 	reader_mocks "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/contractreader"
@@ -32,7 +35,7 @@ func setupBasicCache(t *testing.T) (*configPoller, *reader_mocks.MockExtended) {
 		destChain: chainA,
 	}
 
-	cache := newConfigPoller(logger.Test(t), reader, 1*time.Second)
+	cache := newConfigPoller(logger.Test(t), reader, 1*time.Second, 0)
 	return cache, mockReader
 }
 
@@ -799,7 +802,7 @@ func TestConfigCache_Initialization(t *testing.T) {
 			ctx := tests.Context(t)
 
 			reader := tc.setupReader()
-			cache := newConfigPoller(lggr, reader, tc.refreshPeriod)
+			cache := newConfigPoller(lggr, reader, tc.refreshPeriod, 0)
 			require.NotNil(t, cache, "cache should never be nil after initialization")
 
 			require.NotNil(t, cache.chainCaches, "chainCaches map should never be nil")
@@ -958,7 +961,7 @@ func TestConfigCache_MultipleChains(t *testing.T) {
 		destChain: chainA,
 	}
 
-	cache := newConfigPoller(logger.Test(t), reader, 1*time.Second)
+	cache := newConfigPoller(logger.Test(t), reader, 1*time.Second, 0)
 	ctx := tests.Context(t)
 
 	// Setup mock response for both chains
@@ -1046,7 +1049,7 @@ func TestConfigCache_BackgroundRefreshPeriod(t *testing.T) {
 				destChain: chainA,
 			}
 
-			cache := newConfigPoller(logger.Test(t), reader, tc.refreshPeriod)
+			cache := newConfigPoller(logger.Test(t), reader, tc.refreshPeriod, 0)
 			ctx := tests.Context(t)
 
 			mockConfig := OCRConfigResponse{
@@ -1609,3 +1612,126 @@ func TestConfigPoller_GetChainsToRefresh(t *testing.T) {
 	assert.Contains(t, chains2, chainB, "Chain B should still be included")
 	assert.Empty(t, sourceChainsMap2, "Source chains map should be empty when none tracked")
 }
+
+func TestConfigPoller_SubscribeConfigChanges(t *testing.T) {
+	cache, _ := setupBasicCache(t)
+	chainCache := cache.getOrCreateChainCache(chainA)
+	require.NotNil(t, chainCache)
+
+	changes, cancel := cache.SubscribeConfigChanges()
+	defer cancel()
+
+	cache.setSourceChainConfigs(chainA, chainCache, map[cciptypes.ChainSelector]SourceChainConfig{
+		chainB: {IsEnabled: true, OnRamp: cciptypes.UnknownAddress{1, 2, 3}, MinSeqNr: 1},
+	})
+	ev := <-changes
+	assert.Equal(t, ConfigKindSourceChainConfig, ev.Kind)
+	assert.Equal(t, ConfigAdded, ev.ChangeType)
+	assert.Equal(t, chainA, ev.ChainSelector)
+	assert.Equal(t, chainB, ev.SourceChainSelector)
+
+	// a new min sequence number is not a config change
+	cache.setSourceChainConfigs(chainA, chainCache, map[cciptypes.ChainSelector]SourceChainConfig{
+		chainB: {IsEnabled: true, OnRamp: cciptypes.UnknownAddress{1, 2, 3}, MinSeqNr: 10},
+	})
+	require.Empty(t, changes)
+
+	cache.setSourceChainConfigs(chainA, chainCache, map[cciptypes.ChainSelector]SourceChainConfig{
+		chainB: {IsEnabled: false, OnRamp: cciptypes.UnknownAddress{1, 2, 3}, MinSeqNr: 10},
+	})
+	ev = <-changes
+	assert.Equal(t, ConfigUpdated, ev.ChangeType)
+	require.Len(t, ev.Diff, 1)
+	assert.Equal(t, "IsEnabled", ev.Diff[0].Path)
+	assert.Equal(t, "true", ev.Diff[0].Old)
+	assert.Equal(t, "false", ev.Diff[0].New)
+}
+
+func TestConfigPoller_InvalidateOnConfigEvents(t *testing.T) {
+	cache, reader := setupBasicCache(t)
+	ctx := tests.Context(t)
+	setupMockResponse(reader)
+
+	_, err := cache.GetChainConfig(ctx, chainA)
+	require.NoError(t, err)
+	reader.AssertNumberOfCalls(t, "ExtendedBatchGetLatestValues", 1)
+
+	// latest block of each event, events are only returned when they are past the requested block
+	latestEvents := map[string]int{consts.EventNameDynamicConfigSet: 5}
+	reader.EXPECT().ExtendedQueryKey(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(
+			_ context.Context, _ string, filter query.KeyFilter, _ query.LimitAndSort, _ any,
+		) ([]types.Sequence, error) {
+			height, ok := latestEvents[filter.Key]
+			if !ok {
+				return nil, nil
+			}
+			for _, expr := range filter.Expressions {
+				block, isBlock := expr.Primitive.(*primitives.Block)
+				if !isBlock {
+					continue
+				}
+				cursor, err := strconv.Atoi(block.Block)
+				require.NoError(t, err)
+				if height <= cursor {
+					return nil, nil
+				}
+			}
+			return []types.Sequence{{Head: types.Head{Height: fmt.Sprint(height)}}}, nil
+		})
+
+	// the first check only initializes the cursors to the existing events
+	cache.invalidateOnConfigEvents()
+	reader.AssertNumberOfCalls(t, "ExtendedBatchGetLatestValues", 1)
+	dynamicConfigKey := configEventKey{chainA, consts.ContractNameOffRamp, consts.EventNameDynamicConfigSet}
+	assert.Equal(t, "5", cache.eventCursors[dynamicConfigKey])
+
+	// no new events
+	cache.invalidateOnConfigEvents()
+	reader.AssertNumberOfCalls(t, "ExtendedBatchGetLatestValues", 1)
+
+	// a new config event refreshes the chain config immediately
+	latestEvents[consts.EventNameConfigSet] = 7
+	cache.invalidateOnConfigEvents()
+	reader.AssertNumberOfCalls(t, "ExtendedBatchGetLatestValues", 2)
+	rmnConfigKey := configEventKey{chainA, consts.ContractNameRMNRemote, consts.EventNameConfigSet}
+	assert.Equal(t, "7", cache.eventCursors[rmnConfigKey])
+
+	// the event is not processed twice
+	cache.invalidateOnConfigEvents()
+	reader.AssertNumberOfCalls(t, "ExtendedBatchGetLatestValues", 2)
+
+	// curses change the cached curse info
+	latestEvents[consts.EventNameCursed] = 9
+	cache.invalidateOnConfigEvents()
+	reader.AssertNumberOfCalls(t, "ExtendedBatchGetLatestValues", 3)
+	cursedKey := configEventKey{chainA, consts.ContractNameRMNRemote, consts.EventNameCursed}
+	assert.Equal(t, "9", cache.eventCursors[cursedKey])
+}
+
+func TestConfigPoller_InvalidateOnConfigEvents_Unbound(t *testing.T) {
+	cache, reader := setupBasicCache(t)
+	ctx := tests.Context(t)
+	setupMockResponse(reader)
+
+	_, err := cache.GetChainConfig(ctx, chainA)
+	require.NoError(t, err)
+
+	// only the offramp is bound, the RMNRemote events are not checked again after the first failure
+	reader.EXPECT().
+		ExtendedQueryKey(mock.Anything, consts.ContractNameOffRamp, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil)
+	reader.EXPECT().
+		ExtendedQueryKey(mock.Anything, consts.ContractNameRMNRemote, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("ExtendedQueryKey: %w", contractreader.ErrNoBindings))
+
+	cache.invalidateOnConfigEvents()
+	cache.invalidateOnConfigEvents()
+	reader.AssertNumberOfCalls(t, "ExtendedQueryKey", 2*2+3)
+	assert.Len(t, cache.unboundEvents, 3)
+	assert.Contains(t, cache.unboundEvents, configEventKey{chainA, consts.ContractNameRMNRemote, consts.EventNameCursed})
+
+	// transient failures are retried
+	assert.False(t, isUnboundErr(errors.New("connection refused")))
+	assert.True(t, isUnboundErr(fmt.Errorf("query: %w", types.InvalidArgumentError("key not found"))))
+}
@@ -24,6 +24,24 @@ type OCR3Config = reader_internal.OCR3Config
 
 type OCR3Node = reader_internal.OCR3Node
 
+type ConfigChangeEvent = reader_internal.ConfigChangeEvent
+
+type ConfigKind = reader_internal.ConfigKind
+
+type ConfigChangeType = reader_internal.ConfigChangeType
+
+const (
+	ConfigKindChainConfig       = reader_internal.ConfigKindChainConfig
+	ConfigKindOCR3Config        = reader_internal.ConfigKindOCR3Config
+	ConfigKindRMNHomeConfig     = reader_internal.ConfigKindRMNHomeConfig
+	ConfigKindSourceChainConfig = reader_internal.ConfigKindSourceChainConfig
+
+	ConfigAdded    = reader_internal.ConfigAdded
+	ConfigUpdated  = reader_internal.ConfigUpdated
+	ConfigRemoved  = reader_internal.ConfigRemoved
+	ConfigPromoted = reader_internal.ConfigPromoted
+)
+
 func NewObservedHomeChainReader(
 	homeChainReader types.ContractReader,
 	lggr logger.Logger,
@@ -39,6 +39,9 @@ type RMNHome interface {
 	GetOffChainConfig(configDigest cciptypes.Bytes32) (cciptypes.Bytes, error)
 	// GetAllConfigDigests gets the active and candidate RMNHomeConfigs
 	GetAllConfigDigests() (activeConfigDigest cciptypes.Bytes32, candidateConfigDigest cciptypes.Bytes32)
+	// SubscribeConfigChanges returns a channel of RMNHome config change events, e.g. candidate promotions,
+	// and a function to cancel the subscription.
+	SubscribeConfigChanges() (<-chan ConfigChangeEvent, func())
 	services.Service
 }
 
@@ -155,6 +158,10 @@ func (r *rmnHome) GetAllConfigDigests() (
 	return state.activeConfigDigest, state.candidateConfigDigest
 }
 
+func (r *rmnHome) SubscribeConfigChanges() (<-chan ConfigChangeEvent, func()) {
+	return r.bgPoller.changes.Subscribe()
+}
+
 func (r *rmnHome) Ready() error {
 	return r.sync.Ready()
 }
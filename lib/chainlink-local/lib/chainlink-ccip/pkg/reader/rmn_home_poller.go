@@ -20,6 +20,7 @@ import (
 	ragep2ptypes "github.com/smartcontractkit/libocr/ragep2p/types"
 
 	rmntypes "github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn/types"
+	reader_internal "github.com/smartcontractkit/chainlink-ccip/internal/reader"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
@@ -58,6 +59,7 @@ type rmnHomePoller struct {
 	rmnHomeState   rmnHomeState
 	rmnHomeStateMu *sync.RWMutex
 	failedPolls    atomic.Uint32
+	changes        *reader_internal.ConfigChangeFeed
 }
 
 // getRMNHomePoller returns a rmnHomePoller instance if it already exists, else creates a new one.
@@ -142,6 +144,7 @@ func newRMNHomePoller(
 		failedPolls:          atomic.Uint32{},
 		lggr:                 lggr,
 		pollingDuration:      pollingInterval,
+		changes:              reader_internal.NewConfigChangeFeed(lggr),
 	}
 }
 
@@ -280,12 +283,20 @@ func (r *rmnHomePoller) setRMNHomeState(
 	rmnHomeConfig map[cciptypes.Bytes32]rmntypes.HomeConfig,
 ) {
 	r.rmnHomeStateMu.Lock()
-	defer r.rmnHomeStateMu.Unlock()
-
 	s := &r.rmnHomeState
+	events := reader_internal.DiffActiveAndCandidate(
+		ConfigChangeEvent{Kind: ConfigKindRMNHomeConfig},
+		s.activeConfigDigest, s.candidateConfigDigest,
+		s.rmnHomeConfig[s.activeConfigDigest], s.rmnHomeConfig[s.candidateConfigDigest],
+		activeConfigDigest, candidateConfigDigest,
+		rmnHomeConfig[activeConfigDigest], rmnHomeConfig[candidateConfigDigest],
+	)
 	s.activeConfigDigest = activeConfigDigest
 	s.candidateConfigDigest = candidateConfigDigest
 	s.rmnHomeConfig = rmnHomeConfig
+	r.rmnHomeStateMu.Unlock()
+
+	r.changes.Send(events...)
 }
 
 func validate(config VersionedConfig) error {
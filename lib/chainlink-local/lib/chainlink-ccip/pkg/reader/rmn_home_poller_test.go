@@ -17,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 
+	rmntypes "github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn/types"
 	readermock "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/contractreader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
@@ -426,6 +427,40 @@ func createTestRMNHomeConfigs(
 	return primary, secondary
 }
 
+func TestRMNHomePoller_SubscribeConfigChanges(t *testing.T) {
+	reader := readermock.NewMockContractReaderFacade(t)
+	home := newRMNHomeForTests(t, reader, rmnHomeBoundContract, time.Minute)
+
+	changes, cancel := home.SubscribeConfigChanges()
+	defer cancel()
+
+	active := cciptypes.Bytes32{1}
+	candidate := cciptypes.Bytes32{2}
+	configs := map[cciptypes.Bytes32]rmntypes.HomeConfig{
+		active:    {ConfigDigest: active, SourceChainF: map[cciptypes.ChainSelector]int{1: 1}},
+		candidate: {ConfigDigest: candidate, SourceChainF: map[cciptypes.ChainSelector]int{1: 2}},
+	}
+
+	home.bgPoller.setRMNHomeState(active, candidate, configs)
+	ev := <-changes
+	require.Equal(t, ConfigKindRMNHomeConfig, ev.Kind)
+	require.Equal(t, ConfigAdded, ev.ChangeType)
+
+	// same state, no events
+	home.bgPoller.setRMNHomeState(active, candidate, configs)
+	require.Empty(t, changes)
+
+	// candidate gets promoted
+	home.bgPoller.setRMNHomeState(candidate, cciptypes.Bytes32{}, configs)
+	ev = <-changes
+	require.Equal(t, ConfigPromoted, ev.ChangeType)
+	diffPaths := make([]string, len(ev.Diff))
+	for i, d := range ev.Diff {
+		diffPaths[i] = d.Path
+	}
+	require.Equal(t, []string{"ConfigDigest", "SourceChainF[ChainSelector(1)]"}, diffPaths)
+}
+
 func newRMNHomeForTests(
 	t *testing.T,
 	reader *readermock.MockContractReaderFacade,
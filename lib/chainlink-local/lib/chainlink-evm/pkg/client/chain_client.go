@@ -106,6 +106,18 @@ type chainClient struct {
 	logger       logger.SugaredLogger
 	chainType    chaintype.ChainType
 	clientErrors evmconfig.ClientErrors
+	hedger       *hedger
+}
+
+// ChainClientOption configures the Client returned by NewChainClient.
+type ChainClientOption func(*chainClient)
+
+// WithHedging hedges the calls of the client with the hedging config, see evmconfig.Hedging. Calls are not hedged by
+// default.
+func WithHedging(hedging evmconfig.Hedging) ChainClientOption {
+	return func(c *chainClient) {
+		c.hedger = newHedger(c.multiNode.ChainID().String(), hedging)
+	}
 }
 
 func NewChainClient(
@@ -119,6 +131,7 @@ func NewChainClient(
 	clientErrors evmconfig.ClientErrors,
 	deathDeclarationDelay time.Duration,
 	chainType chaintype.ChainType,
+	opts ...ChainClientOption,
 ) Client {
 	chainFamily := "EVM"
 	multiNode := multinode.NewMultiNode[*big.Int, *RPCClient](
@@ -143,13 +156,18 @@ func NewChainClient(
 		0, // use the default value provided by the implementation
 	)
 
-	return &chainClient{
+	c := &chainClient{
 		multiNode:    multiNode,
 		txSender:     txSender,
 		logger:       logger.Sugared(lggr),
 		chainType:    chainType,
 		clientErrors: clientErrors,
+		hedger:       newHedger(chainID.String(), nil),
+	}
+	for _, opt := range opts {
+		opt(c)
 	}
+	return c
 }
 
 func (c *chainClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
@@ -247,11 +265,34 @@ func (c *chainClient) CallContext(ctx context.Context, result interface{}, metho
 }
 
 func (c *chainClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return hedge(ctx, c, "CallContract", func(ctx context.Context, r *RPCClient) ([]byte, error) {
+		return r.CallContract(ctx, msg, blockNumber)
+	})
+}
+
+// hedge calls the selected node, or the fastest node if PreferFastestNode is set, and hedges the call to a second
+// healthy node if enabled, see hedgedCall.
+func hedge[T any](ctx context.Context, c *chainClient, method string, call func(context.Context, *RPCClient) (T, error)) (T, error) {
 	r, err := c.multiNode.SelectRPC(ctx)
 	if err != nil {
-		return nil, err
+		var zero T
+		return zero, err
+	}
+	if !c.hedger.enabled() && !c.hedger.preferFastestNode() {
+		return timedCall(ctx, c.hedger, method, r, call)
+	}
+	var nodes []*RPCClient
+	_ = c.multiNode.DoAll(ctx, func(_ context.Context, rpc *RPCClient, isSendOnly bool) {
+		if !isSendOnly {
+			nodes = append(nodes, rpc)
+		}
+	})
+	if c.hedger.preferFastestNode() {
+		if fastest := c.hedger.fastest(method, nodes, nil); fastest != nil {
+			r = fastest
+		}
 	}
-	return r.CallContract(ctx, msg, blockNumber)
+	return hedgedCall(ctx, c.hedger, method, r, nodes, call)
 }
 
 func (c *chainClient) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
@@ -295,11 +336,9 @@ func (c *chainClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (u
 	return r.EstimateGas(ctx, call)
 }
 func (c *chainClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
-	r, err := c.multiNode.SelectRPC(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return r.FilterEvents(ctx, q)
+	return hedge(ctx, c, "FilterLogs", func(ctx context.Context, r *RPCClient) ([]types.Log, error) {
+		return r.FilterEvents(ctx, q)
+	})
 }
 
 func (c *chainClient) HeaderByHash(ctx context.Context, h common.Hash) (head *types.Header, err error) {
@@ -376,13 +415,14 @@ func (c *chainClient) PendingNonceAt(ctx context.Context, account common.Address
 	return uint64(n), err
 }
 
+// SendTransaction broadcasts the transaction to all healthy nodes, except on Hedera where it is sent to the selected
+// node only, and hedged if enabled.
 func (c *chainClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
 	if c.chainType == chaintype.ChainHedera {
-		activeRPC, err := c.multiNode.SelectRPC(ctx)
-		if err != nil {
-			return err
-		}
-		_, _, err = activeRPC.SendTransaction(ctx, tx)
+		_, err := hedge(ctx, c, "SendTransaction", func(ctx context.Context, r *RPCClient) (struct{}, error) {
+			_, _, err := r.SendTransaction(ctx, tx)
+			return struct{}{}, err
+		})
 		return err
 	}
 	_, _, err := c.txSender.SendTransaction(ctx, tx)
@@ -391,7 +431,7 @@ func (c *chainClient) SendTransaction(ctx context.Context, tx *types.Transaction
 
 func (c *chainClient) SendTransactionReturnCode(ctx context.Context, tx *types.Transaction, fromAddress common.Address) (multinode.SendTxReturnCode, error) {
 	err := c.SendTransaction(ctx, tx)
-	returnCode := ClassifySendError(err, c.clientErrors, c.logger, tx, fromAddress, c.IsL2())
+	returnCode := classifySendErrorOf(c.chainType, "", err, c.clientErrors, c.logger, tx, fromAddress)
 	return returnCode, err
 }
 
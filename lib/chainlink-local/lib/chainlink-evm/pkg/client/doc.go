@@ -6,5 +6,17 @@ anything other than `latest`, `nil`, or the latest block are passed to
 The simulated client avoids the old block error from the simulated backend by
 passing `nil` to `CallContract` when calling `CallContext` or `BatchCallContext`
 and will not return an error when an old block is used.
+
+For deterministic tests against real-world chain behaviour, the recording client
+(NewRecordingClient) records all JSON-RPC calls of a session with a real RPC to
+a file, and the replay client (NewReplayClient) answers the same calls from the
+file without network. Calls are matched by method and params.
+
+The errors returned by RPC nodes, chains and providers are classified with the
+error catalog in errors.toml, which declares their patterns per error type,
+optionally restricted to JSON-RPC error codes, along with test vectors
+validated when the package is loaded. A new chain or provider is supported by
+adding an entry to the catalog. Operators may merge the entries of their own
+catalog file over the embedded ones, see LoadErrorCatalog.
 */
 package client
@@ -17,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink-framework/multinode"
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/config"
+	"github.com/smartcontractkit/chainlink-evm/pkg/config/chaintype"
 	"github.com/smartcontractkit/chainlink-evm/pkg/label"
 )
 
@@ -24,6 +25,10 @@ import (
 type SendError struct {
 	fatal bool
 	err   error
+
+	// catalog classifies the error, with its entries selected by scope, see ClassifySendError
+	catalog *ErrorCatalog
+	scope   errorScope
 }
 
 func (s *SendError) Error() string {
@@ -34,10 +39,7 @@ func (s *SendError) Error() string {
 // Fatal errors mean that no matter how many times the send is retried, no node
 // will ever accept it
 func (s *SendError) Fatal(configErrors *ClientErrors) bool {
-	if configErrors != nil && configErrors.ErrIs(s.err, Fatal) {
-		return true
-	}
-	return s != nil && s.fatal
+	return s != nil && (s.fatal || s.is(Fatal, configErrors))
 }
 
 const (
@@ -88,218 +90,6 @@ func (e *ClientErrors) ErrIs(err error, errorTypes ...int) bool {
 	return false
 }
 
-// Parity
-// See: https://github.com/openethereum/openethereum/blob/master/rpc/src/v1/helpers/errors.rs#L420
-var parFatal = regexp.MustCompile(`^Transaction gas is too low. There is not enough gas to cover minimal cost of the transaction|^Transaction cost exceeds current gas limit. Limit:|^Invalid signature|Recipient is banned in local queue.|Supplied gas is beyond limit|Sender is banned in local queue|Code is banned in local queue|Transaction is not permitted|Transaction is too big, see chain specification for the limit|^Invalid RLP data`)
-var parity = ClientErrors{
-	NonceTooLow:                       regexp.MustCompile("^Transaction nonce is too low. Try incrementing the nonce."),
-	ReplacementTransactionUnderpriced: regexp.MustCompile("^Transaction gas price .+is too low. There is another transaction with same nonce in the queue"),
-	LimitReached:                      regexp.MustCompile("There are too many transactions in the queue. Your transaction was dropped due to limit. Try increasing the fee."),
-	TransactionAlreadyInMempool:       regexp.MustCompile("Transaction with the same hash was already imported."),
-	TerminallyUnderpriced:             regexp.MustCompile("^Transaction gas price is too low. It does not satisfy your node's minimal gas price"),
-	InsufficientEth:                   regexp.MustCompile("^(Insufficient funds. The account you tried to send transaction from does not have enough funds.|Insufficient balance for transaction.)"),
-	Fatal:                             parFatal,
-}
-
-// Geth
-// See: https://github.com/ethereum/go-ethereum/blob/b9df7ecdc3d3685180ceb29665bab59e9f614da5/core/tx_pool.go#L516
-var gethFatal = regexp.MustCompile(`(: |^)(exceeds block gas limit|invalid sender|negative value|oversized data|gas uint64 overflow|intrinsic gas too low)$`)
-var geth = ClientErrors{
-	NonceTooLow:                       regexp.MustCompile(`(: |^)nonce too low$`),
-	NonceTooHigh:                      regexp.MustCompile(`(: |^)nonce too high$`),
-	ReplacementTransactionUnderpriced: regexp.MustCompile(`(: |^)replacement transaction underpriced$`),
-	TransactionAlreadyInMempool:       regexp.MustCompile(`(: |^)(?i)(known transaction|already known)`),
-	TerminallyUnderpriced:             regexp.MustCompile(`(: |^)transaction underpriced$`),
-	InsufficientEth:                   regexp.MustCompile(`(: |^)(insufficient funds for transfer|insufficient funds for gas \* price \+ value|insufficient balance for transfer|transaction would cause overdraft)$`),
-	TxFeeExceedsCap:                   regexp.MustCompile(`(: |^)tx fee \([0-9\.]+ [a-zA-Z]+\) exceeds the configured cap \([0-9\.]+ [a-zA-Z]+\)$`),
-	Fatal:                             gethFatal,
-}
-
-// Besu
-// See: https://github.com/hyperledger/besu/blob/81f25e15f9891787829b532f2fb38c8c43fd6b2e/ethereum/api/src/main/java/org/hyperledger/besu/ethereum/api/jsonrpc/internal/response/JsonRpcError.java
-var besuFatal = regexp.MustCompile(`^(Intrinsic gas exceeds gas limit|Transaction gas limit exceeds block gas limit|Invalid signature)$`)
-var besu = ClientErrors{
-	NonceTooLow:                       regexp.MustCompile(`^Nonce too low$`),
-	ReplacementTransactionUnderpriced: regexp.MustCompile(`^Replacement transaction underpriced$`),
-	TransactionAlreadyInMempool:       regexp.MustCompile(`^Known transaction$`),
-	TerminallyUnderpriced:             regexp.MustCompile(`^Gas price below configured minimum gas price$`),
-	InsufficientEth:                   regexp.MustCompile(`^Upfront cost exceeds account balance$`),
-	TxFeeExceedsCap:                   regexp.MustCompile(`^Transaction fee cap exceeded$`),
-	Fatal:                             besuFatal,
-}
-
-// Erigon
-// See:
-//   - https://github.com/ledgerwatch/erigon/blob/devel/core/tx_pool.go
-//   - https://github.com/ledgerwatch/erigon/blob/devel/core/error.go
-//   - https://github.com/ledgerwatch/erigon/blob/devel/core/vm/errors.go
-//
-// Note: some error definitions are unused, many errors are created inline.
-var erigonFatal = regexp.MustCompile(`(: |^)(exceeds block gas limit|invalid sender|negative value|oversized data|gas uint64 overflow|intrinsic gas too low)$`)
-var erigon = ClientErrors{
-	NonceTooLow:                       regexp.MustCompile(`(: |^)nonce too low$`),
-	NonceTooHigh:                      regexp.MustCompile(`(: |^)nonce too high$`),
-	ReplacementTransactionUnderpriced: regexp.MustCompile(`(: |^)replacement transaction underpriced$`),
-	TransactionAlreadyInMempool:       regexp.MustCompile(`(: |^)(block already known|already known)`),
-	TerminallyUnderpriced:             regexp.MustCompile(`(: |^)transaction underpriced$`),
-	InsufficientEth:                   regexp.MustCompile(`(: |^)(insufficient funds for transfer|insufficient funds for gas \* price \+ value|insufficient balance for transfer)$`),
-	TxFeeExceedsCap:                   regexp.MustCompile(`(: |^)tx fee \([0-9\.]+ [a-zA-Z]+\) exceeds the configured cap \([0-9\.]+ [a-zA-Z]+\)$`),
-	Fatal:                             erigonFatal,
-}
-
-// Arbitrum
-// https://github.com/OffchainLabs/arbitrum/blob/cac30586bc10ecc1ae73e93de517c90984677fdb/packages/arb-evm/evm/result.go#L158
-// nitro: https://github.com/OffchainLabs/go-ethereum/blob/master/core/state_transition.go
-var arbitrumFatal = regexp.MustCompile(`(: |^)(invalid message format|forbidden sender address)$|(: |^)(execution reverted)(:|$)`)
-var arbitrum = ClientErrors{
-	// TODO: Arbitrum returns this in case of low or high nonce. Update this when Arbitrum fix it
-	// Archived ticket: story/16801/add-full-support-for-incorrect-nonce-on-arbitrum
-	NonceTooLow:           regexp.MustCompile(`(: |^)invalid transaction nonce$|(: |^)nonce too low(:|$)`),
-	NonceTooHigh:          regexp.MustCompile(`(: |^)nonce too high(:|$)`),
-	TerminallyUnderpriced: regexp.MustCompile(`(: |^)gas price too low$`),
-	InsufficientEth:       regexp.MustCompile(`(: |^)(not enough funds for gas|insufficient funds for gas \* price \+ value)`),
-	Fatal:                 arbitrumFatal,
-	L2FeeTooLow:           regexp.MustCompile(`(: |^)max fee per gas less than block base fee(:|$)`),
-	L2Full:                regexp.MustCompile(`(: |^)(queue full|sequencer pending tx pool full, please try again)(:|$)`),
-	ServiceUnavailable:    regexp.MustCompile(`(: |^)502 Bad Gateway: [\s\S]*$|network is unreachable|i/o timeout|(: |^)503 Service Temporarily Unavailable(:|$)`),
-	ServiceTimeout:        regexp.MustCompile(`(: |^)408 Request Timeout(:|$)`),
-}
-
-// Treasure
-var treasureFatal = regexp.MustCompile(`(: |^)invalid chain id for signer(:|$)`)
-var treasure = ClientErrors{
-	Fatal: treasureFatal,
-}
-
-var celo = ClientErrors{
-	TxFeeExceedsCap:       regexp.MustCompile(`(: |^)tx fee \([0-9\.]+ of currency celo\) exceeds the configured cap \([0-9\.]+ [a-zA-Z]+\)$`),
-	TerminallyUnderpriced: regexp.MustCompile(`(: |^)gasprice is less than gas price minimum floor`),
-	InsufficientEth:       regexp.MustCompile(`(: |^)insufficient funds for gas \* price \+ value \+ gatewayFee$`),
-	LimitReached:          regexp.MustCompile(`(: |^)txpool is full`),
-}
-
-var metis = ClientErrors{
-	L2FeeTooLow: regexp.MustCompile(`(: |^)gas price too low: \d+ wei, use at least tx.gasPrice = \d+ wei$`),
-}
-
-// Substrate (Moonriver)
-var substrate = ClientErrors{
-	NonceTooLow:                 regexp.MustCompile(`(: |^)Pool\(Stale\)$`),
-	TransactionAlreadyInMempool: regexp.MustCompile(`(: |^)Pool\(AlreadyImported\)$`),
-}
-
-var avalanche = ClientErrors{
-	NonceTooLow: regexp.MustCompile(`(: |^)nonce too low: address 0x[0-9a-fA-F]{40} current nonce \([\d]+\) > tx nonce \([\d]+\)$`),
-}
-
-// Klaytn
-// https://github.com/klaytn/klaytn/blob/dev/blockchain/error.go
-// https://github.com/klaytn/klaytn/blob/dev/blockchain/tx_pool.go
-var klaytn = ClientErrors{
-	NonceTooLow:                       regexp.MustCompile(`(: |^)nonce too low$`),                                                                                    // retry with an increased nonce
-	TransactionAlreadyInMempool:       regexp.MustCompile(`(: |^)(known transaction)`),                                                                               // don't send the tx again. The exactly same tx is already in the mempool
-	ReplacementTransactionUnderpriced: regexp.MustCompile(`(: |^)replacement transaction underpriced$|there is another tx which has the same nonce in the tx pool$`), // retry with an increased gasPrice or maxFeePerGas. This error happened when there is another tx having higher gasPrice or maxFeePerGas exist in the mempool
-	TerminallyUnderpriced:             regexp.MustCompile(`(: |^)(transaction underpriced|^intrinsic gas too low)`),                                                  // retry with an increased gasPrice or maxFeePerGas
-	LimitReached:                      regexp.MustCompile(`(: |^)txpool is full`),                                                                                    // retry with few seconds wait
-	InsufficientEth:                   regexp.MustCompile(`(: |^)insufficient funds`),                                                                                // stop to send a tx. The sender address doesn't have enough KLAY
-	TxFeeExceedsCap:                   regexp.MustCompile(`(: |^)(invalid gas fee cap|max fee per gas higher than max priority fee per gas)`),                        // retry with a valid gasPrice, maxFeePerGas, or maxPriorityFeePerGas. The new value can get from the return of `eth_gasPrice`
-	Fatal:                             gethFatal,
-}
-
-// Nethermind
-// All errors: https://github.com/NethermindEth/nethermind/blob/master/src/Nethermind/Nethermind.TxPool/AcceptTxResult.cs
-// All filters: https://github.com/NethermindEth/nethermind/tree/9b68ec048c65f4b44fb863164c0dec3f7780d820/src/Nethermind/Nethermind.TxPool/Filters
-var nethermindFatal = regexp.MustCompile(`(: |^)(SenderIsContract|Invalid(, transaction Hash is null)?|Int256Overflow|FailedToResolveSender|GasLimitExceeded(, Gas limit: \d+, gas limit of rejected tx: \d+)?)$`)
-var nethermind = ClientErrors{
-	// OldNonce: The EOA (externally owned account) that signed this transaction (sender) has already signed and executed a transaction with the same nonce.
-	NonceTooLow:  regexp.MustCompile(`(: |^)OldNonce(, Current nonce: \d+, nonce of rejected tx: \d+)?$`),
-	NonceTooHigh: regexp.MustCompile(`(: |^)NonceGap(, Future nonce. Expected nonce: \d+)?$`),
-
-	// FeeTooLow/FeeTooLowToCompete: Fee paid by this transaction is not enough to be accepted in the mempool.
-	TerminallyUnderpriced: regexp.MustCompile(`(: |^)(FeeTooLow(, MaxFeePerGas too low. MaxFeePerGas: \d+, BaseFee: \d+, MaxPriorityFeePerGas:\d+, Block number: \d+|` +
-		`, EffectivePriorityFeePerGas too low \d+ < \d+, BaseFee: \d+|` +
-		`, FeePerGas needs to be higher than \d+ to be added to the TxPool. Affordable FeePerGas of rejected tx: \d+.)?|` +
-		`FeeTooLowToCompete)$`),
-
-	// AlreadyKnown: A transaction with the same hash has already been added to the pool in the past.
-	// OwnNonceAlreadyUsed: A transaction with same nonce has been signed locally already and is awaiting in the pool.
-	TransactionAlreadyInMempool: regexp.MustCompile(`(: |^)(AlreadyKnown|OwnNonceAlreadyUsed)$`),
-
-	// InsufficientFunds: Sender account has not enough balance to execute this transaction.
-	InsufficientEth:    regexp.MustCompile(`(: |^)InsufficientFunds(, Account balance: \d+, cumulative cost: \d+|, Balance is \d+ less than sending value \+ gas \d+)?$`),
-	ServiceUnavailable: regexp.MustCompile(`(: |^)503 Service Unavailable: [\s\S]*$`),
-	Fatal:              nethermindFatal,
-}
-
-// Harmony
-// https://github.com/harmony-one/harmony/blob/main/core/tx_pool.go#L49
-var harmonyFatal = regexp.MustCompile("(: |^)(invalid shard|staking message does not match directive message|`from` address of transaction in blacklist|`to` address of transaction in blacklist)$")
-var harmony = ClientErrors{
-	TransactionAlreadyMined: regexp.MustCompile(`(: |^)transaction already finalized$`),
-	Fatal:                   harmonyFatal,
-}
-
-var zkSync = ClientErrors{
-	NonceTooLow:           regexp.MustCompile(`(?:: |^)nonce too low\..+actual: \d*$`),
-	NonceTooHigh:          regexp.MustCompile(`(?:: |^)nonce too high\..+actual: \d*$`),
-	TerminallyUnderpriced: regexp.MustCompile(`(?:: |^)(max fee per gas less than block base fee|virtual machine entered unexpected state. (?:P|p)lease contact developers and provide transaction details that caused this error. Error description: (?:The operator included transaction with an unacceptable gas price|Assertion error: Fair pubdata price too high))$`),
-	InsufficientEth:       regexp.MustCompile(`(?:: |^)(?:insufficient balance for transfer$|insufficient funds for gas + value)`),
-	TxFeeExceedsCap:       regexp.MustCompile(`(?:: |^)max priority fee per gas higher than max fee per gas$`),
-	// intrinsic gas too low 						- gas limit less than 14700
-	// Not enough gas for transaction validation 	- gas limit less than L2 fee
-	// Failed to pay the fee to the operator 		- gas limit less than L2+L1 fee
-	// Error function_selector = 0x, data = 0x 		- contract call with gas limit of 0
-	// can't start a transaction from a non-account - trying to send from an invalid address, e.g. estimating a contract -> contract tx
-	// max fee per gas higher than 2^64-1 			- uint64 overflow
-	// oversized data 								- data too large
-	Fatal:                       regexp.MustCompile(`(?:: |^)(?:exceeds block gas limit|intrinsic gas too low|Not enough gas for transaction validation|Failed to pay the fee to the operator|Error function_selector = 0x, data = 0x|invalid sender. can't start a transaction from a non-account|max(?: priority)? fee per (?:gas|pubdata byte) higher than 2\^64-1|oversized data. max: \d+; actual: \d+)$`),
-	TransactionAlreadyInMempool: regexp.MustCompile(`known transaction. transaction with hash .* is already in the system`),
-}
-
-var zkEvm = ClientErrors{
-	TerminallyStuck: regexp.MustCompile(`(?:: |^)(?:not enough .* counters to continue the execution|out of counters at node level (?:.*))$`),
-}
-
-var aStar = ClientErrors{
-	TerminallyUnderpriced: regexp.MustCompile(`(?:: |^)(gas price less than block base fee)$`),
-}
-
-var mantle = ClientErrors{
-	InsufficientEth:                   regexp.MustCompile(`(: |^)'*insufficient funds for gas \* price \+ value`),
-	Fatal:                             regexp.MustCompile(`(: |^)'*invalid sender`),
-	NonceTooLow:                       regexp.MustCompile(`(: |^)'*nonce too low`),
-	ReplacementTransactionUnderpriced: regexp.MustCompile(`(: |^)'*replacement transaction underpriced`),
-	TransactionAlreadyInMempool:       regexp.MustCompile(`(: |^)'*already known`),
-}
-
-var hederaFatal = regexp.MustCompile(`(: |^)(execution reverted)(:|$) | ^Transaction gas limit '(\d+)' exceeds block gas limit '(\d+)' | ^Transaction gas limit provided '(\d+)' is insufficient of intrinsic gas required '(\d+)' | ^Oversized data:|status INVALID_SIGNATURE`)
-var hedera = ClientErrors{
-	NonceTooLow:           regexp.MustCompile(`Nonce too low`),
-	NonceTooHigh:          regexp.MustCompile(`Nonce too high`),
-	TerminallyUnderpriced: regexp.MustCompile(`(Gas price '(\d+)' is below configured minimum gas price '(\d+)')|(Gas price too low)`),
-	InsufficientEth:       regexp.MustCompile(`Insufficient funds for transfer| failed precheck with status INSUFFICIENT_PAYER_BALANCE`),
-	ServiceUnavailable:    regexp.MustCompile(`Transaction execution returns a null value for transaction`),
-	Fatal:                 hederaFatal,
-}
-
-var gnosis = ClientErrors{
-	TransactionAlreadyInMempool: regexp.MustCompile(`(: |^)(alreadyknown)`),
-}
-
-var sei = ClientErrors{
-	// https://github.com/sei-protocol/sei-tendermint/blob/e9a22c961e83579d8a68cd045c532980d82fb2a0/types/mempool.go#L12
-	TransactionAlreadyInMempool: regexp.MustCompile("tx already exists in cache"),
-	// https://github.com/sei-protocol/sei-cosmos/blob/a4eb451c957b1ca7ca9118406682f93fe83d1f61/types/errors/errors.go#L50
-	// https://github.com/sei-protocol/sei-cosmos/blob/a4eb451c957b1ca7ca9118406682f93fe83d1f61/types/errors/errors.go#L56
-	// https://github.com/sei-protocol/sei-cosmos/blob/a4eb451c957b1ca7ca9118406682f93fe83d1f61/client/broadcast.go#L27
-	// https://github.com/sei-protocol/sei-cosmos/blob/a4eb451c957b1ca7ca9118406682f93fe83d1f61/types/errors/errors.go#L32
-	Fatal: regexp.MustCompile(`(: |^)'*out of gas|insufficient fee|Tx too large. Max size is \d+, but got \d+|: insufficient funds`),
-}
-
-var monad = ClientErrors{
-	Fatal: regexp.MustCompile("Gas limit too low"),
-}
-
 const TerminallyStuckMsg = "transaction terminally stuck"
 
 // Tx.Error messages that are set internally so they are not chain or client specific
@@ -307,8 +97,6 @@ var internal = ClientErrors{
 	TerminallyStuck: regexp.MustCompile(TerminallyStuckMsg),
 }
 
-var clients = []ClientErrors{parity, geth, arbitrum, metis, substrate, avalanche, nethermind, harmony, besu, erigon, klaytn, celo, zkSync, zkEvm, treasure, mantle, aStar, hedera, gnosis, sei, monad, internal}
-
 // ClientErrorRegexes returns a map of compiled regexes for each error type
 func ClientErrorRegexes(errsRegex config.ClientErrors) *ClientErrors {
 	if errsRegex == nil {
@@ -340,12 +128,14 @@ func (s *SendError) is(errorType int, configErrors *ClientErrors) bool {
 	if configErrors != nil && configErrors.ErrIs(s.err, errorType) {
 		return true
 	}
-	for _, client := range clients {
-		if client.ErrIs(s.err, errorType) {
-			return true
-		}
+	if internal.ErrIs(s.err, errorType) {
+		return true
 	}
-	return false
+	catalog := s.catalog
+	if catalog == nil {
+		catalog = defaultErrorCatalog
+	}
+	return catalog.errIs(s.err, s.scope, errorType)
 }
 
 // IsReplacementUnderpriced indicates that a transaction already exists in the mempool with this nonce but a different gas price or payload
@@ -481,21 +271,10 @@ func NewTxError(e error) commontypes.ErrorClassifier {
 // Geth/parity returns these errors if the transaction failed in such a way that:
 // 1. It will never be included into a block as a result of this send
 // 2. Resending the transaction at a different gas price will never change the outcome
+//
+// Only the errors of the embedded error catalog are considered, as the config of the chain is unknown.
 func isFatalSendError(err error) bool {
-	if err == nil {
-		return false
-	}
-	str := pkgerrors.Cause(err).Error()
-
-	for _, client := range clients {
-		if _, ok := client[Fatal]; !ok {
-			continue
-		}
-		if client[Fatal].MatchString(str) {
-			return true
-		}
-	}
-	return false
+	return defaultErrorCatalog.errIs(err, errorScope{}, Fatal)
 }
 
 var (
@@ -577,11 +356,28 @@ func ExtractRPCError(baseErr error) (*JsonError, error) {
 	return &jErr, nil
 }
 
+// ClassifySendError classifies the error of sending tx, with the errors of clientErrors and of the error catalog of
+// clientErrors, see LoadErrorCatalog. The chain type and RPC node are unknown, so all the entries of the catalog apply.
 func ClassifySendError(err error, clientErrors config.ClientErrors, lggr logger.SugaredLogger, tx *types.Transaction, fromAddress common.Address, isL2 bool) multinode.SendTxReturnCode {
+	return classifySendError(err, errorCatalog(clientErrors), errorScope{}, clientErrors, lggr, tx, fromAddress, isL2)
+}
+
+// classifySendErrorOf is ClassifySendError with the entries of the error catalog selected by chainType and rpcDomain,
+// which may be empty if the RPC node is unknown.
+func classifySendErrorOf(chainType chaintype.ChainType, rpcDomain string, err error, clientErrors config.ClientErrors, lggr logger.SugaredLogger, tx *types.Transaction, fromAddress common.Address) multinode.SendTxReturnCode {
+	return classifySendError(err, errorCatalog(clientErrors), newErrorScope(chainType, rpcDomain), clientErrors, lggr, tx, fromAddress, chainType.IsL2())
+}
+
+func classifySendError(err error, catalog *ErrorCatalog, scope errorScope, clientErrors config.ClientErrors, lggr logger.SugaredLogger, tx *types.Transaction, fromAddress common.Address, isL2 bool) multinode.SendTxReturnCode {
 	sendError := NewSendError(err)
 	if sendError == nil {
 		return multinode.Successful
 	}
+	// NewSendError matches the fatal errors of all the entries of the embedded catalog, they are matched in scope by
+	// SendError.Fatal instead
+	sendError.fatal = false
+	sendError.catalog = catalog
+	sendError.scope = scope
 
 	configErrors := ClientErrorRegexes(clientErrors)
 
@@ -671,53 +467,19 @@ func ClassifySendError(err error, clientErrors config.ClientErrors, lggr logger.
 	return multinode.Unknown
 }
 
-var infura = ClientErrors{
-	TooManyResults: regexp.MustCompile(`(: |^)query returned more than [0-9]+ results. Try with this block range \[0x[0-9A-F]+, 0x[0-9A-F]+\].$`),
-}
-
-var alchemy = ClientErrors{
-	TooManyResults: regexp.MustCompile(`(: |^)Log response size exceeded. You can make eth_getLogs requests with up to a [0-9A-Z]+ block range and no limit on the response size, or you can request any block range with a cap of [0-9A-Z]+ logs in the response. Based on your parameters and the response size limit, this block range should work: \[0x[0-9a-f]+, 0x[0-9a-f]+\]$`),
-}
-
-var quicknode = ClientErrors{
-	TooManyResults: regexp.MustCompile(`(: |^)eth_getLogs is limited to a [0-9,]+ range$`),
-}
-
-var simplyvc = ClientErrors{
-	TooManyResults: regexp.MustCompile(`too wide blocks range, the limit is [0-9,]+$`),
-}
-
-var drpc = ClientErrors{
-	TooManyResults: regexp.MustCompile(`(: |^)requested too many blocks from [0-9]+ to [0-9]+, maximum is set to [0-9,]+$`),
-}
+// JSON-RPC error code which indicates a refusal of the server to process an eth_getLogs request because the result set
+// is too large. The error codes of the providers are declared in the error catalog, see errors.toml.
+//
+// See: https://github.com/ethereum/go-ethereum/blob/master/rpc/errors.go#L63
+// Can occur if the rpc server is configured with a maximum byte limit on the response size of batch requests
+const jsonRpcResponseTooLarge = -32003
 
-// Linkpool, Blockdaemon, and Chainstack all return "request timed out" if the log results are too large for them to process
-var defaultClient = ClientErrors{
-	TooManyResults: regexp.MustCompile(`request timed out|408 Request Timed Out`),
+// IsTooManyResults returns true if err indicates that the eth_getLogs request returned too many results.
+func IsTooManyResults(err error, clientErrors config.ClientErrors) bool {
+	return errorCatalog(clientErrors).isTooManyResults(err, clientErrors, errorScope{})
 }
 
-// JSON-RPC error codes which can indicate a refusal of the server to process an eth_getLogs request because the result set is too large
-const (
-	jsonRpcServerError = -32000 // Server error. SimplyVC uses this error code when too many results are returned
-
-	// Server timeout. When the rpc server has its own limit on how long it can take to compile the results
-	// Examples: Linkpool, Chainstack, Block Daemon
-	jsonRpcTimedOut = -32002
-
-	// See: https://github.com/ethereum/go-ethereum/blob/master/rpc/errors.go#L63
-	// Can occur if the rpc server is configured with a maximum byte limit on the response size of batch requests
-	jsonRpcResponseTooLarge = -32003
-
-	// Not implemented in geth by default, but is defined in EIP 1474 and implemented by infura and some other 3rd party rpc servers
-	// See: https://community.infura.io/t/getlogs-error-query-returned-more-than-1000-results/358/5
-	jsonRpcLimitExceeded = -32005 // See also: https://github.com/ethereum/EIPs/blob/master/EIPS/eip-1474.md
-
-	jsonRpcInvalidParams = -32602 // Invalid method params. Returned by alchemy if the block range is too large or there are too many results to return
-
-	jsonRpcQuicknodeTooManyResults = -32614 // Undocumented error code used by Quicknode for too many results error
-)
-
-func IsTooManyResults(err error, clientErrors config.ClientErrors) bool {
+func (c *ErrorCatalog) isTooManyResults(err error, clientErrors config.ClientErrors, scope errorScope) bool {
 	// Context timeouts often occur when receiving too many results from RPCs
 	if errors.Is(err, context.DeadlineExceeded) {
 		return true
@@ -731,31 +493,8 @@ func IsTooManyResults(err error, clientErrors config.ClientErrors) bool {
 	if configErrors.ErrIs(rpcErr, TooManyResults) {
 		return true
 	}
-
-	switch rpcErr.ErrorCode() {
-	case jsonRpcResponseTooLarge:
+	if rpcErr.ErrorCode() == jsonRpcResponseTooLarge {
 		return true
-	case jsonRpcLimitExceeded:
-		if infura.ErrIs(rpcErr, TooManyResults) {
-			return true
-		}
-	case jsonRpcInvalidParams:
-		if alchemy.ErrIs(rpcErr, TooManyResults) {
-			return true
-		}
-	case jsonRpcQuicknodeTooManyResults:
-		if quicknode.ErrIs(rpcErr, TooManyResults) {
-			return true
-		}
-	case jsonRpcTimedOut:
-		if defaultClient.ErrIs(rpcErr, TooManyResults) {
-			return true
-		}
-	case jsonRpcServerError:
-		if simplyvc.ErrIs(rpcErr, TooManyResults) ||
-			drpc.ErrIs(rpcErr, TooManyResults) {
-			return true
-		}
 	}
-	return false
+	return c.errIs(rpcErr, scope, TooManyResults)
 }
@@ -0,0 +1,342 @@
+package client
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-framework/multinode"
+
+	evmconfig "github.com/smartcontractkit/chainlink-evm/pkg/config"
+	"github.com/smartcontractkit/chainlink-evm/pkg/config/chaintype"
+)
+
+//go:embed errors.toml
+var errorCatalogTOML []byte
+
+// defaultErrorCatalog is the catalog of errors of the known RPC nodes, chains and providers, see errors.toml. It is nil
+// if errors.toml is invalid, in which case errDefaultErrorCatalog is returned by LoadErrorCatalog.
+var (
+	defaultErrorCatalog    *ErrorCatalog
+	errDefaultErrorCatalog error
+)
+
+func init() {
+	defaultErrorCatalog, errDefaultErrorCatalog = ParseErrorCatalog(errorCatalogTOML)
+}
+
+var (
+	catalogFilesMu sync.Mutex
+	catalogFiles   = map[string]catalogFile{}
+)
+
+type catalogFile struct {
+	catalog *ErrorCatalog
+	err     error
+}
+
+// LoadErrorCatalog returns the error catalog of clientErrors: the embedded catalog, with the entries of the catalog file
+// of clientErrors, if any, merged over it. Catalog files are loaded once, and their test vectors must pass.
+func LoadErrorCatalog(clientErrors evmconfig.ClientErrors) (*ErrorCatalog, error) {
+	if errDefaultErrorCatalog != nil {
+		return nil, fmt.Errorf("invalid embedded error catalog: %w", errDefaultErrorCatalog)
+	}
+	if clientErrors == nil || clientErrors.CatalogFile() == "" {
+		return defaultErrorCatalog, nil
+	}
+	path := clientErrors.CatalogFile()
+
+	catalogFilesMu.Lock()
+	defer catalogFilesMu.Unlock()
+	f, ok := catalogFiles[path]
+	if !ok {
+		f.catalog, f.err = loadErrorCatalogFile(path)
+		catalogFiles[path] = f
+	}
+	return f.catalog, f.err
+}
+
+func loadErrorCatalogFile(path string) (*ErrorCatalog, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read error catalog file: %w", err)
+	}
+	var c ErrorCatalog
+	if err = c.parse(b); err != nil {
+		return nil, fmt.Errorf("invalid error catalog file %s: %w", path, err)
+	}
+	merged := defaultErrorCatalog.merge(&c)
+	if err = merged.validate(c.Entries); err != nil {
+		return nil, fmt.Errorf("invalid error catalog file %s: %w", path, err)
+	}
+	return merged, nil
+}
+
+// errorCatalog returns the error catalog of clientErrors, or the embedded catalog if it fails to load, see
+// LoadErrorCatalog.
+func errorCatalog(clientErrors evmconfig.ClientErrors) *ErrorCatalog {
+	c, err := LoadErrorCatalog(clientErrors)
+	if err != nil {
+		return defaultErrorCatalog
+	}
+	return c
+}
+
+// errorTypeNames are the names of the error types in the catalog, matching the EVM.NodePool.Errors config.
+var errorTypeNames = map[string]int{
+	"NonceTooLow":                       NonceTooLow,
+	"NonceTooHigh":                      NonceTooHigh,
+	"ReplacementTransactionUnderpriced": ReplacementTransactionUnderpriced,
+	"LimitReached":                      LimitReached,
+	"TransactionAlreadyInMempool":       TransactionAlreadyInMempool,
+	"TerminallyUnderpriced":             TerminallyUnderpriced,
+	"InsufficientEth":                   InsufficientEth,
+	"TxFeeExceedsCap":                   TxFeeExceedsCap,
+	"L2FeeTooLow":                       L2FeeTooLow,
+	"L2FeeTooHigh":                      L2FeeTooHigh,
+	"L2Full":                            L2Full,
+	"TransactionAlreadyMined":           TransactionAlreadyMined,
+	"Fatal":                             Fatal,
+	"ServiceUnavailable":                ServiceUnavailable,
+	"TerminallyStuck":                   TerminallyStuck,
+	"TooManyResults":                    TooManyResults,
+	"ServiceTimeout":                    ServiceTimeout,
+}
+
+// ErrorCatalog is a declarative catalog of the errors returned by RPC nodes, chains and providers, see errors.toml.
+type ErrorCatalog struct {
+	Entries []ErrorCatalogEntry
+}
+
+// ErrorCatalogEntry declares the errors of an RPC node, chain or provider.
+type ErrorCatalogEntry struct {
+	Name string
+	// Provider is the RPC provider returning the errors, if they are specific to a provider.
+	Provider string
+	// ErrorCodes restricts the entry to JSON-RPC errors with one of these codes.
+	ErrorCodes []int
+	// ChainTypes restricts the entry to the chains of one of these chain types. It is ignored if the chain type is
+	// unknown, see ClassifySendError.
+	ChainTypes []chaintype.ChainType
+	// RPCDomains restricts the entry to the RPC nodes of one of these domains or their subdomains, e.g. the domain of
+	// Provider. It is ignored if the RPC node is unknown, e.g. for the transactions sent through the node pool.
+	RPCDomains []string
+	// Errors are the regular expressions of each error type, by name.
+	Errors map[string]string
+	// Tests are the test vectors of the entry, validated when the catalog is parsed.
+	Tests []ErrorCatalogTest
+
+	errors ClientErrors
+}
+
+// ErrorCatalogTest is a test vector of an ErrorCatalogEntry.
+type ErrorCatalogTest struct {
+	Error string
+	// ErrorCode is the JSON-RPC code of the error, if any.
+	ErrorCode int
+	// L2 is true if the error is classified as sent to an L2 chain, see ClassifySendError.
+	L2 bool
+	// ChainType is the chain type the error is classified for, if any.
+	ChainType chaintype.ChainType
+	// RPCDomain is the domain of the RPC node the error is classified for, if any.
+	RPCDomain string
+	// SendTxReturnCode is the expected classification of the error, see ClassifySendError.
+	SendTxReturnCode string
+	// TooManyResults is true if the error is expected to be classified as too many results, see IsTooManyResults.
+	TooManyResults bool
+}
+
+// ParseErrorCatalog parses, compiles and validates the error catalog encoded as TOML in b. All the test vectors of the
+// catalog must pass.
+func ParseErrorCatalog(b []byte) (*ErrorCatalog, error) {
+	var c ErrorCatalog
+	if err := c.parse(b); err != nil {
+		return nil, err
+	}
+	if err := c.validate(c.Entries); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (c *ErrorCatalog) parse(b []byte) error {
+	if err := config.DecodeTOML(bytes.NewReader(b), c); err != nil {
+		return err
+	}
+	return c.compile()
+}
+
+// merge returns a catalog with the entries of c, replaced by the entries of o with the same name, followed by the other
+// entries of o.
+func (c *ErrorCatalog) merge(o *ErrorCatalog) *ErrorCatalog {
+	merged := &ErrorCatalog{Entries: slices.Clone(c.Entries)}
+	for _, e := range o.Entries {
+		i := slices.IndexFunc(merged.Entries, func(m ErrorCatalogEntry) bool { return m.Name == e.Name })
+		if i < 0 {
+			merged.Entries = append(merged.Entries, e)
+			continue
+		}
+		merged.Entries[i] = e
+	}
+	return merged
+}
+
+func (c *ErrorCatalog) compile() (err error) {
+	names := make(map[string]struct{}, len(c.Entries))
+	for i := range c.Entries {
+		e := &c.Entries[i]
+		if e.Name == "" {
+			err = errors.Join(err, fmt.Errorf("entry %d: missing name", i))
+			continue
+		}
+		if _, ok := names[e.Name]; ok {
+			err = errors.Join(err, fmt.Errorf("entry %s: duplicate name", e.Name))
+		}
+		names[e.Name] = struct{}{}
+		if len(e.Errors) == 0 {
+			err = errors.Join(err, fmt.Errorf("entry %s: missing errors", e.Name))
+		}
+		if len(e.Tests) == 0 {
+			err = errors.Join(err, fmt.Errorf("entry %s: missing tests", e.Name))
+		}
+		for _, chainType := range e.ChainTypes {
+			if !chainType.IsValid() {
+				err = errors.Join(err, fmt.Errorf("entry %s: unknown chain type %s", e.Name, chainType))
+			}
+		}
+		for _, domain := range e.RPCDomains {
+			if domain == "" {
+				err = errors.Join(err, fmt.Errorf("entry %s: empty RPC domain", e.Name))
+			}
+		}
+		e.errors = make(ClientErrors, len(e.Errors))
+		for name, pattern := range e.Errors {
+			errorType, ok := errorTypeNames[name]
+			if !ok {
+				err = errors.Join(err, fmt.Errorf("entry %s: unknown error type %s", e.Name, name))
+				continue
+			}
+			if pattern == "" {
+				err = errors.Join(err, fmt.Errorf("entry %s: empty %s pattern", e.Name, name))
+				continue
+			}
+			re, reErr := regexp.Compile(pattern)
+			if reErr != nil {
+				err = errors.Join(err, fmt.Errorf("entry %s: invalid %s pattern: %w", e.Name, name, reErr))
+				continue
+			}
+			e.errors[errorType] = re
+		}
+	}
+	return
+}
+
+// validate runs the test vectors of entries on c.
+func (c *ErrorCatalog) validate(entries []ErrorCatalogEntry) (err error) {
+	lggr := logger.Sugared(logger.Nop())
+	tx := types.NewTx(&types.LegacyTx{})
+	for _, e := range entries {
+		for _, test := range e.Tests {
+			var testErr error = pkgerrors.New(test.Error)
+			if test.ErrorCode != 0 {
+				testErr = JsonError{Code: test.ErrorCode, Message: test.Error}
+			}
+			scope := newErrorScope(test.ChainType, test.RPCDomain)
+			scope.knownChainType = test.ChainType != ""
+
+			switch {
+			case test.TooManyResults:
+				if !c.isTooManyResults(testErr, nil, scope) {
+					err = errors.Join(err, fmt.Errorf("entry %s: test %q: not too many results", e.Name, test.Error))
+				}
+			case test.SendTxReturnCode != "":
+				want, ok := parseSendTxReturnCode(test.SendTxReturnCode)
+				if !ok {
+					err = errors.Join(err, fmt.Errorf("entry %s: test %q: unknown SendTxReturnCode %s", e.Name, test.Error, test.SendTxReturnCode))
+					continue
+				}
+				if got := classifySendError(testErr, c, scope, nil, lggr, tx, common.Address{}, test.L2); got != want {
+					err = errors.Join(err, fmt.Errorf("entry %s: test %q: classified as %s, expected %s", e.Name, test.Error, got, want))
+				}
+			default:
+				err = errors.Join(err, fmt.Errorf("entry %s: test %q: missing SendTxReturnCode or TooManyResults", e.Name, test.Error))
+			}
+		}
+	}
+	return
+}
+
+func parseSendTxReturnCode(s string) (multinode.SendTxReturnCode, bool) {
+	for code := multinode.Successful; code.String() != fmt.Sprintf("SendTxReturnCode(%d)", code); code++ {
+		if code.String() == s {
+			return code, true
+		}
+	}
+	return 0, false
+}
+
+// errorScope is the chain and RPC node an error is classified for, which select the entries of the catalog applying to
+// it. The zero value is an unknown chain and RPC node, to which all the entries apply.
+type errorScope struct {
+	chainType      chaintype.ChainType
+	knownChainType bool
+	// rpcDomain is the domain of the RPC node, without port
+	rpcDomain string
+}
+
+// newErrorScope returns the scope of the errors of an RPC node of a chain of chainType. rpcDomain may be empty if the RPC
+// node is unknown, and may include a port.
+func newErrorScope(chainType chaintype.ChainType, rpcDomain string) errorScope {
+	if host, _, err := net.SplitHostPort(rpcDomain); err == nil {
+		rpcDomain = host
+	}
+	return errorScope{chainType: chainType, knownChainType: true, rpcDomain: rpcDomain}
+}
+
+// selects returns true if the entry applies to the errors of scope.
+func (e *ErrorCatalogEntry) selects(scope errorScope) bool {
+	if scope.knownChainType && len(e.ChainTypes) > 0 && !slices.Contains(e.ChainTypes, scope.chainType) {
+		return false
+	}
+	if scope.rpcDomain != "" && len(e.RPCDomains) > 0 && !slices.ContainsFunc(e.RPCDomains, func(domain string) bool {
+		return strings.EqualFold(scope.rpcDomain, domain) || strings.HasSuffix(strings.ToLower(scope.rpcDomain), "."+strings.ToLower(domain))
+	}) {
+		return false
+	}
+	return true
+}
+
+// errIs returns true if err matches any of the error types in an entry of the catalog selected by scope.
+func (c *ErrorCatalog) errIs(err error, scope errorScope, errorTypes ...int) bool {
+	if c == nil || err == nil {
+		return false
+	}
+	var code int
+	var rpcErr rpc.Error
+	if pkgerrors.As(err, &rpcErr) {
+		code = rpcErr.ErrorCode()
+	}
+	for _, e := range c.Entries {
+		if len(e.ErrorCodes) > 0 && !slices.Contains(e.ErrorCodes, code) || !e.selects(scope) {
+			continue
+		}
+		if e.errors.ErrIs(err, errorTypes...) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,266 @@
+package client_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-framework/multinode"
+
+	evmclient "github.com/smartcontractkit/chainlink-evm/pkg/client"
+	evmconfig "github.com/smartcontractkit/chainlink-evm/pkg/config"
+)
+
+func TestParseErrorCatalog(t *testing.T) {
+	t.Parallel()
+
+	t.Run("L2", func(t *testing.T) {
+		c, err := evmclient.ParseErrorCatalog([]byte(`
+[[Entries]]
+Name = 'Rollup'
+[Entries.Errors]
+L2Full = '^rollup full$'
+[[Entries.Tests]]
+Error = 'rollup full'
+L2 = true
+SendTxReturnCode = 'FeeOutOfValidRange'
+[[Entries.Tests]]
+Error = 'rollup full'
+SendTxReturnCode = 'Unsupported'
+`))
+		require.NoError(t, err)
+		require.Len(t, c.Entries, 1)
+		assert.Equal(t, "Rollup", c.Entries[0].Name)
+	})
+
+	t.Run("error codes", func(t *testing.T) {
+		_, err := evmclient.ParseErrorCatalog([]byte(`
+[[Entries]]
+Name = 'Provider'
+Provider = 'Provider'
+ErrorCodes = [-32099]
+[Entries.Errors]
+TooManyResults = '^too many logs$'
+[[Entries.Tests]]
+Error = 'too many logs'
+ErrorCode = -32099
+TooManyResults = true
+`))
+		require.NoError(t, err)
+
+		_, err = evmclient.ParseErrorCatalog([]byte(`
+[[Entries]]
+Name = 'Provider'
+ErrorCodes = [-32099]
+[Entries.Errors]
+TooManyResults = '^too many logs$'
+[[Entries.Tests]]
+Error = 'too many logs'
+ErrorCode = -32000
+TooManyResults = true
+`))
+		require.ErrorContains(t, err, `entry Provider: test "too many logs": not too many results`)
+	})
+
+	t.Run("chain types and RPC domains", func(t *testing.T) {
+		_, err := evmclient.ParseErrorCatalog([]byte(`
+[[Entries]]
+Name = 'Chain'
+ChainTypes = ['zksync', 'zkevm']
+[Entries.Errors]
+Fatal = '^chain quirk$'
+[[Entries.Tests]]
+Error = 'chain quirk'
+ChainType = 'zkevm'
+SendTxReturnCode = 'Fatal'
+[[Entries.Tests]]
+Error = 'chain quirk'
+ChainType = 'celo'
+SendTxReturnCode = 'Unknown'
+[[Entries.Tests]]
+Error = 'chain quirk'
+SendTxReturnCode = 'Fatal'
+
+[[Entries]]
+Name = 'Provider'
+RPCDomains = ['provider.io']
+[Entries.Errors]
+ServiceUnavailable = '^provider busy$'
+[[Entries.Tests]]
+Error = 'provider busy'
+RPCDomain = 'eu.provider.io:8545'
+SendTxReturnCode = 'Retryable'
+[[Entries.Tests]]
+Error = 'provider busy'
+RPCDomain = 'notprovider.io'
+SendTxReturnCode = 'Unknown'
+[[Entries.Tests]]
+Error = 'provider busy'
+SendTxReturnCode = 'Retryable'
+`))
+		require.NoError(t, err)
+
+		_, err = evmclient.ParseErrorCatalog([]byte(`
+[[Entries]]
+Name = 'Chain'
+ChainTypes = ['zksync']
+[Entries.Errors]
+Fatal = '^chain quirk$'
+[[Entries.Tests]]
+Error = 'chain quirk'
+ChainType = 'celo'
+SendTxReturnCode = 'Fatal'
+`))
+		require.ErrorContains(t, err, `entry Chain: test "chain quirk": classified as Unknown, expected Fatal`)
+	})
+
+	for _, tt := range []struct {
+		name    string
+		catalog string
+		errs    []string
+	}{
+		{"unknown field", `
+[[Entries]]
+Name = 'Node'
+Unknown = true`, []string{"Unknown"}},
+		{"invalid entry", `
+[[Entries]]
+[[Entries]]
+Name = 'Node'
+[[Entries]]
+Name = 'Node'
+ChainTypes = ['unknown']
+RPCDomains = ['']
+[Entries.Errors]
+NonceTooLow = ''
+Unknown = 'unknown'
+Fatal = '('
+[[Entries.Tests]]
+Error = 'nonce too low'`, []string{
+			"entry 0: missing name",
+			"entry Node: missing errors",
+			"entry Node: missing tests",
+			"entry Node: duplicate name",
+			"entry Node: empty NonceTooLow pattern",
+			"entry Node: unknown error type Unknown",
+			"entry Node: invalid Fatal pattern",
+			"entry Node: unknown chain type unknown",
+			"entry Node: empty RPC domain",
+		}},
+		{"failing test", `
+[[Entries]]
+Name = 'Node'
+[Entries.Errors]
+NonceTooHigh = '^nonce too high$'
+[[Entries.Tests]]
+Error = 'nonce too high'
+SendTxReturnCode = 'Fatal'
+[[Entries.Tests]]
+Error = 'nonce too high'
+SendTxReturnCode = 'Unexpected'
+[[Entries.Tests]]
+Error = 'nonce too high'`, []string{
+			`entry Node: test "nonce too high": classified as Retryable, expected Fatal`,
+			`entry Node: test "nonce too high": unknown SendTxReturnCode Unexpected`,
+			`entry Node: test "nonce too high": missing SendTxReturnCode or TooManyResults`,
+		}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := evmclient.ParseErrorCatalog([]byte(tt.catalog))
+			require.Error(t, err)
+			for _, e := range tt.errs {
+				assert.ErrorContains(t, err, e)
+			}
+		})
+	}
+}
+
+func TestLoadErrorCatalog(t *testing.T) {
+	t.Parallel()
+
+	embedded, err := evmclient.LoadErrorCatalog(nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, embedded.Entries)
+
+	writeCatalog := func(t *testing.T, catalog string) evmconfig.ClientErrors {
+		path := filepath.Join(t.TempDir(), "errors.toml")
+		require.NoError(t, os.WriteFile(path, []byte(catalog), 0600))
+		return &catalogFileErrors{TestClientErrors: evmclient.NewTestClientErrors(), path: path}
+	}
+	sendErr := func(t *testing.T, clientErrors evmconfig.ClientErrors, msg string) multinode.SendTxReturnCode {
+		return evmclient.ClassifySendError(errors.New(msg), clientErrors, logger.Sugared(logger.Test(t)),
+			types.NewTx(&types.LegacyTx{}), common.Address{}, false)
+	}
+
+	t.Run("merges the catalog file over the embedded catalog", func(t *testing.T) {
+		clientErrors := writeCatalog(t, `
+[[Entries]]
+Name = 'Geth'
+[Entries.Errors]
+Fatal = '^geth quirk$'
+[[Entries.Tests]]
+Error = 'geth quirk'
+SendTxReturnCode = 'Fatal'
+[[Entries.Tests]]
+Error = 'transaction would cause overdraft'
+SendTxReturnCode = 'Unknown'
+
+[[Entries]]
+Name = 'Operator'
+[Entries.Errors]
+TerminallyUnderpriced = '^operator underpriced$'
+[[Entries.Tests]]
+Error = 'operator underpriced'
+SendTxReturnCode = 'Underpriced'
+`)
+		c, err := evmclient.LoadErrorCatalog(clientErrors)
+		require.NoError(t, err)
+		require.Len(t, c.Entries, len(embedded.Entries)+1)
+		assert.Equal(t, "Operator", c.Entries[len(c.Entries)-1].Name)
+
+		assert.Equal(t, multinode.Underpriced, sendErr(t, clientErrors, "operator underpriced"))
+		assert.Equal(t, multinode.Unknown, sendErr(t, nil, "operator underpriced"))
+		// the Geth entry is replaced
+		assert.Equal(t, multinode.Fatal, sendErr(t, clientErrors, "geth quirk"))
+		assert.Equal(t, multinode.Unknown, sendErr(t, clientErrors, "transaction would cause overdraft"))
+		assert.Equal(t, multinode.InsufficientFunds, sendErr(t, nil, "transaction would cause overdraft"))
+		// the entries of other nodes are kept
+		assert.Equal(t, multinode.InsufficientFunds, sendErr(t, clientErrors, "Upfront cost exceeds account balance"))
+		// the entries of a chain type apply when the chain type is unknown
+		assert.Equal(t, multinode.Underpriced, sendErr(t, clientErrors, "gasprice is less than gas price minimum floor"))
+	})
+
+	t.Run("invalid catalog file", func(t *testing.T) {
+		clientErrors := writeCatalog(t, `
+[[Entries]]
+Name = 'Operator'
+[Entries.Errors]
+Fatal = '^operator quirk$'
+[[Entries.Tests]]
+Error = 'operator quirk'
+SendTxReturnCode = 'Underpriced'
+`)
+		_, err := evmclient.LoadErrorCatalog(clientErrors)
+		require.ErrorContains(t, err, `entry Operator: test "operator quirk": classified as Fatal, expected Underpriced`)
+		// the embedded catalog is used instead
+		assert.Equal(t, multinode.Fatal, sendErr(t, clientErrors, "intrinsic gas too low"))
+		assert.Equal(t, multinode.Unknown, sendErr(t, clientErrors, "operator quirk"))
+
+		_, err = evmclient.LoadErrorCatalog(&catalogFileErrors{TestClientErrors: evmclient.NewTestClientErrors(), path: filepath.Join(t.TempDir(), "missing.toml")})
+		require.ErrorContains(t, err, "failed to read error catalog file")
+	})
+}
+
+type catalogFileErrors struct {
+	evmclient.TestClientErrors
+	path string
+}
+
+func (c *catalogFileErrors) CatalogFile() string { return c.path }
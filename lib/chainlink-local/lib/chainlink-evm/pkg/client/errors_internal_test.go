@@ -0,0 +1,38 @@
+package client
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-framework/multinode"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/config/chaintype"
+)
+
+func Test_classifySendErrorOf(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.Sugared(logger.Test(t))
+	tx := types.NewTx(&types.LegacyTx{})
+	classify := func(chainType chaintype.ChainType, rpcDomain string, msg string) multinode.SendTxReturnCode {
+		return classifySendErrorOf(chainType, rpcDomain, errors.New(msg), nil, lggr, tx, common.Address{})
+	}
+
+	// the Celo entry of the catalog only applies to Celo
+	const celoErr = "gasprice is less than gas price minimum floor"
+	assert.Equal(t, multinode.Underpriced, classify(chaintype.ChainCelo, "", celoErr))
+	assert.Equal(t, multinode.Unknown, classify("", "", celoErr))
+	assert.Equal(t, multinode.Underpriced, ClassifySendError(errors.New(celoErr), nil, lggr, tx, common.Address{}, false))
+	// the entries of the node clients apply to every chain
+	assert.Equal(t, multinode.Fatal, classify(chaintype.ChainCelo, "", "intrinsic gas too low"))
+
+	// the RPC domain of the errors is matched without port
+	scope := newErrorScope("", "mainnet.infura.io:443")
+	assert.Equal(t, "mainnet.infura.io", scope.rpcDomain)
+	assert.True(t, scope.knownChainType)
+}
@@ -26,6 +26,9 @@ func NewEvmClient(cfg evmconfig.NodePool, chainCfg multinode.ChainConfig, client
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize metrics: %w", err)
 	}
+	if _, err = LoadErrorCatalog(clientErrors); err != nil {
+		return nil, fmt.Errorf("failed to load the error catalog: %w", err)
+	}
 
 	for i, node := range nodes {
 		if node.SendOnly != nil && *node.SendOnly {
@@ -46,7 +49,7 @@ func NewEvmClient(cfg evmconfig.NodePool, chainCfg multinode.ChainConfig, client
 	}
 
 	return NewChainClient(lggr, multiNodeMetrics, cfg.SelectionMode(), cfg.LeaseDuration(),
-		primaries, sendonlys, chainID, clientErrors, cfg.DeathDeclarationDelay(), chainType), nil
+		primaries, sendonlys, chainID, clientErrors, cfg.DeathDeclarationDelay(), chainType, WithHedging(cfg.Hedging())), nil
 }
 
 func getRPCTimeouts(chainType chaintype.ChainType) (largePayload, defaultTimeout time.Duration) {
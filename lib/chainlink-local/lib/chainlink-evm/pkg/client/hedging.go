@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	evmconfig "github.com/smartcontractkit/chainlink-evm/pkg/config"
+)
+
+var (
+	promEVMPoolRPCNodeMethodLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "evm_pool_rpc_node_method_latency_seconds",
+		Help:    "The latency of latency-sensitive RPC calls (CallContract, FilterLogs, SendTransaction) for the given RPC node and method",
+		Buckets: []float64{.01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+	}, []string{"evmChainID", "nodeName", "method"})
+	promEVMPoolRPCHedgedCalls = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_pool_rpc_hedged_calls_total",
+		Help: "The total number of calls hedged to a second RPC node, by the node whose answer was used (primary, hedge or none)",
+	}, []string{"evmChainID", "method", "winner"})
+)
+
+// latencyDecay is the weight of the latest observation in the moving average of the latency of a node.
+const latencyDecay = 0.3
+
+type latencyKey struct {
+	node   string
+	method string
+}
+
+// hedger sends latency-sensitive calls to a second node when the first is slow to answer, and tracks the latency of each
+// node per method to pick the fastest node.
+type hedger struct {
+	chainID string
+	cfg     evmconfig.Hedging
+
+	mu        sync.RWMutex
+	latencies map[latencyKey]time.Duration // exponentially weighted moving average
+}
+
+func newHedger(chainID string, cfg evmconfig.Hedging) *hedger {
+	return &hedger{chainID: chainID, cfg: cfg, latencies: make(map[latencyKey]time.Duration)}
+}
+
+func (h *hedger) enabled() bool {
+	return h.cfg != nil && h.cfg.Enabled() && h.cfg.Delay() > 0
+}
+
+func (h *hedger) preferFastestNode() bool {
+	return h.cfg != nil && h.cfg.PreferFastestNode()
+}
+
+// observe records that the call of method took d on node. Cancelled calls are not reported to the histogram, but are
+// still averaged: the node took at least d to answer.
+func (h *hedger) observe(node, method string, d time.Duration, cancelled bool) {
+	if !cancelled {
+		promEVMPoolRPCNodeMethodLatency.WithLabelValues(h.chainID, node, method).Observe(d.Seconds())
+	}
+	k := latencyKey{node: node, method: method}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	avg, ok := h.latencies[k]
+	if !ok {
+		h.latencies[k] = d
+		return
+	}
+	h.latencies[k] = time.Duration(latencyDecay*float64(d) + (1-latencyDecay)*float64(avg))
+}
+
+// latency returns the average latency of method on node, and false if the node did not answer any call yet.
+func (h *hedger) latency(node, method string) (time.Duration, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	d, ok := h.latencies[latencyKey{node: node, method: method}]
+	return d, ok
+}
+
+// fastest returns the node with the lowest average latency of method, except the excluded node, or nil if there is none.
+// Nodes without observations are preferred, so that their latency is measured.
+func (h *hedger) fastest(method string, nodes []*RPCClient, exclude *RPCClient) *RPCClient {
+	var best *RPCClient
+	var bestLatency time.Duration
+	for _, n := range nodes {
+		if n == exclude {
+			continue
+		}
+		d, _ := h.latency(n.Name(), method)
+		if best == nil || d < bestLatency {
+			best, bestLatency = n, d
+		}
+	}
+	return best
+}
+
+// timedCall calls method on node and records its latency.
+func timedCall[T any](ctx context.Context, h *hedger, method string, node *RPCClient, call func(context.Context, *RPCClient) (T, error)) (T, error) {
+	start := time.Now()
+	val, err := call(ctx, node)
+	h.observe(node.Name(), method, time.Since(start), ctx.Err() != nil)
+	return val, err
+}
+
+// hedgedCall calls method on primary and, if it has not answered after the configured delay, on the fastest of the other
+// nodes. The first successful answer is returned and the other call is cancelled. If both calls fail, the first error
+// is returned. Without hedging, only primary is called.
+func hedgedCall[T any](ctx context.Context, h *hedger, method string, primary *RPCClient, nodes []*RPCClient, call func(context.Context, *RPCClient) (T, error)) (T, error) {
+	if !h.enabled() {
+		return timedCall(ctx, h, method, primary, call)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		val    T
+		err    error
+		hedged bool
+	}
+	results := make(chan result, 2) // buffered so that the cancelled call does not block
+	send := func(node *RPCClient, hedged bool) {
+		go func() {
+			val, err := timedCall(ctx, h, method, node, call)
+			results <- result{val: val, err: err, hedged: hedged}
+		}()
+	}
+	send(primary, false)
+	pending := 1
+
+	timer := time.NewTimer(h.cfg.Delay())
+	defer timer.Stop()
+	delay := timer.C
+	hedged := false
+
+	var first *result
+	for pending > 0 {
+		select {
+		case <-delay:
+			delay = nil
+			if node := h.fastest(method, nodes, primary); node != nil {
+				hedged = true
+				send(node, true)
+				pending++
+			}
+		case res := <-results:
+			pending--
+			if res.err == nil {
+				if hedged {
+					promEVMPoolRPCHedgedCalls.WithLabelValues(h.chainID, method, winner(res.hedged)).Inc()
+				}
+				return res.val, nil
+			}
+			if first == nil {
+				first = &res
+			}
+			if !hedged {
+				// the primary failed before the delay, do not hedge deterministic errors like reverts
+				return res.val, res.err
+			}
+		}
+	}
+	promEVMPoolRPCHedgedCalls.WithLabelValues(h.chainID, method, "none").Inc()
+	return first.val, first.err
+}
+
+func winner(hedged bool) string {
+	if hedged {
+		return "hedge"
+	}
+	return "primary"
+}
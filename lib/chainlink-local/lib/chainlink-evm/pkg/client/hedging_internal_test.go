@@ -0,0 +1,171 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	"github.com/smartcontractkit/chainlink-framework/multinode"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+)
+
+type testHedging struct {
+	enabled, preferFastestNode bool
+	delay                      time.Duration
+}
+
+func (h testHedging) Enabled() bool           { return h.enabled }
+func (h testHedging) Delay() time.Duration    { return h.delay }
+func (h testHedging) PreferFastestNode() bool { return h.preferFastestNode }
+
+func newTestRPCs(t *testing.T, names ...string) []*RPCClient {
+	rpcs := make([]*RPCClient, len(names))
+	for i, name := range names {
+		rpcs[i] = NewRPCClient(TestNodePoolConfig{}, logger.Test(t), nil, nil, name, i, big.NewInt(1), multinode.Primary, QueryTimeout, QueryTimeout, "")
+	}
+	return rpcs
+}
+
+// answer returns a call answering with the node name after the latency of the node, or failing if it is in failing.
+func answer(latencies map[string]time.Duration, failing ...string) func(context.Context, *RPCClient) (string, error) {
+	return func(ctx context.Context, r *RPCClient) (string, error) {
+		select {
+		case <-time.After(latencies[r.Name()]):
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+		for _, f := range failing {
+			if f == r.Name() {
+				return "", errors.New("failed on " + f)
+			}
+		}
+		return r.Name(), nil
+	}
+}
+
+func TestHedgedCall(t *testing.T) {
+	t.Parallel()
+
+	rpcs := newTestRPCs(t, "slow", "fast", "other")
+	slow, fast := rpcs[0], rpcs[1]
+	latencies := map[string]time.Duration{"slow": tests.WaitTimeout(t), "fast": 0, "other": time.Hour}
+
+	t.Run("without hedging calls the primary only", func(t *testing.T) {
+		h := newHedger(testutils.NewRandomEVMChainID().String(), testHedging{delay: time.Millisecond})
+		var calls atomic.Int32
+		val, err := hedgedCall(tests.Context(t), h, "CallContract", fast, rpcs, func(ctx context.Context, r *RPCClient) (string, error) {
+			calls.Add(1)
+			return r.Name(), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "fast", val)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("hedges slow calls to the fastest other node", func(t *testing.T) {
+		chainID := testutils.NewRandomEVMChainID().String()
+		h := newHedger(chainID, testHedging{enabled: true, delay: time.Millisecond})
+		h.observe("other", "CallContract", time.Second, false)
+		h.observe("fast", "CallContract", time.Millisecond, false)
+
+		val, err := hedgedCall(tests.Context(t), h, "CallContract", slow, rpcs, answer(latencies))
+		require.NoError(t, err)
+		assert.Equal(t, "fast", val)
+		assert.InDelta(t, 1, testutil.ToFloat64(promEVMPoolRPCHedgedCalls.WithLabelValues(chainID, "CallContract", "hedge")), 0)
+
+		// the cancelled call of the slow node still counts towards its latency
+		require.Eventually(t, func() bool {
+			d, ok := h.latency("slow", "CallContract")
+			return ok && d > 0
+		}, tests.WaitTimeout(t), 10*time.Millisecond)
+	})
+
+	t.Run("does not hedge fast calls", func(t *testing.T) {
+		h := newHedger(testutils.NewRandomEVMChainID().String(), testHedging{enabled: true, delay: tests.WaitTimeout(t)})
+		var calls atomic.Int32
+		val, err := hedgedCall(tests.Context(t), h, "FilterLogs", fast, rpcs, func(ctx context.Context, r *RPCClient) (string, error) {
+			calls.Add(1)
+			return r.Name(), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "fast", val)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("does not hedge errors of the primary", func(t *testing.T) {
+		h := newHedger(testutils.NewRandomEVMChainID().String(), testHedging{enabled: true, delay: tests.WaitTimeout(t)})
+		_, err := hedgedCall(tests.Context(t), h, "CallContract", fast, rpcs, answer(latencies, "fast"))
+		require.ErrorContains(t, err, "failed on fast")
+	})
+
+	t.Run("waits for the hedge if the primary fails", func(t *testing.T) {
+		h := newHedger(testutils.NewRandomEVMChainID().String(), testHedging{enabled: true, delay: time.Millisecond})
+		lat := map[string]time.Duration{"slow": 50 * time.Millisecond, "fast": 100 * time.Millisecond}
+		val, err := hedgedCall(tests.Context(t), h, "CallContract", slow, rpcs[:2], answer(lat, "slow"))
+		require.NoError(t, err)
+		assert.Equal(t, "fast", val)
+	})
+
+	t.Run("returns the first error if both calls fail", func(t *testing.T) {
+		chainID := testutils.NewRandomEVMChainID().String()
+		h := newHedger(chainID, testHedging{enabled: true, delay: time.Millisecond})
+		lat := map[string]time.Duration{"slow": 50 * time.Millisecond, "fast": 100 * time.Millisecond}
+		_, err := hedgedCall(tests.Context(t), h, "CallContract", slow, rpcs[:2], answer(lat, "slow", "fast"))
+		require.ErrorContains(t, err, "failed on slow")
+		assert.InDelta(t, 1, testutil.ToFloat64(promEVMPoolRPCHedgedCalls.WithLabelValues(chainID, "CallContract", "none")), 0)
+	})
+}
+
+func TestHedger_Fastest(t *testing.T) {
+	t.Parallel()
+
+	rpcs := newTestRPCs(t, "a", "b", "c")
+	h := newHedger(testutils.NewRandomEVMChainID().String(), nil)
+	assert.False(t, h.enabled())
+	assert.False(t, h.preferFastestNode())
+
+	// nodes without observations are tried first
+	h.observe("a", "FilterLogs", 100*time.Millisecond, false)
+	h.observe("b", "FilterLogs", 200*time.Millisecond, false)
+	assert.Equal(t, rpcs[2], h.fastest("FilterLogs", rpcs, nil))
+
+	h.observe("c", "FilterLogs", 300*time.Millisecond, false)
+	assert.Equal(t, rpcs[0], h.fastest("FilterLogs", rpcs, nil))
+	assert.Equal(t, rpcs[1], h.fastest("FilterLogs", rpcs, rpcs[0]))
+	assert.Nil(t, h.fastest("FilterLogs", rpcs[:1], rpcs[0]))
+
+	t.Run("averages latencies per method", func(t *testing.T) {
+		h.observe("a", "FilterLogs", time.Second, false)
+		d, ok := h.latency("a", "FilterLogs")
+		require.True(t, ok)
+		assert.Equal(t, 370*time.Millisecond, d)
+		assert.Equal(t, rpcs[1], h.fastest("FilterLogs", rpcs, nil))
+
+		_, ok = h.latency("a", "CallContract")
+		assert.False(t, ok)
+	})
+}
+
+func TestNewChainClient_WithHedging(t *testing.T) {
+	t.Parallel()
+
+	chainID := testutils.NewRandomEVMChainID()
+	c := NewChainClient(logger.Test(t), nil, multinode.NodeSelectionModeRoundRobin, 0, nil, nil, chainID, nil, 0, "").(*chainClient)
+	assert.False(t, c.hedger.enabled())
+
+	c = NewChainClient(logger.Test(t), nil, multinode.NodeSelectionModeRoundRobin, 0, nil, nil, chainID, nil, 0, "",
+		WithHedging(testHedging{enabled: true, delay: time.Millisecond, preferFastestNode: true})).(*chainClient)
+	assert.True(t, c.hedger.enabled())
+	assert.True(t, c.hedger.preferFastestNode())
+	assert.Equal(t, chainID.String(), c.hedger.chainID)
+}
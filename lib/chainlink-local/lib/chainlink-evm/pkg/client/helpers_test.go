@@ -83,6 +83,7 @@ func (c *TestClientErrors) TransactionAlreadyMined() string { return c.transacti
 func (c *TestClientErrors) Fatal() string                   { return c.fatal }
 func (c *TestClientErrors) ServiceUnavailable() string      { return c.serviceUnavailable }
 func (c *TestClientErrors) TooManyResults() string          { return c.serviceUnavailable }
+func (c *TestClientErrors) CatalogFile() string             { return "" }
 
 type TestNodePoolConfig struct {
 	NodePollFailureThreshold       uint32
@@ -96,6 +97,7 @@ type TestNodePoolConfig struct {
 	EnforceRepeatableReadVal       bool
 	NodeDeathDeclarationDelay      time.Duration
 	NodeNewHeadsPollInterval       time.Duration
+	NodeHedging                    config.Hedging
 }
 
 func (tc TestNodePoolConfig) PollFailureThreshold() uint32 { return tc.NodePollFailureThreshold }
@@ -134,6 +136,10 @@ func (tc TestNodePoolConfig) DeathDeclarationDelay() time.Duration {
 	return tc.NodeDeathDeclarationDelay
 }
 
+func (tc TestNodePoolConfig) Hedging() config.Hedging {
+	return tc.NodeHedging
+}
+
 func NewChainClientWithTestNode(
 	t *testing.T,
 	nodeCfg multinode.NodeConfig,
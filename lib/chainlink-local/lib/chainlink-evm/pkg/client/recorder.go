@@ -0,0 +1,237 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// RecordedCall is a JSON-RPC call recorded by a Recorder, and replayed by a Replayer. Recordings are stored as one JSON
+// encoded call per line, in the order of the responses.
+type RecordedCall struct {
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  json.RawMessage `json:"error,omitempty"`
+}
+
+// key returns the key matching a replayed call with the recorded calls, i.e. the method and the compacted params.
+func (c RecordedCall) key() string {
+	var params bytes.Buffer
+	if err := json.Compact(&params, c.Params); err != nil {
+		params.Reset()
+		params.Write(c.Params)
+	}
+	return c.Method + params.String()
+}
+
+// jsonrpcMessage is a JSON-RPC request or response.
+type jsonrpcMessage struct {
+	Version string          `json:"jsonrpc,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   json.RawMessage `json:"error,omitempty"`
+}
+
+// parseMessages parses a single JSON-RPC message or a batch of messages, and returns whether it is a batch.
+func parseMessages(b []byte) ([]jsonrpcMessage, bool, error) {
+	b = bytes.TrimSpace(b)
+	if len(b) > 0 && b[0] == '[' {
+		var msgs []jsonrpcMessage
+		err := json.Unmarshal(b, &msgs)
+		return msgs, true, err
+	}
+	var msg jsonrpcMessage
+	if err := json.Unmarshal(b, &msg); err != nil {
+		return nil, false, err
+	}
+	return []jsonrpcMessage{msg}, false, nil
+}
+
+// Recorder is an http.RoundTripper recording the JSON-RPC calls sent over HTTP, see NewRecordingClient.
+type Recorder struct {
+	next http.RoundTripper
+
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+var _ http.RoundTripper = &Recorder{}
+
+// NewRecorder returns a new Recorder writing the calls sent with next to w. If next is nil, http.DefaultTransport is
+// used.
+func NewRecorder(w io.Writer, next http.RoundTripper) *Recorder {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &Recorder{next: next, enc: json.NewEncoder(w)}
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request: %w", err)
+		}
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	if resp.StatusCode != http.StatusOK {
+		// not a JSON-RPC response, e.g. rate limited
+		return resp, nil
+	}
+
+	if err := r.record(body, respBody); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (r *Recorder) record(reqBody, respBody []byte) error {
+	reqs, _, err := parseMessages(reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to parse JSON-RPC request: %w", err)
+	}
+	resps, _, err := parseMessages(respBody)
+	if err != nil {
+		return fmt.Errorf("failed to parse JSON-RPC response: %w", err)
+	}
+	methods := make(map[string]jsonrpcMessage, len(reqs))
+	for _, m := range reqs {
+		methods[string(m.ID)] = m
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, resp := range resps {
+		req, ok := methods[string(resp.ID)]
+		if !ok {
+			continue
+		}
+		call := RecordedCall{Method: req.Method, Params: req.Params, Result: resp.Result, Error: resp.Error}
+		if err := r.enc.Encode(call); err != nil {
+			return fmt.Errorf("failed to record call %s: %w", req.Method, err)
+		}
+	}
+	return nil
+}
+
+// Replayer is an http.RoundTripper answering JSON-RPC calls with recorded calls, without network, see
+// NewReplayClient. Calls are matched by method and params. Calls recorded multiple times are replayed in order, and the
+// last one is repeated once exhausted. Calls which were not recorded fail with a JSON-RPC error.
+type Replayer struct {
+	mu    sync.Mutex
+	calls map[string][]RecordedCall
+}
+
+var _ http.RoundTripper = &Replayer{}
+
+// NewReplayer returns a new Replayer of the calls recorded in r, see Recorder.
+func NewReplayer(r io.Reader) (*Replayer, error) {
+	p := &Replayer{calls: make(map[string][]RecordedCall)}
+	dec := json.NewDecoder(bufio.NewReader(r))
+	for {
+		var call RecordedCall
+		if err := dec.Decode(&call); errors.Is(err, io.EOF) {
+			return p, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read recorded call: %w", err)
+		}
+		k := call.key()
+		p.calls[k] = append(p.calls[k], call)
+	}
+}
+
+// next returns the next recorded call matching the call, and false if there is none.
+func (p *Replayer) next(call RecordedCall) (RecordedCall, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	k := call.key()
+	calls := p.calls[k]
+	if len(calls) == 0 {
+		return RecordedCall{}, false
+	}
+	if len(calls) > 1 {
+		p.calls[k] = calls[1:]
+	}
+	return calls[0], true
+}
+
+func (p *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body == nil {
+		return nil, errors.New("missing JSON-RPC request")
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request: %w", err)
+	}
+	reqs, batch, err := parseMessages(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JSON-RPC request: %w", err)
+	}
+
+	resps := make([]jsonrpcMessage, len(reqs))
+	for i, m := range reqs {
+		resps[i] = jsonrpcMessage{Version: "2.0", ID: m.ID}
+		call, ok := p.next(RecordedCall{Method: m.Method, Params: m.Params})
+		switch {
+		case !ok:
+			resps[i].Error, err = json.Marshal(map[string]any{
+				"code":    -32000,
+				"message": fmt.Sprintf("no recorded call %s with params %s", m.Method, m.Params),
+			})
+			if err != nil {
+				return nil, err
+			}
+		case len(call.Error) > 0:
+			resps[i].Error = call.Error
+		case len(call.Result) > 0:
+			resps[i].Result = call.Result
+		default:
+			resps[i].Result = json.RawMessage("null")
+		}
+	}
+
+	var respBody []byte
+	if batch {
+		respBody, err = json.Marshal(resps)
+	} else {
+		respBody, err = json.Marshal(resps[0])
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode JSON-RPC response: %w", err)
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(respBody)),
+		ContentLength: int64(len(respBody)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,225 @@
+package client
+
+import (
+	"context"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	commonassets "github.com/smartcontractkit/chainlink-common/pkg/assets"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-framework/multinode"
+
+	evmconfig "github.com/smartcontractkit/chainlink-evm/pkg/config"
+	"github.com/smartcontractkit/chainlink-evm/pkg/config/chaintype"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+)
+
+// replayURL is the url of the replay clients, which is never dialed.
+var replayURL = url.URL{Scheme: "http", Host: "replay"}
+
+var _ Client = (*recordingClient)(nil)
+
+// recordingClient is a Client calling a single RPC over HTTP, without multinode, to record or replay its traffic. Only
+// HTTP is supported, so subscriptions require NodePool.NewHeadsPollInterval to poll new heads.
+type recordingClient struct {
+	rpc          *RPCClient
+	logger       logger.SugaredLogger
+	chainType    chaintype.ChainType
+	clientErrors evmconfig.ClientErrors
+}
+
+// NewRecordingClient returns a new Client calling the RPC at rpcURL over HTTP, and recording all the JSON-RPC calls to
+// w, see Recorder. The recording can be replayed with NewReplayClient.
+func NewRecordingClient(lggr logger.Logger, cfg evmconfig.NodePool, chainID *big.Int, chainType chaintype.ChainType, rpcURL *url.URL, w io.Writer) Client {
+	return newRecordingClient(lggr, cfg, chainID, chainType, rpcURL, NewRecorder(w, nil))
+}
+
+// NewReplayClient returns a new Client replaying the JSON-RPC calls recorded in r by NewRecordingClient, without
+// network, see Replayer.
+func NewReplayClient(lggr logger.Logger, cfg evmconfig.NodePool, chainID *big.Int, chainType chaintype.ChainType, r io.Reader) (Client, error) {
+	replayer, err := NewReplayer(r)
+	if err != nil {
+		return nil, err
+	}
+	return newRecordingClient(lggr, cfg, chainID, chainType, &replayURL, replayer), nil
+}
+
+func newRecordingClient(lggr logger.Logger, cfg evmconfig.NodePool, chainID *big.Int, chainType chaintype.ChainType, rpcURL *url.URL, transport http.RoundTripper) *recordingClient {
+	r := NewRPCClient(cfg, lggr, nil, rpcURL, rpcURL.Host, 0, chainID, multinode.Primary, QueryTimeout, QueryTimeout, chainType)
+	r.httpOptions = []rpc.ClientOption{rpc.WithHTTPClient(&http.Client{Transport: transport})}
+	return &recordingClient{
+		rpc:          r,
+		logger:       logger.Sugared(lggr),
+		chainType:    chainType,
+		clientErrors: cfg.Errors(),
+	}
+}
+
+func (c *recordingClient) Dial(ctx context.Context) error {
+	return c.rpc.Dial(ctx)
+}
+
+func (c *recordingClient) Close() {
+	c.rpc.Close()
+}
+
+func (c *recordingClient) ConfiguredChainID() *big.Int {
+	return c.rpc.chainID
+}
+
+func (c *recordingClient) NodeStates() map[string]string {
+	return map[string]string{c.rpc.Name(): "Alive"}
+}
+
+func (c *recordingClient) TokenBalance(ctx context.Context, address common.Address, contractAddress common.Address) (*big.Int, error) {
+	return c.rpc.TokenBalance(ctx, address, contractAddress)
+}
+
+func (c *recordingClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return c.rpc.BalanceAt(ctx, account, blockNumber)
+}
+
+func (c *recordingClient) LINKBalance(ctx context.Context, address common.Address, linkAddress common.Address) (*commonassets.Link, error) {
+	return c.rpc.LINKBalance(ctx, address, linkAddress)
+}
+
+func (c *recordingClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	return c.rpc.CallContext(ctx, result, method, args...)
+}
+
+func (c *recordingClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	return c.rpc.BatchCallContext(ctx, b)
+}
+
+// BatchCallContextAll calls BatchCallContext, as there is a single node.
+func (c *recordingClient) BatchCallContextAll(ctx context.Context, b []rpc.BatchElem) error {
+	return c.rpc.BatchCallContext(ctx, b)
+}
+
+func (c *recordingClient) HeadByNumber(ctx context.Context, n *big.Int) (*evmtypes.Head, error) {
+	return c.rpc.BlockByNumber(ctx, n)
+}
+
+func (c *recordingClient) HeadByHash(ctx context.Context, h common.Hash) (*evmtypes.Head, error) {
+	return c.rpc.BlockByHash(ctx, h)
+}
+
+func (c *recordingClient) SubscribeToHeads(ctx context.Context) (<-chan *evmtypes.Head, ethereum.Subscription, error) {
+	ch, sub, err := c.rpc.SubscribeToHeads(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ch, sub, nil
+}
+
+func (c *recordingClient) LatestFinalizedBlock(ctx context.Context) (*evmtypes.Head, error) {
+	return c.rpc.LatestFinalizedBlock(ctx)
+}
+
+func (c *recordingClient) SendTransactionReturnCode(ctx context.Context, tx *types.Transaction, fromAddress common.Address) (multinode.SendTxReturnCode, error) {
+	err := c.SendTransaction(ctx, tx)
+	returnCode := classifySendErrorOf(c.chainType, c.rpc.getRPCDomain(), err, c.clientErrors, c.logger, tx, fromAddress)
+	return returnCode, err
+}
+
+func (c *recordingClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	_, _, err := c.rpc.SendTransaction(ctx, tx)
+	return err
+}
+
+func (c *recordingClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return c.rpc.CodeAt(ctx, account, blockNumber)
+}
+
+func (c *recordingClient) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return c.rpc.PendingCodeAt(ctx, account)
+}
+
+func (c *recordingClient) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	n, err := c.rpc.PendingSequenceAt(ctx, account)
+	return uint64(n), err
+}
+
+func (c *recordingClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	return c.rpc.NonceAt(ctx, account, blockNumber)
+}
+
+func (c *recordingClient) TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, error) {
+	return c.rpc.TransactionByHash(ctx, txHash)
+}
+
+func (c *recordingClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return c.rpc.TransactionReceiptGeth(ctx, txHash)
+}
+
+func (c *recordingClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	return c.rpc.BlockByNumberGeth(ctx, number)
+}
+
+func (c *recordingClient) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	return c.rpc.BlockByHashGeth(ctx, hash)
+}
+
+func (c *recordingClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	return c.rpc.FilterEvents(ctx, q)
+}
+
+func (c *recordingClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return c.rpc.SubscribeFilterLogs(ctx, q, ch)
+}
+
+func (c *recordingClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return c.rpc.EstimateGas(ctx, call)
+}
+
+func (c *recordingClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return c.rpc.SuggestGasPrice(ctx)
+}
+
+func (c *recordingClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return c.rpc.SuggestGasTipCap(ctx)
+}
+
+func (c *recordingClient) LatestBlockHeight(ctx context.Context) (*big.Int, error) {
+	return c.rpc.LatestBlockHeight(ctx)
+}
+
+func (c *recordingClient) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	return c.rpc.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
+}
+
+func (c *recordingClient) HeaderByNumber(ctx context.Context, n *big.Int) (*types.Header, error) {
+	return c.rpc.HeaderByNumber(ctx, n)
+}
+
+func (c *recordingClient) HeaderByHash(ctx context.Context, h common.Hash) (*types.Header, error) {
+	return c.rpc.HeaderByHash(ctx, h)
+}
+
+func (c *recordingClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return c.rpc.CallContract(ctx, msg, blockNumber)
+}
+
+func (c *recordingClient) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
+	return c.rpc.PendingCallContract(ctx, msg)
+}
+
+func (c *recordingClient) IsL2() bool {
+	return c.chainType.IsL2()
+}
+
+func (c *recordingClient) CheckTxValidity(ctx context.Context, from common.Address, to common.Address, data []byte) *SendError {
+	msg := ethereum.CallMsg{
+		From: from,
+		To:   &to,
+		Data: data,
+	}
+	return SimulateTransaction(ctx, c, c.logger, c.chainType, msg)
+}
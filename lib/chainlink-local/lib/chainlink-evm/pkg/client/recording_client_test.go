@@ -0,0 +1,135 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/client"
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+)
+
+type revertError struct{ data string }
+
+func (e revertError) Error() string          { return "execution reverted" }
+func (e revertError) ErrorCode() int         { return 3 }
+func (e revertError) ErrorData() interface{} { return e.data }
+
+// ethService is a fake eth namespace, whose block number increases on every call.
+type ethService struct {
+	blockNumber atomic.Uint64
+}
+
+func (s *ethService) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(s.blockNumber.Add(1))
+}
+
+func (s *ethService) GetBalance(addr common.Address, _ string) *hexutil.Big {
+	return (*hexutil.Big)(new(big.Int).SetBytes(addr[:2]))
+}
+
+func (s *ethService) Call(args map[string]any, _ string) (hexutil.Bytes, error) {
+	if _, ok := args["data"]; !ok {
+		return nil, revertError{data: "0x08c379a0"}
+	}
+	return hexutil.Bytes{1, 2, 3}, nil
+}
+
+func newEthServer(t *testing.T) *url.URL {
+	srv := rpc.NewServer()
+	require.NoError(t, srv.RegisterName("eth", new(ethService)))
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+	t.Cleanup(srv.Stop)
+	u, err := url.Parse(ts.URL)
+	require.NoError(t, err)
+	return u
+}
+
+func TestRecordingClient(t *testing.T) {
+	t.Parallel()
+
+	chainID := testutils.FixtureChainID
+	cfg := client.TestNodePoolConfig{NodeErrors: ptr(client.NewTestClientErrors())}
+	to := testutils.NewAddress()
+	account := common.HexToAddress("0x0102000000000000000000000000000000000000")
+
+	// exercise calls the client and returns the answers
+	exercise := func(ctx context.Context, c client.Client) (answers []any) {
+		for range 2 {
+			h, err := c.LatestBlockHeight(ctx)
+			answers = append(answers, h, err)
+		}
+		balance, err := c.BalanceAt(ctx, account, nil)
+		answers = append(answers, balance, err)
+		res, err := c.CallContract(ctx, ethereum.CallMsg{To: &to, Data: []byte{0xaa}}, nil)
+		answers = append(answers, res, err)
+		_, err = c.CallContract(ctx, ethereum.CallMsg{To: &to}, nil)
+		jErr, extractErr := client.ExtractRPCError(err)
+		answers = append(answers, jErr, extractErr)
+		b := []rpc.BatchElem{
+			{Method: "eth_blockNumber", Result: new(hexutil.Uint64)},
+			{Method: "eth_getBalance", Args: []any{account, "latest"}, Result: new(hexutil.Big)},
+		}
+		err = c.BatchCallContext(ctx, b)
+		answers = append(answers, err, b[0].Result, b[1].Result)
+		return
+	}
+
+	ctx := tests.Context(t)
+	var recording bytes.Buffer
+	recorder := client.NewRecordingClient(logger.Test(t), cfg, chainID, "", newEthServer(t), &recording)
+	require.NoError(t, recorder.Dial(ctx))
+	t.Cleanup(recorder.Close)
+	recorded := exercise(ctx, recorder)
+	assert.Equal(t, big.NewInt(1), recorded[0])
+	assert.Equal(t, big.NewInt(2), recorded[2])
+	assert.Equal(t, big.NewInt(0x0102), recorded[4])
+	assert.Equal(t, []byte{1, 2, 3}, recorded[6])
+	assert.Equal(t, "0x08c379a0", recorded[8].(*client.JsonError).Data)
+	assert.Equal(t, hexutil.Uint64(3), *recorded[11].(*hexutil.Uint64))
+
+	replay := func(t *testing.T) client.Client {
+		c, err := client.NewReplayClient(logger.Test(t), cfg, chainID, "", bytes.NewReader(recording.Bytes()))
+		require.NoError(t, err)
+		require.NoError(t, c.Dial(ctx))
+		t.Cleanup(c.Close)
+		return c
+	}
+
+	t.Run("replays the recorded calls", func(t *testing.T) {
+		c := replay(t)
+		assert.Equal(t, recorded, exercise(ctx, c))
+		assert.Equal(t, chainID, c.ConfiguredChainID())
+	})
+
+	t.Run("repeats the last recorded call", func(t *testing.T) {
+		c := replay(t)
+		// the third block number was recorded in the batch call
+		for _, want := range []int64{1, 2, 3, 3} {
+			h, err := c.LatestBlockHeight(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, big.NewInt(want), h)
+		}
+	})
+
+	t.Run("fails calls which were not recorded", func(t *testing.T) {
+		c := replay(t)
+		_, err := c.BalanceAt(ctx, testutils.NewAddress(), nil)
+		require.ErrorContains(t, err, "no recorded call eth_getBalance")
+	})
+}
@@ -102,6 +102,8 @@ type RPCClient struct {
 
 	ws   atomic.Pointer[rawclient]
 	http atomic.Pointer[rawclient]
+	// httpOptions are the options to dial the http url with, e.g. to record or replay the traffic.
+	httpOptions []rpc.ClientOption
 
 	*multinode.RPCClientBase[*evmtypes.Head]
 }
@@ -207,7 +209,12 @@ func (r *RPCClient) DialHTTP() error {
 	lggr.Debugw("RPC dial: evmclient.Client#dial")
 
 	var httprpc *rpc.Client
-	httprpc, err := rpc.DialHTTP(http.uri.String())
+	var err error
+	if len(r.httpOptions) > 0 {
+		httprpc, err = rpc.DialOptions(context.Background(), http.uri.String(), r.httpOptions...)
+	} else {
+		httprpc, err = rpc.DialHTTP(http.uri.String())
+	}
 	if err != nil {
 		promEVMPoolRPCNodeDialsFailed.WithLabelValues(r.chainID.String(), r.name).Inc()
 		return r.wrapRPCClientError(pkgerrors.Wrapf(err, "error while dialing HTTP: %v", http.uri.Redacted()))
@@ -744,14 +751,37 @@ func (r *RPCClient) SendTransaction(ctx context.Context, tx *types.Transaction)
 
 	r.logResult(lggr, err, duration, r.getRPCDomain(), "SendTransaction")
 
-	return struct{}{}, ClassifySendError(err, r.clientErrors, logger.Sugared(logger.Nop()), tx, common.Address{}, r.chainType.IsL2()), err
+	return struct{}{}, classifySendErrorOf(r.chainType, r.getRPCDomain(), err, r.clientErrors, logger.Sugared(logger.Nop()), tx, common.Address{}), err
 }
 
+// SimulateTransaction calls the signed transaction on the pending block, and returns the error of the call if it
+// would fail, e.g. revert.
 func (r *RPCClient) SimulateTransaction(ctx context.Context, tx *types.Transaction) error {
-	// Not Implemented
-	return pkgerrors.New("SimulateTransaction not implemented")
+	from, err := types.Sender(types.LatestSignerForChainID(r.chainID), tx)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to recover the sender of the transaction")
+	}
+	msg := ethereum.CallMsg{
+		From:       from,
+		To:         tx.To(),
+		Gas:        tx.Gas(),
+		Value:      tx.Value(),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
+	}
+	// fee caps and gas price are mutually exclusive
+	if tx.Type() == types.LegacyTxType || tx.Type() == types.AccessListTxType {
+		msg.GasPrice = tx.GasPrice()
+	} else {
+		msg.GasFeeCap = tx.GasFeeCap()
+		msg.GasTipCap = tx.GasTipCap()
+	}
+	_, err = r.PendingCallContract(ctx, msg)
+	return err
 }
 
+// SendEmptyTransaction sends the transaction built by newTxAttempt, e.g. to clear a stuck nonce. The attempt must be
+// a signed *types.Transaction, or its binary encoding as returned by types.Transaction.MarshalBinary.
 func (r *RPCClient) SendEmptyTransaction(
 	ctx context.Context,
 	newTxAttempt func(nonce evmtypes.Nonce, feeLimit uint32, fee *assets.Wei, fromAddress common.Address) (attempt any, err error),
@@ -760,8 +790,24 @@ func (r *RPCClient) SendEmptyTransaction(
 	fee *assets.Wei,
 	fromAddress common.Address,
 ) (txhash string, err error) {
-	// Not Implemented
-	return "", pkgerrors.New("SendEmptyTransaction not implemented")
+	attempt, err := newTxAttempt(nonce, gasLimit, fee, fromAddress)
+	if err != nil {
+		return "", err
+	}
+	var tx *types.Transaction
+	switch a := attempt.(type) {
+	case *types.Transaction:
+		tx = a
+	case []byte:
+		tx = new(types.Transaction)
+		if err = tx.UnmarshalBinary(a); err != nil {
+			return "", pkgerrors.Wrap(err, "failed to decode the signed transaction")
+		}
+	default:
+		return "", fmt.Errorf("unsupported attempt type %T, expected a signed *types.Transaction or its binary encoding", attempt)
+	}
+	_, _, err = r.SendTransaction(ctx, tx)
+	return tx.Hash().String(), err
 }
 
 // PendingSequenceAt returns one higher than the highest nonce from both mempool and mined transactions
@@ -8,6 +8,7 @@ import (
 	"math"
 	"math/big"
 	"net/url"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -16,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -26,6 +28,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 	"github.com/smartcontractkit/chainlink-framework/multinode"
 
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
 	"github.com/smartcontractkit/chainlink-evm/pkg/client"
 	"github.com/smartcontractkit/chainlink-evm/pkg/config/chaintype"
 	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
@@ -806,6 +809,74 @@ func TestRPCClient_Tron(t *testing.T) {
 	})
 }
 
+func TestRPCClient_SimulateTransaction(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.NewInt(123456)
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	reverting := testutils.NewAddress()
+
+	server := testutils.NewWSServer(t, chainID, func(method string, params gjson.Result) (resp testutils.JSONRPCResponse) {
+		switch method {
+		case "eth_call":
+			assert.Equal(t, "pending", params.Get("1").String())
+			assert.Equal(t, strings.ToLower(from.Hex()), params.Get("0.from").String())
+			if params.Get("0.to").String() == strings.ToLower(reverting.Hex()) {
+				resp.Error.Code = 3
+				resp.Error.Message = "execution reverted"
+				return
+			}
+			resp.Result = `"0x"`
+		case "eth_sendRawTransaction":
+			resp.Result = `"` + crypto.Keccak256Hash([]byte(params.Get("0").String())).Hex() + `"`
+		}
+		return
+	})
+	rpc := client.NewRPCClient(client.TestNodePoolConfig{}, logger.Test(t), server.WSURL(), nil, "rpc", 1, chainID, multinode.Primary, client.QueryTimeout, client.QueryTimeout, "")
+	defer rpc.Close()
+	ctx := tests.Context(t)
+	require.NoError(t, rpc.Dial(ctx))
+
+	signTx := func(t *testing.T, to common.Address) *types.Transaction {
+		tx, err := types.SignNewTx(key, types.LatestSignerForChainID(chainID), &types.DynamicFeeTx{
+			ChainID: chainID, To: &to, Gas: 21_000, GasFeeCap: big.NewInt(2), GasTipCap: big.NewInt(1),
+		})
+		require.NoError(t, err)
+		return tx
+	}
+
+	t.Run("SimulateTransaction", func(t *testing.T) {
+		require.NoError(t, rpc.SimulateTransaction(ctx, signTx(t, testutils.NewAddress())))
+		require.ErrorContains(t, rpc.SimulateTransaction(ctx, signTx(t, reverting)), "execution reverted")
+
+		unsigned := types.NewTx(&types.LegacyTx{To: &reverting})
+		require.ErrorContains(t, rpc.SimulateTransaction(ctx, unsigned), "failed to recover the sender")
+	})
+
+	t.Run("SendEmptyTransaction", func(t *testing.T) {
+		tx := signTx(t, common.Address{})
+		require.Equal(t, uint8(types.DynamicFeeTxType), tx.Type())
+		raw, err := tx.MarshalBinary()
+		require.NoError(t, err)
+		for _, attempt := range []any{tx, raw} {
+			hash, err := rpc.SendEmptyTransaction(ctx, func(nonce evmtypes.Nonce, feeLimit uint32, fee *assets.Wei, fromAddress common.Address) (any, error) {
+				assert.Equal(t, evmtypes.Nonce(7), nonce)
+				assert.Equal(t, from, fromAddress)
+				return attempt, nil
+			}, 7, 21_000, assets.NewWeiI(1), from)
+			require.NoError(t, err)
+			assert.Equal(t, tx.Hash().String(), hash)
+		}
+
+		_, err = rpc.SendEmptyTransaction(ctx, func(evmtypes.Nonce, uint32, *assets.Wei, common.Address) (any, error) {
+			return "tx", nil
+		}, 7, 21_000, assets.NewWeiI(1), from)
+		require.ErrorContains(t, err, "unsupported attempt type string")
+	})
+}
+
 func TestAstarCustomFinality(t *testing.T) {
 	t.Parallel()
 
@@ -3,6 +3,7 @@ package client
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
@@ -67,7 +68,8 @@ var (
 )
 
 // SimulatedBackendClient is an Client implementation using a simulated
-// blockchain backend. Note that not all RPC methods are implemented here.
+// blockchain backend. Note that not all RPC methods are implemented by
+// CallContext and BatchCallContext, see rpcMethod.
 type SimulatedBackendClient struct {
 	b                    evmtypes.Backend // *simulated.Backend, or something satisfying same interface
 	client               simulated.Client
@@ -113,19 +115,36 @@ func (c *SimulatedBackendClient) Close() {}
 // passing `nil` to `CallContract` when calling `CallContext` or `BatchCallContext`
 // and will not return an error when an old block is used.
 func (c *SimulatedBackendClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	m, ok := c.rpcMethod(method)
+	if !ok {
+		return fmt.Errorf("second arg to SimulatedBackendClient.Call is an RPC API method which has not yet been implemented: %s. Add processing for it here", method)
+	}
+	return m(ctx, result, args...)
+}
+
+// rpcMethod returns the implementation of the RPC method, and false if it is not implemented.
+func (c *SimulatedBackendClient) rpcMethod(method string) (func(context.Context, interface{}, ...interface{}) error, bool) {
 	switch method {
 	case "eth_getTransactionReceipt":
-		return c.ethGetTransactionReceipt(ctx, result, args...)
+		return c.ethGetTransactionReceipt, true
 	case "eth_getBlockByNumber":
-		return c.ethGetBlockByNumber(ctx, result, args...)
+		return c.ethGetBlockByNumber, true
 	case "eth_call":
-		return c.ethCall(ctx, result, args...)
+		return c.ethCall, true
 	case "eth_getHeaderByNumber":
-		return c.ethGetHeaderByNumber(ctx, result, args...)
+		return c.ethGetHeaderByNumber, true
 	case "eth_estimateGas":
-		return c.ethEstimateGas(ctx, result, args...)
+		return c.ethEstimateGas, true
+	case "eth_getLogs":
+		return c.ethGetLogs, true
+	case "eth_feeHistory":
+		return c.ethFeeHistory, true
+	case "eth_chainId":
+		return c.ethChainID, true
+	case "eth_blockNumber":
+		return c.ethBlockNumber, true
 	default:
-		return fmt.Errorf("second arg to SimulatedBackendClient.Call is an RPC API method which has not yet been implemented: %s. Add processing for it here", method)
+		return nil, false
 	}
 }
 
@@ -160,20 +179,26 @@ func (c *SimulatedBackendClient) TokenBalance(ctx context.Context, address commo
 		return nil, fmt.Errorf("%w: while calling ERC20 balanceOf method on %s "+
 			"for balance of %s", err, contractAddress, address)
 	}
-	err = balanceOfABI.UnpackIntoInterface(balance, "balanceOf", b)
+	balance = new(big.Int)
+	err = balanceOfABI.UnpackIntoInterface(&balance, "balanceOf", b)
 	if err != nil {
 		return nil, errors.New("unable to unpack balance")
 	}
 	return balance, nil
 }
 
-// GetLINKBalance get link balance.
+// LINKBalance returns the balance of the LINK token at linkAddress.
 func (c *SimulatedBackendClient) LINKBalance(ctx context.Context, address common.Address, linkAddress common.Address) (*assets.Link, error) {
-	panic("not implemented")
+	balance, err := c.TokenBalance(ctx, address, linkAddress)
+	if err != nil {
+		return nil, err
+	}
+	return (*assets.Link)(balance), nil
 }
 
+// FeeHistory returns the fee history of the blockCount blocks up to lastBlock.
 func (c *SimulatedBackendClient) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (feeHistory *ethereum.FeeHistory, err error) {
-	panic("not implemented")
+	return c.client.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
 }
 
 // TransactionReceipt returns the transaction receipt for the given transaction hash.
@@ -188,6 +213,8 @@ func (c *SimulatedBackendClient) TransactionByHash(ctx context.Context, txHash c
 
 func (c *SimulatedBackendClient) blockNumber(ctx context.Context, number interface{}) (blockNumber *big.Int, err error) {
 	switch n := number.(type) {
+	case rpc.BlockNumber:
+		return c.blockNumber(ctx, n.String())
 	case string:
 		switch n {
 		case "latest":
@@ -209,9 +236,9 @@ func (c *SimulatedBackendClient) blockNumber(ctx context.Context, number interfa
 			}
 			blockNumber = h.Number
 			return
-		case "finalized":
+		case "finalized", "safe":
 			var h *types.Header
-			h, err = c.client.HeaderByNumber(ctx, new(big.Int).SetInt64(rpc.FinalizedBlockNumber.Int64()))
+			h, err = c.fetchHeader(ctx, n)
 			if err != nil {
 				return
 			}
@@ -300,7 +327,7 @@ func (c *SimulatedBackendClient) ConfiguredChainID() *big.Int {
 
 // ChainID RPC call
 func (c *SimulatedBackendClient) ChainID() (*big.Int, error) {
-	panic("not implemented")
+	return c.client.ChainID(context.Background())
 }
 
 // PendingNonceAt gets pending nonce i.e. mempool nonce.
@@ -528,21 +555,8 @@ func (c *SimulatedBackendClient) BatchCallContext(ctx context.Context, b []rpc.B
 	}
 
 	for i, elem := range b {
-		var method func(context.Context, interface{}, ...interface{}) error
-		switch elem.Method {
-		case "eth_getTransactionReceipt":
-			method = c.ethGetTransactionReceipt
-		case "eth_getBlockByNumber":
-			method = c.ethGetBlockByNumber
-		case "eth_call":
-			method = c.ethCall
-		case "eth_getHeaderByNumber":
-			method = c.ethGetHeaderByNumber
-		case "eth_estimateGas":
-			method = c.ethEstimateGas
-		case "eth_getLogs":
-			method = c.ethGetLogs
-		default:
+		method, ok := c.rpcMethod(elem.Method)
+		if !ok {
 			return fmt.Errorf("SimulatedBackendClient got unsupported method %s", elem.Method)
 		}
 		b[i].Error = method(ctx, b[i].Result, b[i].Args...)
@@ -586,6 +600,10 @@ func (c *SimulatedBackendClient) fetchHeader(ctx context.Context, blockNumOrTag
 		return c.client.HeaderByNumber(ctx, big.NewInt(int64(rpc.LatestBlockNumber)))
 	case rpc.FinalizedBlockNumber.String():
 		return c.client.HeaderByNumber(ctx, big.NewInt(int64(rpc.FinalizedBlockNumber)))
+	case rpc.PendingBlockNumber.String():
+		return c.client.HeaderByNumber(ctx, big.NewInt(int64(rpc.PendingBlockNumber)))
+	case rpc.EarliestBlockNumber.String():
+		return c.client.HeaderByNumber(ctx, big.NewInt(0))
 	default:
 		blockNum, ok := new(big.Int).SetString(blockNumOrTag, 0)
 		if !ok {
@@ -654,7 +672,8 @@ func (c *SimulatedBackendClient) ethGetBlockByNumber(ctx context.Context, result
 		res.ParentHash = header.ParentHash
 		res.Timestamp = time.Unix(int64(header.Time), 0).UTC()
 	default:
-		return fmt.Errorf("SimulatedBackendClient Unexpected Type %T", res)
+		// decode the block as returned by a real RPC, e.g. into custom types
+		return assignJSON(result, header)
 	}
 
 	return nil
@@ -790,8 +809,17 @@ func (c *SimulatedBackendClient) ethGetLogs(ctx context.Context, result interfac
 		}
 	}
 
-	if a, ok := params["addresses"]; ok {
-		addresses = a.([]common.Address)
+	// geth encodes the filter query with "address", see ethclient.toFilterArg
+	for _, key := range []string{"address", "addresses"} {
+		switch a := params[key].(type) {
+		case nil:
+		case common.Address:
+			addresses = append(addresses, a)
+		case []common.Address:
+			addresses = append(addresses, a...)
+		default:
+			return fmt.Errorf("SimulatedBackendClient received unexpected '%s' param: %T", key, a)
+		}
 	}
 
 	if t, ok := params["topics"]; ok {
@@ -806,7 +834,7 @@ func (c *SimulatedBackendClient) ethGetLogs(ctx context.Context, result interfac
 		// lastTopic is the topic index of the last non-nil topic slice
 		//  We have to drop any nil values in the topics slice after that due to a quirk in FilterLogs(),
 		//  which will only use nil as a wildcard if there are non-nil values after it in the slice
-		for i := 0; i < lastTopic; i++ {
+		for i := 0; i <= lastTopic; i++ {
 			topics = append(topics, tt[i])
 		}
 	}
@@ -831,6 +859,102 @@ func (c *SimulatedBackendClient) ethGetLogs(ctx context.Context, result interfac
 	}
 }
 
+func (c *SimulatedBackendClient) ethFeeHistory(ctx context.Context, result interface{}, args ...interface{}) error {
+	if len(args) != 3 {
+		return fmt.Errorf("SimulatedBackendClient expected 3 args, got %d for eth_feeHistory", len(args))
+	}
+
+	var blockCount uint64
+	switch n := args[0].(type) {
+	case uint64:
+		blockCount = n
+	case hexutil.Uint64:
+		blockCount = uint64(n)
+	case hexutil.Uint:
+		blockCount = uint64(n)
+	case int:
+		if n < 0 {
+			return errors.New("SimulatedBackendClient expected first arg to be non-negative for eth_feeHistory")
+		}
+		blockCount = uint64(n)
+	default:
+		return fmt.Errorf("SimulatedBackendClient expected first arg to be a block count for eth_feeHistory, got: %T", args[0])
+	}
+
+	lastBlock, err := c.blockNumber(ctx, args[1])
+	if err != nil {
+		return fmt.Errorf("SimulatedBackendClient expected second arg to be a block number for eth_feeHistory: %w", err)
+	}
+
+	percentiles, ok := args[2].([]float64)
+	if !ok && args[2] != nil {
+		return fmt.Errorf("SimulatedBackendClient expected third arg to be []float64 for eth_feeHistory, got: %T", args[2])
+	}
+
+	feeHistory, err := c.client.FeeHistory(ctx, blockCount, lastBlock, percentiles)
+	if err != nil {
+		return err
+	}
+
+	if typed, ok := result.(*ethereum.FeeHistory); ok {
+		*typed = *feeHistory
+		return nil
+	}
+	// encode the fee history as returned by a real RPC
+	res := feeHistoryResult{
+		OldestBlock:  (*hexutil.Big)(feeHistory.OldestBlock),
+		BaseFee:      make([]*hexutil.Big, len(feeHistory.BaseFee)),
+		GasUsedRatio: feeHistory.GasUsedRatio,
+	}
+	for i, f := range feeHistory.BaseFee {
+		res.BaseFee[i] = (*hexutil.Big)(f)
+	}
+	for _, rewards := range feeHistory.Reward {
+		r := make([]*hexutil.Big, len(rewards))
+		for i, reward := range rewards {
+			r[i] = (*hexutil.Big)(reward)
+		}
+		res.Reward = append(res.Reward, r)
+	}
+	return assignJSON(result, res)
+}
+
+// feeHistoryResult is the eth_feeHistory result, see ethclient.feeHistoryResultMarshaling.
+type feeHistoryResult struct {
+	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
+	Reward       [][]*hexutil.Big `json:"reward,omitempty"`
+	BaseFee      []*hexutil.Big   `json:"baseFeePerGas,omitempty"`
+	GasUsedRatio []float64        `json:"gasUsedRatio"`
+}
+
+func (c *SimulatedBackendClient) ethChainID(ctx context.Context, result interface{}, args ...interface{}) error {
+	chainID, err := c.client.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+	return assignJSON(result, (*hexutil.Big)(chainID))
+}
+
+func (c *SimulatedBackendClient) ethBlockNumber(ctx context.Context, result interface{}, args ...interface{}) error {
+	n, err := c.client.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	return assignJSON(result, hexutil.Uint64(n))
+}
+
+// assignJSON assigns v to result as a real RPC would, by encoding it to JSON and decoding it into result.
+func assignJSON(result interface{}, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("SimulatedBackendClient failed to encode result: %w", err)
+	}
+	if err = json.Unmarshal(b, result); err != nil {
+		return fmt.Errorf("SimulatedBackendClient failed to decode result into %T: %w", result, err)
+	}
+	return nil
+}
+
 func (c *SimulatedBackendClient) CheckTxValidity(ctx context.Context, from common.Address, to common.Address, data []byte) *SendError {
 	return nil
 }
@@ -0,0 +1,136 @@
+package client_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/client"
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+)
+
+func TestSimulatedBackendClient(t *testing.T) {
+	t.Parallel()
+
+	owner := testutils.MustNewSimTransactor(t)
+	owner.GasTipCap = big.NewInt(1000000000)
+	token := testutils.NewAddress()
+	emitter := testutils.NewAddress()
+	topic := common.HexToHash("0x01")
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		owner.From: {Balance: big.NewInt(0).Mul(big.NewInt(10), big.NewInt(1e18))},
+		// returns 42 to any call
+		token: {Code: hexutil.MustDecode("0x602a60005260206000f3")},
+		// emits an anonymous log with topic on any call
+		emitter: {Code: append(append([]byte{0x7f}, topic[:]...), hexutil.MustDecode("0x60006000a100")...)},
+	})
+	t.Cleanup(func() { require.NoError(t, backend.Close()) })
+	c := client.NewSimulatedBackendClient(t, backend, testutils.SimulatedChainID)
+	ctx := testutils.Context(t)
+
+	tx, err := owner.Signer(owner.From, types.NewTx(&types.DynamicFeeTx{
+		ChainID:   testutils.SimulatedChainID,
+		To:        &emitter,
+		Gas:       100_000,
+		GasFeeCap: big.NewInt(10_000_000_000),
+		GasTipCap: owner.GasTipCap,
+	}))
+	require.NoError(t, err)
+	require.NoError(t, c.SendTransaction(ctx, tx))
+	c.Commit()
+
+	t.Run("LINKBalance", func(t *testing.T) {
+		balance, err := c.LINKBalance(ctx, owner.From, token)
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), balance.ToInt().Int64())
+	})
+
+	t.Run("ChainID", func(t *testing.T) {
+		chainID, err := c.ChainID()
+		require.NoError(t, err)
+		assert.Equal(t, testutils.SimulatedChainID, chainID)
+
+		var res hexutil.Big
+		require.NoError(t, c.CallContext(ctx, &res, "eth_chainId"))
+		assert.Equal(t, testutils.SimulatedChainID, res.ToInt())
+	})
+
+	t.Run("eth_blockNumber", func(t *testing.T) {
+		var res hexutil.Uint64
+		require.NoError(t, c.CallContext(ctx, &res, "eth_blockNumber"))
+		assert.Equal(t, hexutil.Uint64(1), res)
+	})
+
+	t.Run("FeeHistory", func(t *testing.T) {
+		feeHistory, err := c.FeeHistory(ctx, 1, nil, []float64{50})
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1), feeHistory.OldestBlock)
+		require.Len(t, feeHistory.Reward, 1)
+
+		var res ethereum.FeeHistory
+		require.NoError(t, c.CallContext(ctx, &res, "eth_feeHistory", uint64(1), "latest", []float64{50}))
+		assert.Equal(t, *feeHistory, res)
+
+		var raw map[string]any
+		require.NoError(t, c.CallContext(ctx, &raw, "eth_feeHistory", hexutil.Uint64(1), rpc.LatestBlockNumber, []float64{50}))
+		assert.Equal(t, "0x1", raw["oldestBlock"])
+	})
+
+	t.Run("batch eth_getLogs", func(t *testing.T) {
+		var matching, other []types.Log
+		b := []rpc.BatchElem{
+			{Method: "eth_getLogs", Args: []any{map[string]any{
+				"fromBlock": "earliest",
+				"toBlock":   "latest",
+				"address":   []common.Address{emitter},
+				"topics":    [][]common.Hash{{topic}, nil},
+			}}, Result: &matching},
+			{Method: "eth_getLogs", Args: []any{map[string]any{
+				"fromBlock": "0x0",
+				"address":   emitter,
+				"topics":    [][]common.Hash{{common.HexToHash("0x02")}},
+			}}, Result: &other},
+		}
+		require.NoError(t, c.BatchCallContext(ctx, b))
+		require.NoError(t, b[0].Error)
+		require.Len(t, matching, 1)
+		assert.Equal(t, tx.Hash(), matching[0].TxHash)
+		require.NoError(t, b[1].Error)
+		assert.Empty(t, other)
+
+		require.ErrorContains(t, c.BatchCallContext(ctx, []rpc.BatchElem{{Method: "eth_unknown"}}), "unsupported method eth_unknown")
+	})
+
+	t.Run("finalized blocks", func(t *testing.T) {
+		client.FinalizeLatest(t, backend)
+		latest, err := c.LatestBlockHeight(ctx)
+		require.NoError(t, err)
+
+		type block struct {
+			Number hexutil.Big `json:"number"`
+			Hash   common.Hash `json:"hash"`
+		}
+		for _, tag := range []string{"finalized", "safe"} {
+			var res block
+			require.NoError(t, c.CallContext(ctx, &res, "eth_getBlockByNumber", tag, false))
+			assert.Positive(t, res.Number.ToInt().Cmp(big.NewInt(0)))
+			assert.LessOrEqual(t, res.Number.ToInt().Cmp(latest), 0)
+
+			var logs []types.Log
+			require.NoError(t, c.CallContext(ctx, &logs, "eth_getLogs", map[string]any{
+				"fromBlock": "earliest",
+				"toBlock":   tag,
+				"addresses": []common.Address{emitter},
+			}))
+			assert.Len(t, logs, 1)
+		}
+	})
+}
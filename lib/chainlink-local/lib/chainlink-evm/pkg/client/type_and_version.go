@@ -0,0 +1,40 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-evm/gethwrappers/shared/generated/type_and_version"
+)
+
+// TypeAndVersionReader reads typeAndVersion() of contracts through the ITypeAndVersion wrapper. It implements the
+// TypeAndVersionReader of the CCIP reader, which uses it to verify the discovered contracts.
+type TypeAndVersionReader struct {
+	caller bind.ContractCaller
+}
+
+// NewTypeAndVersionReader returns a TypeAndVersionReader calling the contracts with caller, e.g. a Client.
+func NewTypeAndVersionReader(caller bind.ContractCaller) *TypeAndVersionReader {
+	return &TypeAndVersionReader{caller: caller}
+}
+
+// TypeAndVersion returns the typeAndVersion of the contract at the hex address, e.g. "OnRamp 1.6.0".
+func (r *TypeAndVersionReader) TypeAndVersion(ctx context.Context, address string) (string, error) {
+	if !common.IsHexAddress(address) {
+		return "", fmt.Errorf("invalid address %q", address)
+	}
+
+	tv, err := type_and_version.NewITypeAndVersionCaller(common.HexToAddress(address), r.caller)
+	if err != nil {
+		return "", fmt.Errorf("failed to create ITypeAndVersion caller: %w", err)
+	}
+
+	typeAndVersion, err := tv.TypeAndVersion(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return "", fmt.Errorf("failed to read typeAndVersion of %s: %w", address, err)
+	}
+	return typeAndVersion, nil
+}
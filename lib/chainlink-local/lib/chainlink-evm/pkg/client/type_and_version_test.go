@@ -0,0 +1,57 @@
+package client_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/gethwrappers/shared/generated/type_and_version"
+	"github.com/smartcontractkit/chainlink-evm/pkg/client"
+)
+
+type typeAndVersionCaller struct {
+	contracts map[common.Address][]byte
+}
+
+func (c *typeAndVersionCaller) CodeAt(_ context.Context, contract common.Address, _ *big.Int) ([]byte, error) {
+	if _, ok := c.contracts[contract]; !ok {
+		return nil, nil
+	}
+	return []byte{0x1}, nil
+}
+
+func (c *typeAndVersionCaller) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	return c.contracts[*call.To], nil
+}
+
+func TestTypeAndVersionReader_TypeAndVersion(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(type_and_version.ITypeAndVersionABI))
+	require.NoError(t, err)
+	onRamp := common.HexToAddress("0x1")
+	encoded, err := parsed.Methods["typeAndVersion"].Outputs.Pack("OnRamp 1.6.0")
+	require.NoError(t, err)
+
+	reader := client.NewTypeAndVersionReader(&typeAndVersionCaller{
+		contracts: map[common.Address][]byte{onRamp: encoded},
+	})
+	ctx := tests.Context(t)
+
+	typeAndVersion, err := reader.TypeAndVersion(ctx, onRamp.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, "OnRamp 1.6.0", typeAndVersion)
+
+	_, err = reader.TypeAndVersion(ctx, common.HexToAddress("0x2").Hex())
+	require.ErrorContains(t, err, "failed to read typeAndVersion")
+
+	_, err = reader.TypeAndVersion(ctx, "not an address")
+	require.ErrorContains(t, err, "invalid address")
+}
@@ -1,13 +1,108 @@
 package config
 
 import (
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
 	"github.com/smartcontractkit/chainlink-evm/pkg/config/toml"
 )
 
 type balanceMonitorConfig struct {
 	c toml.BalanceMonitor
+	k toml.KeySpecificConfig
 }
 
 func (b *balanceMonitorConfig) Enabled() bool {
 	return *b.c.Enabled
 }
+
+func (b *balanceMonitorConfig) LowBalance(key gethcommon.Address) *assets.Wei {
+	if ks := b.keySpecific(key); ks != nil && ks.LowBalance != nil {
+		return ks.LowBalance
+	}
+	return b.c.LowBalance
+}
+
+func (b *balanceMonitorConfig) CriticalBalance(key gethcommon.Address) *assets.Wei {
+	if ks := b.keySpecific(key); ks != nil && ks.CriticalBalance != nil {
+		return ks.CriticalBalance
+	}
+	return b.c.CriticalBalance
+}
+
+func (b *balanceMonitorConfig) keySpecific(key gethcommon.Address) *toml.KeySpecificBalanceMonitor {
+	for i := range b.k {
+		if b.k[i].Key.Address() == key {
+			return &b.k[i].BalanceMonitor
+		}
+	}
+	return nil
+}
+
+func (b *balanceMonitorConfig) Tokens() []BalanceMonitorToken {
+	tokens := make([]BalanceMonitorToken, 0, len(b.c.Tokens))
+	for _, t := range b.c.Tokens {
+		token := BalanceMonitorToken{Address: t.Address.Address()}
+		if t.Symbol != nil {
+			token.Symbol = *t.Symbol
+		}
+		if t.LowBalance != nil {
+			token.LowBalance = t.LowBalance.ToInt()
+		}
+		if t.CriticalBalance != nil {
+			token.CriticalBalance = t.CriticalBalance.ToInt()
+		}
+		if t.TopUpFloor != nil {
+			token.TopUpFloor = t.TopUpFloor.ToInt()
+		}
+		if t.TopUpAmount != nil {
+			token.TopUpAmount = t.TopUpAmount.ToInt()
+		}
+		if t.TopUpPeriodCap != nil {
+			token.TopUpPeriodCap = t.TopUpPeriodCap.ToInt()
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens
+}
+
+func (b *balanceMonitorConfig) TopUp() BalanceMonitorTopUp {
+	return &balanceMonitorTopUpConfig{c: b.c.TopUp}
+}
+
+type balanceMonitorTopUpConfig struct {
+	c toml.BalanceMonitorTopUp
+}
+
+func (t *balanceMonitorTopUpConfig) Enabled() bool {
+	return *t.c.Enabled
+}
+
+func (t *balanceMonitorTopUpConfig) Treasury() gethcommon.Address {
+	if t.c.Treasury == nil {
+		return gethcommon.Address{}
+	}
+	return t.c.Treasury.Address()
+}
+
+func (t *balanceMonitorTopUpConfig) Floor() *assets.Wei {
+	return t.c.Floor
+}
+
+func (t *balanceMonitorTopUpConfig) Amount() *assets.Wei {
+	return t.c.Amount
+}
+
+func (t *balanceMonitorTopUpConfig) PeriodCap() *assets.Wei {
+	return t.c.PeriodCap
+}
+
+func (t *balanceMonitorTopUpConfig) Period() time.Duration {
+	return t.c.Period.Duration()
+}
+
+func (t *balanceMonitorTopUpConfig) GasLimit() uint64 {
+	return *t.c.GasLimit
+}
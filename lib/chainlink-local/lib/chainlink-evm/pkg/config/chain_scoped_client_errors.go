@@ -49,3 +49,4 @@ func (c *clientErrorsConfig) ServiceUnavailable() string {
 	return derefOrDefault(c.c.ServiceUnavailable)
 }
 func (c *clientErrorsConfig) TooManyResults() string { return derefOrDefault(c.c.TooManyResults) }
+func (c *clientErrorsConfig) CatalogFile() string    { return derefOrDefault(c.c.CatalogFile) }
@@ -55,3 +55,24 @@ func (n *NodePoolConfig) EnforceRepeatableRead() bool {
 func (n *NodePoolConfig) DeathDeclarationDelay() time.Duration {
 	return n.C.DeathDeclarationDelay.Duration()
 }
+
+func (n *NodePoolConfig) Hedging() Hedging { return &hedgingConfig{c: n.C.Hedging} }
+
+type hedgingConfig struct {
+	c toml.Hedging
+}
+
+func (h *hedgingConfig) Enabled() bool {
+	return h.c.Enabled != nil && *h.c.Enabled
+}
+
+func (h *hedgingConfig) Delay() time.Duration {
+	if h.c.Delay == nil {
+		return 0
+	}
+	return h.c.Delay.Duration()
+}
+
+func (h *hedgingConfig) PreferFastestNode() bool {
+	return h.c.PreferFastestNode != nil && *h.c.PreferFastestNode
+}
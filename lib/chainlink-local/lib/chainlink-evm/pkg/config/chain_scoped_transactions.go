@@ -4,11 +4,14 @@ import (
 	"net/url"
 	"time"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
 	"github.com/smartcontractkit/chainlink-evm/pkg/config/toml"
 )
 
 type transactionsConfig struct {
 	c toml.Transactions
+	k toml.KeySpecificConfig
 }
 
 func (t *transactionsConfig) Enabled() bool {
@@ -87,3 +90,32 @@ func (a *autoPurgeConfig) MinAttempts() *uint32 {
 func (a *autoPurgeConfig) DetectionApiUrl() *url.URL {
 	return a.c.DetectionApiUrl.URL()
 }
+
+func (t *transactionsConfig) KeySelection() KeySelection {
+	return &keySelectionConfig{c: t.c.KeySelection, k: t.k}
+}
+
+type keySelectionConfig struct {
+	c toml.KeySelection
+	k toml.KeySpecificConfig
+}
+
+func (k *keySelectionConfig) SkipLowBalance() bool {
+	return *k.c.SkipLowBalance
+}
+
+func (k *keySelectionConfig) MaxUnconfirmed() uint32 {
+	return *k.c.MaxUnconfirmed
+}
+
+func (k *keySelectionConfig) Priority(key gethcommon.Address) uint32 {
+	for i := range k.k {
+		if k.k[i].Key.Address() == key {
+			if p := k.k[i].Transactions.Priority; p != nil {
+				return *p
+			}
+			break
+		}
+	}
+	return 1
+}
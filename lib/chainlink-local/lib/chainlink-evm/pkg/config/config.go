@@ -36,6 +36,7 @@ type EVM interface {
 	FlagsContractAddress() string
 	LinkContractAddress() string
 	LogBackfillBatchSize() uint32
+	LogBackfillConcurrency() uint32
 	LogKeepBlocksDepth() uint32
 	BackupLogPollerBlockDelay() uint64
 	LogPollInterval() time.Duration
@@ -82,6 +83,38 @@ type HeadTracker interface {
 
 type BalanceMonitor interface {
 	Enabled() bool
+	// LowBalance returns the native balance below which the key is reported as low, or nil if not set.
+	LowBalance(key gethcommon.Address) *assets.Wei
+	// CriticalBalance returns the native balance below which the key is reported as critical, or nil if not set.
+	CriticalBalance(key gethcommon.Address) *assets.Wei
+	// Tokens returns the ERC-20 tokens whose balances are monitored for every key.
+	Tokens() []BalanceMonitorToken
+	TopUp() BalanceMonitorTopUp
+}
+
+// BalanceMonitorToken is an ERC-20 token whose balances are monitored. Balances are in the smallest token unit.
+type BalanceMonitorToken struct {
+	Address         gethcommon.Address
+	Symbol          string
+	LowBalance      *big.Int
+	CriticalBalance *big.Int
+	// TopUpFloor, TopUpAmount and TopUpPeriodCap are nil if not set.
+	TopUpFloor     *big.Int
+	TopUpAmount    *big.Int
+	TopUpPeriodCap *big.Int
+}
+
+type BalanceMonitorTopUp interface {
+	Enabled() bool
+	// Treasury returns the key the top-ups are sent from.
+	Treasury() gethcommon.Address
+	// Floor returns the native balance below which keys are topped up, or nil if native top-ups are disabled.
+	Floor() *assets.Wei
+	Amount() *assets.Wei
+	// PeriodCap returns the maximum native amount sent per Period, or nil if unlimited.
+	PeriodCap() *assets.Wei
+	Period() time.Duration
+	GasLimit() uint64
 }
 
 type ClientErrors interface {
@@ -100,6 +133,8 @@ type ClientErrors interface {
 	Fatal() string
 	ServiceUnavailable() string
 	TooManyResults() string
+	// CatalogFile returns the path of the error catalog file merged over the embedded error catalog, or "" if none.
+	CatalogFile() string
 }
 
 type Transactions interface {
@@ -112,6 +147,7 @@ type Transactions interface {
 	MaxQueued() uint64
 	AutoPurge() AutoPurgeConfig
 	TransactionManagerV2() TransactionManagerV2
+	KeySelection() KeySelection
 }
 
 type AutoPurgeConfig interface {
@@ -128,6 +164,14 @@ type TransactionManagerV2 interface {
 	DualBroadcast() *bool
 }
 
+type KeySelection interface {
+	SkipLowBalance() bool
+	// MaxUnconfirmed returns the number of unconfirmed transactions from which keys are skipped, or 0 if unlimited.
+	MaxUnconfirmed() uint32
+	// Priority returns the selection weight of the key, 1 if not set.
+	Priority(key gethcommon.Address) uint32
+}
+
 type GasEstimator interface {
 	BlockHistory() BlockHistory
 	FeeHistory() FeeHistory
@@ -202,6 +246,13 @@ type NodePool interface {
 	DeathDeclarationDelay() time.Duration
 	NewHeadsPollInterval() time.Duration
 	VerifyChainID() bool
+	Hedging() Hedging
+}
+
+type Hedging interface {
+	Enabled() bool
+	Delay() time.Duration
+	PreferFastestNode() bool
 }
 
 type ChainScopedConfig interface {
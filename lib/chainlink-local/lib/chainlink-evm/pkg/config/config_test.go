@@ -7,9 +7,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
 	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
 	"github.com/smartcontractkit/chainlink-evm/pkg/config/configtest"
 	"github.com/smartcontractkit/chainlink-evm/pkg/config/toml"
@@ -358,6 +361,101 @@ func TestChainScopedConfig_HeadTracker(t *testing.T) {
 	assert.True(t, ht.PersistenceEnabled())
 }
 
+func TestChainScopedConfig_BalanceMonitor(t *testing.T) {
+	t.Parallel()
+	key := utils.NewAddress()
+	otherKey := utils.NewAddress()
+	token := utils.NewAddress()
+
+	cfg := configtest.NewChainScopedConfig(t, nil)
+	bm := cfg.EVM().BalanceMonitor()
+	assert.True(t, bm.Enabled())
+	assert.Nil(t, bm.LowBalance(key))
+	assert.Nil(t, bm.CriticalBalance(key))
+	assert.Empty(t, bm.Tokens())
+
+	cfg = configtest.NewChainScopedConfig(t, func(c *toml.EVMConfig) {
+		c.BalanceMonitor.LowBalance = assets.GWei(100)
+		c.BalanceMonitor.CriticalBalance = assets.GWei(10)
+		c.BalanceMonitor.Tokens = toml.BalanceMonitorTokens{{
+			Address:    ptr(types.EIP55AddressFromAddress(token)),
+			Symbol:     ptr("LINK"),
+			LowBalance: ubig.NewI(1000),
+		}}
+		c.KeySpecific = toml.KeySpecificConfig{{
+			Key:            ptr(types.EIP55AddressFromAddress(key)),
+			BalanceMonitor: toml.KeySpecificBalanceMonitor{LowBalance: assets.GWei(500)},
+		}}
+	})
+	bm = cfg.EVM().BalanceMonitor()
+	assert.Equal(t, assets.GWei(500), bm.LowBalance(key))
+	assert.Equal(t, assets.GWei(10), bm.CriticalBalance(key))
+	assert.Equal(t, assets.GWei(100), bm.LowBalance(otherKey))
+	assert.Equal(t, assets.GWei(10), bm.CriticalBalance(otherKey))
+	require.Len(t, bm.Tokens(), 1)
+	assert.Equal(t, token, bm.Tokens()[0].Address)
+	assert.Equal(t, "LINK", bm.Tokens()[0].Symbol)
+	assert.Equal(t, big.NewInt(1000), bm.Tokens()[0].LowBalance)
+	assert.Nil(t, bm.Tokens()[0].CriticalBalance)
+	assert.Nil(t, bm.Tokens()[0].TopUpFloor)
+}
+
+func TestChainScopedConfig_BalanceMonitorTopUp(t *testing.T) {
+	t.Parallel()
+	treasury := utils.NewAddress()
+
+	cfg := configtest.NewChainScopedConfig(t, nil)
+	topUp := cfg.EVM().BalanceMonitor().TopUp()
+	assert.False(t, topUp.Enabled())
+	assert.Equal(t, common.Address{}, topUp.Treasury())
+	assert.Nil(t, topUp.Floor())
+	assert.Nil(t, topUp.PeriodCap())
+	assert.Equal(t, 24*time.Hour, topUp.Period())
+	assert.Equal(t, uint64(100_000), topUp.GasLimit())
+
+	cfg = configtest.NewChainScopedConfig(t, func(c *toml.EVMConfig) {
+		c.BalanceMonitor.TopUp.Enabled = ptr(true)
+		c.BalanceMonitor.TopUp.Treasury = ptr(types.EIP55AddressFromAddress(treasury))
+		c.BalanceMonitor.TopUp.Floor = assets.GWei(10)
+		c.BalanceMonitor.TopUp.Amount = assets.GWei(50)
+		c.BalanceMonitor.TopUp.PeriodCap = assets.GWei(200)
+		c.BalanceMonitor.TopUp.Period = commonconfig.MustNewDuration(time.Hour)
+	})
+	topUp = cfg.EVM().BalanceMonitor().TopUp()
+	assert.True(t, topUp.Enabled())
+	assert.Equal(t, treasury, topUp.Treasury())
+	assert.Equal(t, assets.GWei(10), topUp.Floor())
+	assert.Equal(t, assets.GWei(50), topUp.Amount())
+	assert.Equal(t, assets.GWei(200), topUp.PeriodCap())
+	assert.Equal(t, time.Hour, topUp.Period())
+	assert.Equal(t, uint64(100_000), topUp.GasLimit())
+}
+
+func TestChainScopedConfig_KeySelection(t *testing.T) {
+	t.Parallel()
+	key := utils.NewAddress()
+
+	cfg := configtest.NewChainScopedConfig(t, nil)
+	ks := cfg.EVM().Transactions().KeySelection()
+	assert.False(t, ks.SkipLowBalance())
+	assert.Zero(t, ks.MaxUnconfirmed())
+	assert.Equal(t, uint32(1), ks.Priority(key))
+
+	cfg = configtest.NewChainScopedConfig(t, func(c *toml.EVMConfig) {
+		c.Transactions.KeySelection.SkipLowBalance = ptr(true)
+		c.Transactions.KeySelection.MaxUnconfirmed = ptr[uint32](5)
+		c.KeySpecific = toml.KeySpecificConfig{{
+			Key:          ptr(types.EIP55AddressFromAddress(key)),
+			Transactions: toml.KeySpecificTransactions{Priority: ptr[uint32](3)},
+		}}
+	})
+	ks = cfg.EVM().Transactions().KeySelection()
+	assert.True(t, ks.SkipLowBalance())
+	assert.Equal(t, uint32(5), ks.MaxUnconfirmed())
+	assert.Equal(t, uint32(3), ks.Priority(key))
+	assert.Equal(t, uint32(1), ks.Priority(utils.NewAddress()))
+}
+
 func TestNodePoolConfig(t *testing.T) {
 	cfg := configtest.NewChainScopedConfig(t, nil)
 
@@ -394,6 +492,7 @@ func TestClientErrorsConfig(t *testing.T) {
 					Fatal:                             ptr("client error fatal"),
 					ServiceUnavailable:                ptr("client error service unavailable"),
 					TooManyResults:                    ptr("client error too many results"),
+					CatalogFile:                       ptr("errors.toml"),
 				},
 			}
 		})
@@ -414,6 +513,7 @@ func TestClientErrorsConfig(t *testing.T) {
 		assert.Equal(t, "client error fatal", errors.Fatal())
 		assert.Equal(t, "client error service unavailable", errors.ServiceUnavailable())
 		assert.Equal(t, "client error too many results", errors.TooManyResults())
+		assert.Equal(t, "errors.toml", errors.CatalogFile())
 	})
 }
 
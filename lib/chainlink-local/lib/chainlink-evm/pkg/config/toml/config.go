@@ -393,6 +393,7 @@ type Chain struct {
 	FlagsContractAddress         *types.EIP55Address
 	LinkContractAddress          *types.EIP55Address
 	LogBackfillBatchSize         *uint32
+	LogBackfillConcurrency       *uint32
 	LogPollInterval              *commonconfig.Duration
 	LogKeepBlocksDepth           *uint32
 	LogPrunePageSize             *uint32
@@ -524,6 +525,7 @@ type Transactions struct {
 
 	AutoPurge            AutoPurgeConfig            `toml:",omitempty"`
 	TransactionManagerV2 TransactionManagerV2Config `toml:",omitempty"`
+	KeySelection         KeySelection               `toml:",omitempty"`
 }
 
 func (t *Transactions) setFrom(f *Transactions) {
@@ -550,6 +552,7 @@ func (t *Transactions) setFrom(f *Transactions) {
 	}
 	t.AutoPurge.setFrom(&f.AutoPurge)
 	t.TransactionManagerV2.setFrom(&f.TransactionManagerV2)
+	t.KeySelection.setFrom(&f.KeySelection)
 }
 
 type AutoPurgeConfig struct {
@@ -647,13 +650,151 @@ func (m *Workflow) setFrom(f *Workflow) {
 }
 
 type BalanceMonitor struct {
-	Enabled *bool
+	Enabled         *bool
+	LowBalance      *assets.Wei
+	CriticalBalance *assets.Wei
+	TopUp           BalanceMonitorTopUp  `toml:",omitempty"`
+	Tokens          BalanceMonitorTokens `toml:",omitempty"`
 }
 
 func (m *BalanceMonitor) setFrom(f *BalanceMonitor) {
 	if v := f.Enabled; v != nil {
 		m.Enabled = v
 	}
+	if v := f.LowBalance; v != nil {
+		m.LowBalance = v
+	}
+	if v := f.CriticalBalance; v != nil {
+		m.CriticalBalance = v
+	}
+	m.TopUp.setFrom(&f.TopUp)
+	for i := range f.Tokens {
+		v := f.Tokens[i]
+		if i := slices.IndexFunc(m.Tokens, func(t BalanceMonitorToken) bool { return t.Address == v.Address }); i == -1 {
+			m.Tokens = append(m.Tokens, v)
+		} else {
+			m.Tokens[i].setFrom(&v)
+		}
+	}
+}
+
+func (m *BalanceMonitor) ValidateConfig() (err error) {
+	if m.LowBalance != nil && m.CriticalBalance != nil && m.CriticalBalance.Cmp(m.LowBalance) > 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "CriticalBalance", Value: m.CriticalBalance,
+			Msg: "must be less than or equal to LowBalance"})
+	}
+	return
+}
+
+type BalanceMonitorTopUp struct {
+	Enabled   *bool
+	Treasury  *types.EIP55Address
+	Floor     *assets.Wei
+	Amount    *assets.Wei
+	PeriodCap *assets.Wei
+	Period    *commonconfig.Duration
+	GasLimit  *uint64
+}
+
+func (t *BalanceMonitorTopUp) setFrom(f *BalanceMonitorTopUp) {
+	if v := f.Enabled; v != nil {
+		t.Enabled = v
+	}
+	if v := f.Treasury; v != nil {
+		t.Treasury = v
+	}
+	if v := f.Floor; v != nil {
+		t.Floor = v
+	}
+	if v := f.Amount; v != nil {
+		t.Amount = v
+	}
+	if v := f.PeriodCap; v != nil {
+		t.PeriodCap = v
+	}
+	if v := f.Period; v != nil {
+		t.Period = v
+	}
+	if v := f.GasLimit; v != nil {
+		t.GasLimit = v
+	}
+}
+
+func (t *BalanceMonitorTopUp) ValidateConfig() (err error) {
+	if t.Enabled == nil || !*t.Enabled {
+		return
+	}
+	if t.Treasury == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "Treasury", Msg: "must be set when TopUp is enabled"})
+	}
+	if t.Floor != nil && (t.Amount == nil || t.Amount.IsZero()) {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Amount", Value: t.Amount, Msg: "must be greater than 0 when Floor is set"})
+	}
+	if t.Period != nil && t.Period.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Period", Value: t.Period, Msg: "must be greater than 0"})
+	}
+	if t.GasLimit != nil && *t.GasLimit == 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "GasLimit", Value: *t.GasLimit, Msg: "must be greater than 0"})
+	}
+	return
+}
+
+type BalanceMonitorTokens []BalanceMonitorToken
+
+func (ts BalanceMonitorTokens) ValidateConfig() (err error) {
+	addrs := map[string]struct{}{}
+	for _, t := range ts {
+		if t.Address == nil {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: "Tokens.Address", Msg: "must be set"})
+			continue
+		}
+		addr := t.Address.String()
+		if _, ok := addrs[addr]; ok {
+			err = multierr.Append(err, commonconfig.NewErrDuplicate("Tokens.Address", addr))
+		} else {
+			addrs[addr] = struct{}{}
+		}
+		if t.LowBalance != nil && t.CriticalBalance != nil && t.CriticalBalance.Cmp(t.LowBalance) > 0 {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Tokens.CriticalBalance", Value: t.CriticalBalance,
+				Msg: fmt.Sprintf("must be less than or equal to LowBalance for token %s", addr)})
+		}
+		if t.TopUpFloor != nil && (t.TopUpAmount == nil || t.TopUpAmount.Cmp(big.NewI(0)) <= 0) {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Tokens.TopUpAmount", Value: t.TopUpAmount,
+				Msg: fmt.Sprintf("must be greater than 0 when TopUpFloor is set for token %s", addr)})
+		}
+	}
+	return
+}
+
+type BalanceMonitorToken struct {
+	Address         *types.EIP55Address
+	Symbol          *string
+	LowBalance      *big.Big
+	CriticalBalance *big.Big
+	TopUpFloor      *big.Big
+	TopUpAmount     *big.Big
+	TopUpPeriodCap  *big.Big
+}
+
+func (t *BalanceMonitorToken) setFrom(f *BalanceMonitorToken) {
+	if v := f.Symbol; v != nil {
+		t.Symbol = v
+	}
+	if v := f.LowBalance; v != nil {
+		t.LowBalance = v
+	}
+	if v := f.CriticalBalance; v != nil {
+		t.CriticalBalance = v
+	}
+	if v := f.TopUpFloor; v != nil {
+		t.TopUpFloor = v
+	}
+	if v := f.TopUpAmount; v != nil {
+		t.TopUpAmount = v
+	}
+	if v := f.TopUpPeriodCap; v != nil {
+		t.TopUpPeriodCap = v
+	}
 }
 
 type GasEstimator struct {
@@ -907,6 +1048,20 @@ func (d *DAOracle) setFrom(f *DAOracle) {
 	}
 }
 
+type KeySelection struct {
+	SkipLowBalance *bool
+	MaxUnconfirmed *uint32
+}
+
+func (k *KeySelection) setFrom(f *KeySelection) {
+	if v := f.SkipLowBalance; v != nil {
+		k.SkipLowBalance = v
+	}
+	if v := f.MaxUnconfirmed; v != nil {
+		k.MaxUnconfirmed = v
+	}
+}
+
 type KeySpecificConfig []KeySpecific
 
 func (ks KeySpecificConfig) ValidateConfig() (err error) {
@@ -923,8 +1078,10 @@ func (ks KeySpecificConfig) ValidateConfig() (err error) {
 }
 
 type KeySpecific struct {
-	Key          *types.EIP55Address
-	GasEstimator KeySpecificGasEstimator `toml:",omitempty"`
+	Key            *types.EIP55Address
+	GasEstimator   KeySpecificGasEstimator   `toml:",omitempty"`
+	BalanceMonitor KeySpecificBalanceMonitor `toml:",omitempty"`
+	Transactions   KeySpecificTransactions   `toml:",omitempty"`
 }
 
 type KeySpecificGasEstimator struct {
@@ -937,6 +1094,37 @@ func (e *KeySpecificGasEstimator) setFrom(f *KeySpecificGasEstimator) {
 	}
 }
 
+type KeySpecificBalanceMonitor struct {
+	LowBalance      *assets.Wei
+	CriticalBalance *assets.Wei
+}
+
+func (m *KeySpecificBalanceMonitor) setFrom(f *KeySpecificBalanceMonitor) {
+	if v := f.LowBalance; v != nil {
+		m.LowBalance = v
+	}
+	if v := f.CriticalBalance; v != nil {
+		m.CriticalBalance = v
+	}
+}
+
+type KeySpecificTransactions struct {
+	Priority *uint32
+}
+
+func (t *KeySpecificTransactions) setFrom(f *KeySpecificTransactions) {
+	if v := f.Priority; v != nil {
+		t.Priority = v
+	}
+}
+
+func (t *KeySpecificTransactions) ValidateConfig() (err error) {
+	if t.Priority != nil && *t.Priority == 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Priority", Value: *t.Priority, Msg: "must be greater than 0"})
+	}
+	return
+}
+
 type HeadTracker struct {
 	HistoryDepth            *uint32
 	MaxBufferSize           *uint32
@@ -992,6 +1180,7 @@ type ClientErrors struct {
 	Fatal                             *string `toml:",omitempty"`
 	ServiceUnavailable                *string `toml:",omitempty"`
 	TooManyResults                    *string `toml:",omitempty"`
+	CatalogFile                       *string `toml:",omitempty"`
 }
 
 func (r *ClientErrors) setFrom(f *ClientErrors) bool {
@@ -1040,6 +1229,9 @@ func (r *ClientErrors) setFrom(f *ClientErrors) bool {
 	if v := f.TooManyResults; v != nil {
 		r.TooManyResults = v
 	}
+	if v := f.CatalogFile; v != nil {
+		r.CatalogFile = v
+	}
 	return true
 }
 
@@ -1051,6 +1243,7 @@ type NodePool struct {
 	LeaseDuration              *commonconfig.Duration
 	NodeIsSyncingEnabled       *bool
 	FinalizedBlockPollInterval *commonconfig.Duration
+	Hedging                    Hedging      `toml:",omitempty"`
 	Errors                     ClientErrors `toml:",omitempty"`
 	EnforceRepeatableRead      *bool
 	DeathDeclarationDelay      *commonconfig.Duration
@@ -1058,6 +1251,34 @@ type NodePool struct {
 	VerifyChainID              *bool
 }
 
+// Hedging configures hedged requests of latency-sensitive calls, sent to a second node when the first is slow to answer.
+type Hedging struct {
+	Enabled           *bool
+	Delay             *commonconfig.Duration
+	PreferFastestNode *bool
+}
+
+func (h *Hedging) setFrom(f *Hedging) {
+	if v := f.Enabled; v != nil {
+		h.Enabled = v
+	}
+	if v := f.Delay; v != nil {
+		h.Delay = v
+	}
+	if v := f.PreferFastestNode; v != nil {
+		h.PreferFastestNode = v
+	}
+}
+
+func (h *Hedging) ValidateConfig() (err error) {
+	if h.Enabled != nil && *h.Enabled {
+		if h.Delay == nil || h.Delay.Duration() <= 0 {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Delay", Value: h.Delay, Msg: "must be greater than 0 when hedging is enabled"})
+		}
+	}
+	return
+}
+
 func (p *NodePool) setFrom(f *NodePool) {
 	if v := f.PollFailureThreshold; v != nil {
 		p.PollFailureThreshold = v
@@ -1098,6 +1319,7 @@ func (p *NodePool) setFrom(f *NodePool) {
 	}
 
 	p.Errors.setFrom(&f.Errors)
+	p.Hedging.setFrom(&f.Hedging)
 }
 
 func (p *NodePool) ValidateConfig(finalityTagEnabled *bool) (err error) {
@@ -65,6 +65,12 @@ func TestDefaults_fieldsNotNil(t *testing.T) {
 	unknown.Transactions.AutoPurge.Threshold = ptr(uint32(0))
 	unknown.Transactions.AutoPurge.MinAttempts = ptr(uint32(0))
 	unknown.Transactions.AutoPurge.DetectionApiUrl = new(config.URL)
+	unknown.BalanceMonitor.LowBalance = new(assets.Wei)
+	unknown.BalanceMonitor.CriticalBalance = new(assets.Wei)
+	unknown.BalanceMonitor.TopUp.Treasury = new(types.EIP55Address)
+	unknown.BalanceMonitor.TopUp.Floor = new(assets.Wei)
+	unknown.BalanceMonitor.TopUp.Amount = new(assets.Wei)
+	unknown.BalanceMonitor.TopUp.PeriodCap = new(assets.Wei)
 	unknown.GasEstimator.BlockHistory.EIP1559FeeCapBufferBlocks = ptr[uint16](10)
 	oracleType := DAOracleOPStack
 	unknown.GasEstimator.DAOracle.OracleType = &oracleType
@@ -95,6 +101,7 @@ func TestDefaults_fieldsNotNil(t *testing.T) {
 		Fatal:                             ptr("fatal"),
 		ServiceUnavailable:                ptr("unavailable"),
 		TooManyResults:                    ptr("too-many"),
+		CatalogFile:                       ptr("errors.toml"),
 	}
 
 	configtest.AssertFieldsNotNil(t, unknown)
@@ -115,10 +122,30 @@ func TestDocs(t *testing.T) {
 		// clean up KeySpecific as a special case
 		require.Len(t, docDefaults.KeySpecific, 1)
 		ks := KeySpecific{Key: new(types.EIP55Address),
-			GasEstimator: KeySpecificGasEstimator{PriceMax: new(assets.Wei)}}
+			GasEstimator:   KeySpecificGasEstimator{PriceMax: new(assets.Wei)},
+			BalanceMonitor: KeySpecificBalanceMonitor{LowBalance: new(assets.Wei), CriticalBalance: new(assets.Wei)},
+			Transactions:   KeySpecificTransactions{Priority: ptr[uint32](0)}}
 		require.Equal(t, ks, docDefaults.KeySpecific[0])
 		docDefaults.KeySpecific = nil
 
+		// balance thresholds and tokens are only set if balances should be reported as low
+		require.Zero(t, *docDefaults.BalanceMonitor.LowBalance)
+		require.Zero(t, *docDefaults.BalanceMonitor.CriticalBalance)
+		require.Len(t, docDefaults.BalanceMonitor.Tokens, 1)
+		docDefaults.BalanceMonitor.LowBalance = nil
+		docDefaults.BalanceMonitor.CriticalBalance = nil
+		docDefaults.BalanceMonitor.Tokens = nil
+
+		// top-ups are disabled by default, there are no global treasury, floor or amounts
+		require.Empty(t, docDefaults.BalanceMonitor.TopUp.Treasury)
+		require.Zero(t, *docDefaults.BalanceMonitor.TopUp.Floor)
+		require.Zero(t, *docDefaults.BalanceMonitor.TopUp.Amount)
+		require.Zero(t, *docDefaults.BalanceMonitor.TopUp.PeriodCap)
+		docDefaults.BalanceMonitor.TopUp.Treasury = nil
+		docDefaults.BalanceMonitor.TopUp.Floor = nil
+		docDefaults.BalanceMonitor.TopUp.Amount = nil
+		docDefaults.BalanceMonitor.TopUp.PeriodCap = nil
+
 		// EVM.GasEstimator.BumpTxDepth doesn't have a constant default - it is derived from another field
 		require.Zero(t, *docDefaults.GasEstimator.BumpTxDepth)
 		docDefaults.GasEstimator.BumpTxDepth = nil
@@ -180,7 +207,27 @@ var fullConfig = EVMConfig{
 	Chain: Chain{
 		AutoCreateKey: ptr(false),
 		BalanceMonitor: BalanceMonitor{
-			Enabled: ptr(true),
+			Enabled:         ptr(true),
+			LowBalance:      assets.GWei(2_000_000_000),
+			CriticalBalance: assets.GWei(100_000_000),
+			TopUp: BalanceMonitorTopUp{
+				Enabled:   ptr(true),
+				Treasury:  ptr(types.MustEIP55Address("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")),
+				Floor:     assets.GWei(500_000_000),
+				Amount:    assets.GWei(1_000_000_000),
+				PeriodCap: assets.GWei(10_000_000_000),
+				Period:    config.MustNewDuration(12 * time.Hour),
+				GasLimit:  ptr[uint64](90_000),
+			},
+			Tokens: BalanceMonitorTokens{{
+				Address:         ptr(types.MustEIP55Address("0x538aAaB4ea120b2bC2fe5D296852D948F07D849e")),
+				Symbol:          ptr("LINK"),
+				LowBalance:      big.NewI(5000),
+				CriticalBalance: big.NewI(500),
+				TopUpFloor:      big.NewI(1000),
+				TopUpAmount:     big.NewI(4000),
+				TopUpPeriodCap:  big.NewI(20000),
+			}},
 		},
 		BlockBackfillDepth:   ptr[uint32](100),
 		BlockBackfillSkip:    ptr(true),
@@ -243,11 +290,19 @@ var fullConfig = EVMConfig{
 				GasEstimator: KeySpecificGasEstimator{
 					PriceMax: assets.NewWei(new(stdbig.Int).SetBytes([]byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF})),
 				},
+				BalanceMonitor: KeySpecificBalanceMonitor{
+					LowBalance:      assets.GWei(3_000_000_000),
+					CriticalBalance: assets.GWei(500_000_000),
+				},
+				Transactions: KeySpecificTransactions{
+					Priority: ptr[uint32](3),
+				},
 			},
 		},
 
 		LinkContractAddress:          ptr(types.MustEIP55Address("0x538aAaB4ea120b2bC2fe5D296852D948F07D849e")),
 		LogBackfillBatchSize:         ptr[uint32](17),
+		LogBackfillConcurrency:       ptr[uint32](4),
 		LogPollInterval:              config.MustNewDuration(time.Minute),
 		LogKeepBlocksDepth:           ptr[uint32](100000),
 		LogPrunePageSize:             ptr[uint32](0),
@@ -282,6 +337,10 @@ var fullConfig = EVMConfig{
 				BlockTime:     config.MustNewDuration(42 * time.Second),
 				CustomURL:     config.MustParseURL("http://txs.org"),
 			},
+			KeySelection: KeySelection{
+				SkipLowBalance: ptr(true),
+				MaxUnconfirmed: ptr[uint32](7),
+			},
 		},
 
 		HeadTracker: HeadTracker{
@@ -305,6 +364,11 @@ var fullConfig = EVMConfig{
 			DeathDeclarationDelay:      config.MustNewDuration(time.Minute),
 			VerifyChainID:              ptr(true),
 			NewHeadsPollInterval:       config.MustNewDuration(0),
+			Hedging: Hedging{
+				Enabled:           ptr(true),
+				Delay:             config.MustNewDuration(200 * time.Millisecond),
+				PreferFastestNode: ptr(true),
+			},
 			Errors: ClientErrors{
 				NonceTooLow:                       ptr[string]("(: |^)nonce too low"),
 				NonceTooHigh:                      ptr[string]("(: |^)nonce too high"),
@@ -321,6 +385,7 @@ var fullConfig = EVMConfig{
 				Fatal:                             ptr[string]("(: |^)fatal"),
 				ServiceUnavailable:                ptr[string]("(: |^)service unavailable"),
 				TooManyResults:                    ptr[string]("(: |^)too many results"),
+				CatalogFile:                       ptr[string]("/etc/chainlink/errors.toml"),
 			},
 		},
 		OCR: OCR{
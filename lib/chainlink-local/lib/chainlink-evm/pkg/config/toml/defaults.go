@@ -218,6 +218,9 @@ func (c *Chain) SetFrom(f *Chain) {
 	if v := f.LogBackfillBatchSize; v != nil {
 		c.LogBackfillBatchSize = v
 	}
+	if v := f.LogBackfillConcurrency; v != nil {
+		c.LogBackfillConcurrency = v
+	}
 	if v := f.LogPollInterval; v != nil {
 		c.LogPollInterval = v
 	}
@@ -272,6 +275,8 @@ func (c *Chain) SetFrom(f *Chain) {
 				c.KeySpecific = append(c.KeySpecific, v)
 			} else {
 				c.KeySpecific[i].GasEstimator.setFrom(&v.GasEstimator)
+				c.KeySpecific[i].BalanceMonitor.setFrom(&v.BalanceMonitor)
+				c.KeySpecific[i].Transactions.setFrom(&v.Transactions)
 			}
 		}
 	}
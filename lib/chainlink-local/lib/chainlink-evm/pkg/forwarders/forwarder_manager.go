@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"slices"
-	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -42,24 +41,34 @@ type FwdMgr struct {
 	logger    logger.SugaredLogger
 	logpoller evmlogpoller.LogPoller
 
-	// TODO(samhassan): sendersCache should be an LRU capped cache
-	// https://smartcontract-it.atlassian.net/browse/ARCHIVE-22505
-	sendersCache map[common.Address][]common.Address
+	sendersCache *sendersCache
 	latestBlock  int64
 
 	authRcvr    authorized_receiver.AuthorizedReceiverInterface
 	offchainAgg offchain_aggregator_wrapper.OffchainAggregatorInterface
+}
+
+// Option configures the FwdMgr returned by NewFwdMgr.
+type Option func(*FwdMgr)
 
-	cacheMu sync.RWMutex
+// WithSendersCache sets the maximum number of forwarders whose authorized senders are cached, and the time after which
+// the cached senders of a forwarder are fetched again. The defaults are 1000 forwarders and 1 hour.
+func WithSendersCache(size int, ttl time.Duration) Option {
+	return func(f *FwdMgr) {
+		f.sendersCache = newSendersCache(f.evmClient.ConfiguredChainID().String(), size, ttl)
+	}
 }
 
-func NewFwdMgr(ds sqlutil.DataSource, client evmclient.Client, logpoller evmlogpoller.LogPoller, lggr logger.Logger, cfg Config) *FwdMgr {
+func NewFwdMgr(ds sqlutil.DataSource, client evmclient.Client, logpoller evmlogpoller.LogPoller, lggr logger.Logger, cfg Config, opts ...Option) *FwdMgr {
 	fm := FwdMgr{
 		cfg:          cfg,
 		evmClient:    client,
 		ORM:          NewORM(ds),
 		logpoller:    logpoller,
-		sendersCache: make(map[common.Address][]common.Address),
+		sendersCache: newSendersCache(client.ConfiguredChainID().String(), defaultSendersCacheSize, defaultSendersCacheTTL),
+	}
+	for _, opt := range opts {
+		opt(&fm)
 	}
 	fm.Service, fm.eng = services.Config{
 		Name:  "ForwarderManager",
@@ -71,7 +80,6 @@ func NewFwdMgr(ds sqlutil.DataSource, client evmclient.Client, logpoller evmlogp
 
 func (f *FwdMgr) start(ctx context.Context) error {
 	chainId := f.evmClient.ConfiguredChainID()
-
 	fwdrs, err := f.ORM.FindForwardersByChain(ctx, big.Big(*chainId))
 	if err != nil {
 		return pkgerrors.Wrapf(err, "Failed to retrieve forwarders for chain %d", chainId)
@@ -212,8 +220,9 @@ func (f *FwdMgr) getAuthorizedSenders(ctx context.Context, addr common.Address)
 	return senders, nil
 }
 
+// initForwardersCache warms up the cache with the senders of the forwarders of the ORM, up to the cache size.
 func (f *FwdMgr) initForwardersCache(ctx context.Context, fwdrs []Forwarder) {
-	for _, fwdr := range fwdrs {
+	for _, fwdr := range fwdrs[:min(len(fwdrs), f.sendersCache.size)] {
 		senders, err := f.getAuthorizedSenders(ctx, fwdr.Address)
 		if err != nil {
 			f.logger.Warnw("Failed to call getAuthorizedSenders on forwarder", "forwarder", fwdr.Address, "err", err)
@@ -249,16 +258,11 @@ func (f *FwdMgr) subscribeSendersChangedLogs(ctx context.Context, addr common.Ad
 }
 
 func (f *FwdMgr) setCachedSenders(addr common.Address, senders []common.Address) {
-	f.cacheMu.Lock()
-	defer f.cacheMu.Unlock()
-	f.sendersCache[addr] = senders
+	f.sendersCache.set(addr, senders)
 }
 
 func (f *FwdMgr) getCachedSenders(addr common.Address) ([]common.Address, bool) {
-	f.cacheMu.RLock()
-	defer f.cacheMu.RUnlock()
-	addrs, ok := f.sendersCache[addr]
-	return addrs, ok
+	return f.sendersCache.get(addr)
 }
 
 func (f *FwdMgr) runLoop(ctx context.Context) {
@@ -334,10 +338,5 @@ func (f *FwdMgr) handleAuthChange(log evmlogpoller.Log) error {
 }
 
 func (f *FwdMgr) collectAddresses() (addrs []common.Address) {
-	f.cacheMu.RLock()
-	defer f.cacheMu.RUnlock()
-	for addr := range f.sendersCache {
-		addrs = append(addrs, addr)
-	}
-	return
+	return f.sendersCache.addresses()
 }
@@ -0,0 +1,99 @@
+package forwarders
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	promSendersCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "forwarder_manager_senders_cache_hits",
+		Help: "Number of lookups of forwarder authorized senders served from the cache",
+	}, []string{"evmChainID"})
+	promSendersCacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "forwarder_manager_senders_cache_misses",
+		Help: "Number of lookups of forwarder authorized senders missing from the cache, or expired",
+	}, []string{"evmChainID"})
+	promSendersCacheEvictions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "forwarder_manager_senders_cache_evictions",
+		Help: "Number of forwarders evicted from the authorized senders cache because it was full",
+	}, []string{"evmChainID"})
+	promSendersCacheSize = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "forwarder_manager_senders_cache_size",
+		Help: "Number of forwarders in the authorized senders cache",
+	}, []string{"evmChainID"})
+)
+
+const (
+	// defaultSendersCacheSize is the maximum number of forwarders whose authorized senders are cached.
+	defaultSendersCacheSize = 1000
+	// defaultSendersCacheTTL is the time after which the cached authorized senders of a forwarder are fetched again,
+	// in case an AuthorizedSendersChanged log was missed.
+	defaultSendersCacheTTL = time.Hour
+)
+
+type sendersCacheEntry struct {
+	senders   []common.Address
+	fetchedAt time.Time
+}
+
+// sendersCache is a capped LRU cache of the authorized senders of each forwarder, with a TTL.
+type sendersCache struct {
+	chainID string
+	size    int
+	ttl     time.Duration
+	now     func() time.Time
+
+	mu  sync.Mutex
+	lru lru.BasicLRU[common.Address, sendersCacheEntry]
+}
+
+func newSendersCache(chainID string, size int, ttl time.Duration) *sendersCache {
+	return &sendersCache{
+		chainID: chainID,
+		size:    size,
+		ttl:     ttl,
+		now:     time.Now,
+		lru:     lru.NewBasicLRU[common.Address, sendersCacheEntry](size),
+	}
+}
+
+// get returns the cached senders of the forwarder, and false if they are missing or expired.
+func (c *sendersCache) get(addr common.Address) ([]common.Address, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.lru.Get(addr)
+	if ok && c.now().Sub(e.fetchedAt) >= c.ttl {
+		c.lru.Remove(addr)
+		promSendersCacheSize.WithLabelValues(c.chainID).Set(float64(c.lru.Len()))
+		ok = false
+	}
+	if !ok {
+		promSendersCacheMisses.WithLabelValues(c.chainID).Inc()
+		return nil, false
+	}
+	promSendersCacheHits.WithLabelValues(c.chainID).Inc()
+	return e.senders, true
+}
+
+// set caches the senders of the forwarder, evicting the least recently used forwarder if the cache is full.
+func (c *sendersCache) set(addr common.Address, senders []common.Address) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.lru.Add(addr, sendersCacheEntry{senders: senders, fetchedAt: c.now()}) {
+		promSendersCacheEvictions.WithLabelValues(c.chainID).Inc()
+	}
+	promSendersCacheSize.WithLabelValues(c.chainID).Set(float64(c.lru.Len()))
+}
+
+// addresses returns the cached forwarders, including the expired ones.
+func (c *sendersCache) addresses() []common.Address {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Keys()
+}
@@ -0,0 +1,65 @@
+package forwarders
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+)
+
+func TestSendersCache(t *testing.T) {
+	t.Parallel()
+
+	chainID := testutils.NewRandomEVMChainID().String()
+	c := newSendersCache(chainID, 2, time.Minute)
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	f0, f1, f2 := testutils.NewAddress(), testutils.NewAddress(), testutils.NewAddress()
+	senders := []common.Address{testutils.NewAddress()}
+
+	_, ok := c.get(f0)
+	require.False(t, ok)
+	c.set(f0, senders)
+	got, ok := c.get(f0)
+	require.True(t, ok)
+	assert.Equal(t, senders, got)
+
+	t.Run("evicts the least recently used forwarder", func(t *testing.T) {
+		c.set(f1, senders)
+		_, ok = c.get(f0)
+		require.True(t, ok)
+		c.set(f2, senders)
+		_, ok = c.get(f1)
+		assert.False(t, ok)
+		assert.ElementsMatch(t, []common.Address{f0, f2}, c.addresses())
+	})
+
+	t.Run("updates forwarders", func(t *testing.T) {
+		updated := []common.Address{testutils.NewAddress()}
+		c.set(f2, updated)
+		got, ok = c.get(f2)
+		require.True(t, ok)
+		assert.Equal(t, updated, got)
+		assert.ElementsMatch(t, []common.Address{f0, f2}, c.addresses())
+	})
+
+	t.Run("expires forwarders", func(t *testing.T) {
+		now = now.Add(time.Minute)
+		_, ok = c.get(f0)
+		assert.False(t, ok)
+		_, ok = c.get(f2)
+		assert.False(t, ok)
+		assert.Empty(t, c.addresses())
+	})
+
+	assert.InDelta(t, 3, testutil.ToFloat64(promSendersCacheHits.WithLabelValues(chainID)), 0)
+	assert.InDelta(t, 4, testutil.ToFloat64(promSendersCacheMisses.WithLabelValues(chainID)), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(promSendersCacheEvictions.WithLabelValues(chainID)), 0)
+	assert.InDelta(t, 0, testutil.ToFloat64(promSendersCacheSize.WithLabelValues(chainID)), 0)
+}
@@ -22,6 +22,7 @@ type ArbConfig interface {
 	LimitMax() uint64
 	BumpPercent() uint16
 	BumpMin() *assets.Wei
+	EIP1559DynamicFees() bool
 }
 
 // arbitrumEstimator is an Estimator which extends SuggestedPriceEstimator to use getPricesInArbGas() for gas limit estimation.
@@ -42,6 +42,10 @@ func (a *arbConfig) BumpMin() *assets.Wei {
 	return a.bumpMin
 }
 
+func (a *arbConfig) EIP1559DynamicFees() bool {
+	return false
+}
+
 func TestArbitrumEstimator(t *testing.T) {
 	t.Parallel()
 
@@ -178,17 +182,17 @@ func TestArbitrumEstimator(t *testing.T) {
 		assert.EqualError(t, err, "failed to estimate gas; gas price not set")
 	})
 
-	t.Run("calling GetDynamicFee always returns error", func(t *testing.T) {
+	t.Run("calling GetDynamicFee with EIP1559 disabled returns error", func(t *testing.T) {
 		feeEstimatorClient := mocks.NewFeeEstimatorClient(t)
 		l1Oracle, err := rollups.NewArbitrumL1GasOracle(logger.Test(t), feeEstimatorClient)
 		require.NoError(t, err)
 
 		o := gas.NewArbitrumEstimator(logger.Test(t), &arbConfig{}, feeEstimatorClient, l1Oracle)
 		_, err = o.GetDynamicFee(tests.Context(t), maxGasPrice)
-		assert.EqualError(t, err, "dynamic fees are not implemented for this estimator")
+		assert.EqualError(t, err, "can't get dynamic fee, EIP1559 is disabled")
 	})
 
-	t.Run("calling BumpDynamicFee always returns error", func(t *testing.T) {
+	t.Run("calling BumpDynamicFee with EIP1559 disabled returns error", func(t *testing.T) {
 		feeEstimatorClient := mocks.NewFeeEstimatorClient(t)
 		l1Oracle, err := rollups.NewArbitrumL1GasOracle(logger.Test(t), feeEstimatorClient)
 		require.NoError(t, err)
@@ -199,7 +203,7 @@ func TestArbitrumEstimator(t *testing.T) {
 			GasTipCap: assets.NewWeiI(5),
 		}
 		_, err = o.BumpDynamicFee(tests.Context(t), fee, maxGasPrice, nil)
-		assert.EqualError(t, err, "dynamic fees are not implemented for this estimator")
+		assert.EqualError(t, err, "can't bump dynamic fee, EIP1559 is disabled")
 	})
 
 	t.Run("limit computes", func(t *testing.T) {
@@ -2858,6 +2858,90 @@ func TestBlockHistoryEstimator_CheckInclusionPercentile_Calculation(t *testing.T
 	})
 }
 
+func TestBlockHistoryEstimator_PredictFees(t *testing.T) {
+	t.Parallel()
+
+	maxPrice := assets.NewWeiI(1000)
+	speeds := []string{"NextBlock", "Fast", "Standard"}
+
+	newEstimator := func(t *testing.T, eip1559 bool) *gas.BlockHistoryEstimator {
+		bhCfg := newBlockHistoryConfig()
+		bhCfg.BlockHistorySizeF = uint16(3)
+
+		geCfg := &gas.MockGasEstimatorConfig{}
+		geCfg.EIP1559DynamicFeesF = eip1559
+		geCfg.PriceMaxF = maxPrice
+		geCfg.PriceMinF = assets.NewWeiI(0)
+		geCfg.TipCapMinF = assets.NewWeiI(0)
+
+		return newBlockHistoryEstimator(t, clienttest.NewClientWithDefaultChainID(t), defaultChainType, geCfg, bhCfg, rollupMocks.NewL1Oracle(t))
+	}
+
+	t.Run("fails if the estimator is not started or has no blocks", func(t *testing.T) {
+		bhe := newEstimator(t, false)
+		_, err := bhe.PredictFees(tests.Context(t), maxPrice)
+		require.ErrorContains(t, err, "not started")
+
+		gas.SimulateStart(t, bhe)
+		_, err = bhe.PredictFees(tests.Context(t), maxPrice)
+		require.ErrorContains(t, err, "no blocks in history")
+	})
+
+	t.Run("predicts legacy gas prices", func(t *testing.T) {
+		bhe := newEstimator(t, false)
+		gas.SimulateStart(t, bhe)
+		gas.SetRollingBlockHistory(bhe, []evmtypes.Block{
+			{Number: 0, Hash: utils.NewHash(), Transactions: legacyTransactionsFromGasPrices(10, 20, 30, 40)},
+			{Number: 1, Hash: utils.NewHash(), Transactions: legacyTransactionsFromGasPrices(20, 30, 40, 50)},
+			{Number: 2, Hash: utils.NewHash(), Transactions: legacyTransactionsFromGasPrices(30, 40, 60, 70)},
+		})
+
+		predictions, err := bhe.PredictFees(tests.Context(t), maxPrice)
+		require.NoError(t, err)
+		require.Len(t, predictions, 3)
+		for i, name := range speeds {
+			assert.Equal(t, name, predictions[i].Name)
+			assert.False(t, predictions[i].Fee.ValidDynamic())
+		}
+		// The 85th percentile of the history is above the 85th percentile of the last block
+		assert.Equal(t, assets.NewWeiI(50), predictions[0].Fee.GasPrice)
+		assert.InDelta(t, 2.0/3, predictions[0].Confidence, 0.001)
+		assert.Equal(t, assets.NewWeiI(40), predictions[1].Fee.GasPrice)
+		assert.InDelta(t, 1, predictions[1].Confidence, 0.001)
+		assert.Equal(t, assets.NewWeiI(30), predictions[2].Fee.GasPrice)
+		assert.InDelta(t, 1, predictions[2].Confidence, 0.001)
+
+		predictions, err = bhe.PredictFees(tests.Context(t), assets.NewWeiI(35))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(35), predictions[0].Fee.GasPrice)
+		assert.Equal(t, assets.NewWeiI(30), predictions[2].Fee.GasPrice)
+	})
+
+	t.Run("predicts dynamic fees", func(t *testing.T) {
+		bhe := newEstimator(t, true)
+		gas.SimulateStart(t, bhe)
+		h := testutils.Head(3)
+		h.BaseFeePerGas = assets.NewWeiI(100)
+		bhe.OnNewLongestChain(tests.Context(t), h)
+		gas.SetRollingBlockHistory(bhe, []evmtypes.Block{
+			{Number: 0, Hash: utils.NewHash(), BaseFeePerGas: assets.NewWeiI(100), Transactions: dynamicFeeTransactionsFromTipCaps(1, 2, 3, 4)},
+			{Number: 1, Hash: utils.NewHash(), BaseFeePerGas: assets.NewWeiI(100), Transactions: dynamicFeeTransactionsFromTipCaps(2, 3, 4, 5)},
+			{Number: 2, Hash: utils.NewHash(), BaseFeePerGas: assets.NewWeiI(100), Transactions: dynamicFeeTransactionsFromTipCaps(3, 4, 6, 7)},
+		})
+
+		predictions, err := bhe.PredictFees(tests.Context(t), maxPrice)
+		require.NoError(t, err)
+		require.Len(t, predictions, 3)
+		// Fee caps add the base fee increased by 12.5% for each target block
+		assert.Equal(t, gas.DynamicFee{GasFeeCap: assets.NewWeiI(117), GasTipCap: assets.NewWeiI(5)}, predictions[0].Fee.DynamicFee)
+		assert.InDelta(t, 2.0/3, predictions[0].Confidence, 0.001)
+		assert.Equal(t, gas.DynamicFee{GasFeeCap: assets.NewWeiI(146), GasTipCap: assets.NewWeiI(4)}, predictions[1].Fee.DynamicFee)
+		assert.InDelta(t, 1, predictions[1].Confidence, 0.001)
+		assert.Equal(t, gas.DynamicFee{GasFeeCap: assets.NewWeiI(327), GasTipCap: assets.NewWeiI(3)}, predictions[2].Fee.DynamicFee)
+		assert.InDelta(t, 1, predictions[2].Confidence, 0.001)
+	})
+}
+
 // ptr takes pointer of anything
 func ptr[T any](v T) *T {
 	return &v
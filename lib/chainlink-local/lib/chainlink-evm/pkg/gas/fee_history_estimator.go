@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 	"strconv"
 	"sync"
 	"time"
@@ -89,6 +90,11 @@ type FeeHistoryEstimator struct {
 	priorityFeeThresholdMu sync.RWMutex
 	priorityFeeThreshold   *assets.Wei
 
+	// percentiles are the reward percentiles requested with eth_feeHistory, in ascending order
+	percentiles  []float64
+	feeHistoryMu sync.RWMutex
+	feeHistory   *ethereum.FeeHistory
+
 	l1Oracle rollups.L1Oracle
 
 	wg        *sync.WaitGroup
@@ -98,14 +104,15 @@ type FeeHistoryEstimator struct {
 
 func NewFeeHistoryEstimator(lggr logger.Logger, client feeHistoryEstimatorClient, cfg FeeHistoryEstimatorConfig, chainID *big.Int, l1Oracle rollups.L1Oracle) *FeeHistoryEstimator {
 	return &FeeHistoryEstimator{
-		client:    client,
-		logger:    logger.Named(lggr, "FeeHistoryEstimator"),
-		config:    cfg,
-		chainID:   chainID,
-		l1Oracle:  l1Oracle,
-		wg:        new(sync.WaitGroup),
-		stopCh:    make(chan struct{}),
-		refreshCh: make(chan struct{}),
+		client:      client,
+		logger:      logger.Named(lggr, "FeeHistoryEstimator"),
+		config:      cfg,
+		chainID:     chainID,
+		l1Oracle:    l1Oracle,
+		percentiles: feeHistoryPercentiles(cfg.RewardPercentile),
+		wg:          new(sync.WaitGroup),
+		stopCh:      make(chan struct{}),
+		refreshCh:   make(chan struct{}),
 	}
 }
 
@@ -161,6 +168,17 @@ func (f *FeeHistoryEstimator) run() {
 	}
 }
 
+// feeHistoryPercentiles returns RewardPercentile, ConnectivityPercentile and the percentiles of FeePredictionSpeeds in
+// ascending order, as required by eth_feeHistory, so that the fee predictions use the fee history of the last refresh.
+func feeHistoryPercentiles(rewardPercentile float64) []float64 {
+	percentiles := []float64{rewardPercentile, ConnectivityPercentile}
+	for _, speed := range FeePredictionSpeeds {
+		percentiles = append(percentiles, float64(speed.Percentile))
+	}
+	slices.Sort(percentiles)
+	return slices.Compact(percentiles)
+}
+
 // GetLegacyGas will fetch the cached gas price value.
 func (f *FeeHistoryEstimator) GetLegacyGas(ctx context.Context, _ []byte, gasLimit uint64, maxPrice *assets.Wei, opts ...fees.Opt) (gasPrice *assets.Wei, chainSpecificGasLimit uint64, err error) {
 	chainSpecificGasLimit = gasLimit
@@ -235,10 +253,16 @@ func (f *FeeHistoryEstimator) RefreshDynamicPrice() error {
 	defer cancel()
 
 	// RewardPercentile will be used for maxPriorityFeePerGas estimations and connectivityPercentile to set the highest threshold for bumping.
-	feeHistory, err := f.client.FeeHistory(ctx, max(f.config.BlockHistorySize, 1), nil, []float64{f.config.RewardPercentile, ConnectivityPercentile})
+	// The other percentiles are cached for PredictFees.
+	feeHistory, err := f.client.FeeHistory(ctx, max(f.config.BlockHistorySize, 1), nil, f.percentiles)
 	if err != nil {
 		return err
 	}
+	f.feeHistoryMu.Lock()
+	f.feeHistory = feeHistory
+	f.feeHistoryMu.Unlock()
+	rewardIdx := slices.Index(f.percentiles, f.config.RewardPercentile)
+	connectivityIdx := slices.Index(f.percentiles, ConnectivityPercentile)
 
 	// eth_feeHistory doesn't return the latest baseFee of the range but rather the latest + 1, because it can be derived from the existing
 	// values. Source: https://github.com/ethereum/go-ethereum/blob/b0f66e34ca2a4ea7ae23475224451c8c9a569826/eth/gasprice/feehistory.go#L235
@@ -256,19 +280,19 @@ func (f *FeeHistoryEstimator) RefreshDynamicPrice() error {
 		priorityFee := big.NewInt(0)
 		priorityFeeThreshold := big.NewInt(0)
 		for _, reward := range feeHistory.Reward {
-			// reward needs to have values for two percentiles. Some chains may return an empty slice instead of 0x0 values, so we use
+			// reward needs to have values for all the percentiles. Some chains may return an empty slice instead of 0x0 values, so we use
 			// continue instead of throwing an error.
-			if len(reward) < 2 {
+			if len(reward) < len(f.percentiles) {
 				continue
 			}
 			// We'll calculate the average of non-zero priority fees
-			if reward[0].Cmp(big.NewInt(0)) > 0 {
-				priorityFee = priorityFee.Add(priorityFee, reward[0])
+			if reward[rewardIdx].Cmp(big.NewInt(0)) > 0 {
+				priorityFee = priorityFee.Add(priorityFee, reward[rewardIdx])
 				nonZeroRewardsLen++
 			}
 			// We take the max value for the bumping threshold
-			if reward[1].Cmp(big.NewInt(0)) > 0 {
-				priorityFeeThreshold = bigmath.Max(priorityFeeThreshold, reward[1])
+			if reward[connectivityIdx].Cmp(big.NewInt(0)) > 0 {
+				priorityFeeThreshold = bigmath.Max(priorityFeeThreshold, reward[connectivityIdx])
 			}
 		}
 
@@ -426,6 +450,15 @@ func LimitBumpedFee(originalFee *assets.Wei, currentFee *assets.Wei, bumpedFee *
 	return bumpedFee, nil
 }
 
+func (f *FeeHistoryEstimator) getFeeHistory() (*ethereum.FeeHistory, error) {
+	f.feeHistoryMu.RLock()
+	defer f.feeHistoryMu.RUnlock()
+	if f.feeHistory == nil {
+		return nil, errors.New("fee history not set")
+	}
+	return f.feeHistory, nil
+}
+
 func (f *FeeHistoryEstimator) getPriorityFeeThreshold() (*assets.Wei, error) {
 	f.priorityFeeThresholdMu.RLock()
 	defer f.priorityFeeThresholdMu.RUnlock()
@@ -226,7 +226,7 @@ func TestFeeHistoryEstimatorGetDynamicFee(t *testing.T) {
 
 		feeHistoryResult := &ethereum.FeeHistory{
 			OldestBlock:  big.NewInt(1),
-			Reward:       [][]*big.Int{{maxPriorityFeePerGas1, big.NewInt(5)}, {maxPriorityFeePerGas2, big.NewInt(5)}, {}}, // first one represents market price and second one connectivity price
+			Reward:       [][]*big.Int{{maxPriorityFeePerGas1, big.NewInt(0), big.NewInt(0), big.NewInt(5)}, {maxPriorityFeePerGas2, big.NewInt(0), big.NewInt(0), big.NewInt(5)}, {}}, // first one represents market price and last one connectivity price
 			BaseFee:      []*big.Int{baseFee, baseFee},
 			GasUsedRatio: nil,
 		}
@@ -265,7 +265,7 @@ func TestFeeHistoryEstimatorGetDynamicFee(t *testing.T) {
 
 		feeHistoryResult := &ethereum.FeeHistory{
 			OldestBlock:  big.NewInt(1),
-			Reward:       [][]*big.Int{{maxPriorityFeePerGas, big.NewInt(5)}}, // first one represents market price and second one connectivity price
+			Reward:       [][]*big.Int{{maxPriorityFeePerGas, big.NewInt(0), big.NewInt(0), big.NewInt(5)}}, // first one represents market price and last one connectivity price
 			BaseFee:      []*big.Int{baseFee},
 			GasUsedRatio: nil,
 		}
@@ -299,7 +299,7 @@ func TestFeeHistoryEstimatorBumpDynamicFee(t *testing.T) {
 		// These values will be ignored because they are lower prices than the originalFee
 		feeHistoryResult := &ethereum.FeeHistory{
 			OldestBlock:  big.NewInt(1),
-			Reward:       [][]*big.Int{{big.NewInt(5), big.NewInt(50)}}, // first one represents market price and second one connectivity price
+			Reward:       [][]*big.Int{{big.NewInt(5), big.NewInt(0), big.NewInt(0), big.NewInt(50)}}, // first one represents market price and last one connectivity price
 			BaseFee:      []*big.Int{big.NewInt(5)},
 			GasUsedRatio: nil,
 		}
@@ -362,7 +362,7 @@ func TestFeeHistoryEstimatorBumpDynamicFee(t *testing.T) {
 		maxPriorityFeePerGas := big.NewInt(33)
 		feeHistoryResult := &ethereum.FeeHistory{
 			OldestBlock:  big.NewInt(1),
-			Reward:       [][]*big.Int{{maxPriorityFeePerGas, big.NewInt(100)}}, // first one represents market price and second one connectivity price
+			Reward:       [][]*big.Int{{maxPriorityFeePerGas, big.NewInt(0), big.NewInt(0), big.NewInt(100)}}, // first one represents market price and last one connectivity price
 			BaseFee:      []*big.Int{baseFee},
 			GasUsedRatio: nil,
 		}
@@ -396,7 +396,7 @@ func TestFeeHistoryEstimatorBumpDynamicFee(t *testing.T) {
 		maxPriorityFeePerGas := big.NewInt(33)
 		feeHistoryResult := &ethereum.FeeHistory{
 			OldestBlock:  big.NewInt(1),
-			Reward:       [][]*big.Int{{maxPriorityFeePerGas, big.NewInt(30)}}, // first one represents market price and second one connectivity price
+			Reward:       [][]*big.Int{{maxPriorityFeePerGas, big.NewInt(0), big.NewInt(0), big.NewInt(30)}}, // first one represents market price and last one connectivity price
 			BaseFee:      []*big.Int{baseFee},
 			GasUsedRatio: nil,
 		}
@@ -428,7 +428,7 @@ func TestFeeHistoryEstimatorBumpDynamicFee(t *testing.T) {
 		maxPriorityFeePerGas := big.NewInt(1)
 		feeHistoryResult := &ethereum.FeeHistory{
 			OldestBlock:  big.NewInt(1),
-			Reward:       [][]*big.Int{{maxPriorityFeePerGas, big.NewInt(30)}}, // first one represents market price and second one connectivity price
+			Reward:       [][]*big.Int{{maxPriorityFeePerGas, big.NewInt(0), big.NewInt(0), big.NewInt(30)}}, // first one represents market price and last one connectivity price
 			BaseFee:      []*big.Int{baseFee},
 			GasUsedRatio: nil,
 		}
@@ -461,7 +461,7 @@ func TestFeeHistoryEstimatorBumpDynamicFee(t *testing.T) {
 		maxPriorityFeePerGas := big.NewInt(1)
 		feeHistoryResult := &ethereum.FeeHistory{
 			OldestBlock:  big.NewInt(1),
-			Reward:       [][]*big.Int{{maxPriorityFeePerGas, big.NewInt(30)}}, // first one represents market price and second one connectivity price
+			Reward:       [][]*big.Int{{maxPriorityFeePerGas, big.NewInt(0), big.NewInt(0), big.NewInt(30)}}, // first one represents market price and last one connectivity price
 			BaseFee:      []*big.Int{baseFee},
 			GasUsedRatio: nil,
 		}
@@ -491,7 +491,7 @@ func TestFeeHistoryEstimatorBumpDynamicFee(t *testing.T) {
 		maxPriorityFeePerGas := big.NewInt(0)
 		feeHistoryResult := &ethereum.FeeHistory{
 			OldestBlock:  big.NewInt(1),
-			Reward:       [][]*big.Int{{maxPriorityFeePerGas, big.NewInt(0)}}, // first one represents market price and second one connectivity price
+			Reward:       [][]*big.Int{{maxPriorityFeePerGas, big.NewInt(0), big.NewInt(0), big.NewInt(0)}}, // first one represents market price and last one connectivity price
 			BaseFee:      []*big.Int{baseFee},
 			GasUsedRatio: nil,
 		}
@@ -513,3 +513,58 @@ func TestFeeHistoryEstimatorBumpDynamicFee(t *testing.T) {
 		assert.Equal(t, maxFeePerGas, bumpedFee.GasFeeCap)
 	})
 }
+
+func TestFeeHistoryEstimatorPredictFees(t *testing.T) {
+	t.Parallel()
+
+	maxPrice := assets.NewWeiI(100)
+	chainID := big.NewInt(0)
+	baseFee := big.NewInt(10)
+	feeHistoryResult := &ethereum.FeeHistory{
+		OldestBlock: big.NewInt(1),
+		// rewards at the 30th, 60th and 85th percentiles
+		Reward:  [][]*big.Int{{big.NewInt(1), big.NewInt(2), big.NewInt(4)}, {big.NewInt(1), big.NewInt(3), big.NewInt(5)}, {big.NewInt(2), big.NewInt(4), big.NewInt(6)}, {big.NewInt(2), big.NewInt(5), big.NewInt(9)}},
+		BaseFee: []*big.Int{baseFee, baseFee, baseFee, baseFee, baseFee},
+	}
+
+	t.Run("predicts dynamic fees for each speed from the cached fee history", func(t *testing.T) {
+		client := mocks.NewFeeHistoryEstimatorClient(t)
+		client.On("FeeHistory", mock.Anything, uint64(4), mock.Anything, []float64{30, 60, 85}).Return(feeHistoryResult, nil).Once()
+
+		cfg := gas.FeeHistoryEstimatorConfig{BlockHistorySize: 4, RewardPercentile: 60, EIP1559: true}
+		u := gas.NewFeeHistoryEstimator(logger.Test(t), client, cfg, chainID, nil)
+		_, err := u.PredictFees(tests.Context(t), maxPrice)
+		assert.ErrorContains(t, err, "fee history not set")
+
+		assert.NoError(t, u.RefreshDynamicPrice())
+		predictions, err := u.PredictFees(tests.Context(t), maxPrice)
+		assert.NoError(t, err)
+		assert.Len(t, predictions, 3)
+
+		// Tips are the average of each percentile, fee caps add the next base fee increased by 12.5% for each target block
+		assert.Equal(t, "NextBlock", predictions[0].Name)
+		assert.Equal(t, gas.DynamicFee{GasFeeCap: assets.NewWeiI(17), GasTipCap: assets.NewWeiI(6)}, predictions[0].Fee.DynamicFee)
+		assert.InDelta(t, 0.75, predictions[0].Confidence, 0.001)
+		assert.Equal(t, "Fast", predictions[1].Name)
+		assert.Equal(t, gas.DynamicFee{GasFeeCap: assets.NewWeiI(17), GasTipCap: assets.NewWeiI(3)}, predictions[1].Fee.DynamicFee)
+		assert.InDelta(t, 1, predictions[1].Confidence, 0.001)
+		assert.Equal(t, "Standard", predictions[2].Name)
+		assert.Equal(t, gas.DynamicFee{GasFeeCap: assets.NewWeiI(33), GasTipCap: assets.NewWeiI(1)}, predictions[2].Fee.DynamicFee)
+		assert.InDelta(t, 1, predictions[2].Confidence, 0.001)
+
+		// fee caps are capped to max price
+		predictions, err = u.PredictFees(tests.Context(t), assets.NewWeiI(20))
+		assert.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(17), predictions[0].Fee.GasFeeCap)
+		assert.Equal(t, assets.NewWeiI(20), predictions[2].Fee.GasFeeCap)
+	})
+
+	t.Run("does not predict legacy gas prices", func(t *testing.T) {
+		client := mocks.NewFeeHistoryEstimatorClient(t)
+
+		cfg := gas.FeeHistoryEstimatorConfig{BlockHistorySize: 4}
+		u := gas.NewFeeHistoryEstimator(logger.Test(t), client, cfg, chainID, nil)
+		_, err := u.PredictFees(tests.Context(t), maxPrice)
+		assert.ErrorIs(t, err, gas.ErrFeePredictionNotSupported)
+	})
+}
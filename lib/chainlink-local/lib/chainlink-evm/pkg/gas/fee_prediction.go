@@ -0,0 +1,239 @@
+package gas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/mathutil"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+)
+
+// ErrFeePredictionNotSupported is returned by PredictFees when the configured estimator can't predict fees.
+var ErrFeePredictionNotSupported = errors.New("fee prediction is not supported by the gas estimator")
+
+// FeeSpeed is a target inclusion speed for which fees are predicted.
+type FeeSpeed struct {
+	Name string
+	// TargetBlocks is the number of blocks within which a transaction paying the predicted fee should be included.
+	TargetBlocks int
+	// Percentile is the percentile of the fees paid in the recent blocks the prediction is based on.
+	Percentile int
+}
+
+// FeePredictionSpeeds are the speeds returned by PredictFees, from the fastest to the slowest.
+var FeePredictionSpeeds = []FeeSpeed{
+	{Name: "NextBlock", TargetBlocks: 1, Percentile: ConnectivityPercentile},
+	{Name: "Fast", TargetBlocks: 3, Percentile: 60},
+	{Name: "Standard", TargetBlocks: 10, Percentile: 30},
+}
+
+// FeePrediction is the fee predicted for a FeeSpeed.
+type FeePrediction struct {
+	FeeSpeed
+	// Fee has GasPrice set for legacy transactions, or DynamicFee for EIP-1559 transactions.
+	Fee EvmFee
+	// Confidence is the share of the windows of TargetBlocks consecutive recent blocks in which the fee would have
+	// been priced at or above the speed percentile in at least one block, from 0 to 1.
+	Confidence float64
+}
+
+// FeePredictor is implemented by the estimators able to predict fees for several inclusion speeds.
+type FeePredictor interface {
+	// PredictFees returns a prediction for each of FeePredictionSpeeds. Fees are capped to maxPrice.
+	PredictFees(ctx context.Context, maxPrice *assets.Wei) ([]FeePrediction, error)
+}
+
+var (
+	_ FeePredictor = (*FeeHistoryEstimator)(nil)
+	_ FeePredictor = (*BlockHistoryEstimator)(nil)
+	_ FeePredictor = (*evmFeeEstimator)(nil)
+)
+
+// blockFees are the fees paid in a recent block, used to compute the confidence of the predictions.
+type blockFees struct {
+	// baseFee is nil for legacy prices which already include it
+	baseFee *assets.Wei
+	// prices has the price paid on top of the base fee at the percentile of each of FeePredictionSpeeds
+	prices []*assets.Wei
+}
+
+// includes returns whether the fee is priced at or above the price of the block at the percentile of the speed.
+// Legacy fees pay their whole gas price as tip.
+func (b blockFees) includes(speed int, fee EvmFee) bool {
+	feeCap, tipCap := fee.GasPrice, fee.GasPrice
+	if fee.ValidDynamic() {
+		feeCap, tipCap = fee.GasFeeCap, fee.GasTipCap
+	}
+	if feeCap == nil {
+		return false
+	}
+	if b.baseFee != nil {
+		if feeCap.Cmp(b.baseFee) < 0 {
+			return false
+		}
+		tipCap = assets.WeiMin(tipCap, feeCap.Sub(b.baseFee))
+	}
+	return tipCap.Cmp(b.prices[speed]) >= 0
+}
+
+// predictionConfidence returns the share of the windows of targetBlocks consecutive blocks in which at least one block
+// includes the fee. A single window is used if there are fewer blocks than targetBlocks.
+func predictionConfidence(blocks []blockFees, speed int, targetBlocks int, fee EvmFee) float64 {
+	if len(blocks) == 0 {
+		return 0
+	}
+	targetBlocks = mathutil.Min(mathutil.Max(targetBlocks, 1), len(blocks))
+	windows := len(blocks) - targetBlocks + 1
+	var included int
+	for start := 0; start < windows; start++ {
+		for _, block := range blocks[start : start+targetBlocks] {
+			if block.includes(speed, fee) {
+				included++
+				break
+			}
+		}
+	}
+	return float64(included) / float64(windows)
+}
+
+// PredictFees uses the eth_feeHistory response cached by the last RefreshDynamicPrice, which includes the
+// maxPriorityFeePerGas at the percentile of each of FeePredictionSpeeds in the past BlockHistorySize blocks. The
+// predicted tip is the average of the non-zero priority fees, like in RefreshDynamicPrice, and the base fee of the next
+// block is increased by up to 12.5% for each block until the target. Legacy gas prices are fetched with eth_gasPrice,
+// so fees can only be predicted with EIP-1559 enabled.
+func (f *FeeHistoryEstimator) PredictFees(_ context.Context, maxPrice *assets.Wei) ([]FeePrediction, error) {
+	if !f.config.EIP1559 {
+		return nil, ErrFeePredictionNotSupported
+	}
+	feeHistory, err := f.getFeeHistory()
+	if err != nil {
+		return nil, fmt.Errorf("FeeHistoryEstimator cannot predict fees: %w", err)
+	}
+	if len(feeHistory.BaseFee) == 0 {
+		return nil, errors.New("fee history returned no base fees")
+	}
+	nextBaseFee := assets.NewWei(feeHistory.BaseFee[len(feeHistory.BaseFee)-1])
+
+	rewardIdxs := make([]int, len(FeePredictionSpeeds))
+	for i, speed := range FeePredictionSpeeds {
+		rewardIdxs[i] = slices.Index(f.percentiles, float64(speed.Percentile))
+	}
+	blocks := make([]blockFees, 0, len(feeHistory.Reward))
+	tipSums := make([]*big.Int, len(FeePredictionSpeeds))
+	tipCounts := make([]int64, len(FeePredictionSpeeds))
+	for i := range tipSums {
+		tipSums[i] = big.NewInt(0)
+	}
+	for j, reward := range feeHistory.Reward {
+		// Some chains may return an empty slice instead of 0x0 values, these blocks are skipped
+		if len(reward) < len(f.percentiles) || j >= len(feeHistory.BaseFee) {
+			continue
+		}
+		block := blockFees{baseFee: assets.NewWei(feeHistory.BaseFee[j]), prices: make([]*assets.Wei, len(FeePredictionSpeeds))}
+		for i := range FeePredictionSpeeds {
+			r := reward[rewardIdxs[i]]
+			block.prices[i] = assets.NewWei(r)
+			if r.Sign() > 0 {
+				tipSums[i].Add(tipSums[i], r)
+				tipCounts[i]++
+			}
+		}
+		blocks = append(blocks, block)
+	}
+
+	predictions := make([]FeePrediction, len(FeePredictionSpeeds))
+	for i, speed := range FeePredictionSpeeds {
+		tipCap := assets.NewWeiI(0)
+		if tipCounts[i] > 0 {
+			tipCap = assets.NewWei(new(big.Int).Div(tipSums[i], big.NewInt(tipCounts[i])))
+		}
+		tipCap = assets.WeiMin(tipCap, maxPrice)
+		fee := EvmFee{DynamicFee: DynamicFee{GasFeeCap: calcFeeCap(nextBaseFee, speed.TargetBlocks, tipCap, maxPrice), GasTipCap: tipCap}}
+		predictions[i] = FeePrediction{FeeSpeed: speed, Fee: fee, Confidence: predictionConfidence(blocks, i, speed.TargetBlocks, fee)}
+	}
+
+	f.logger.Debugw("Predicted fees", "oldestBlock", feeHistory.OldestBlock, "nextBaseFee", nextBaseFee, "predictions", predictions)
+	return predictions, nil
+}
+
+// PredictFees uses the transactions of the blocks in history to predict the gas price, or tip cap, at the percentile of
+// each of FeePredictionSpeeds. EIP-1559 fee caps add to the tip cap the latest base fee increased by up to 12.5% for
+// each block until the target. Prices are bound by EVM.GasEstimator.PriceMin/TipCapMin and PriceMax like the gas
+// price and tip cap of the estimator.
+func (b *BlockHistoryEstimator) PredictFees(_ context.Context, maxPrice *assets.Wei) ([]FeePrediction, error) {
+	if err := b.Ready(); err != nil {
+		return nil, fmt.Errorf("BlockHistoryEstimator is not started; cannot predict fees: %w", err)
+	}
+	blockHistory := b.getBlocks()
+	if len(blockHistory) == 0 {
+		return nil, errors.New("BlockHistoryEstimator has no blocks in history; cannot predict fees")
+	}
+	blockRange := mathutil.Min(len(blockHistory), int(b.bhConfig.BlockHistorySize()))
+	blockHistory = blockHistory[len(blockHistory)-blockRange:]
+
+	eip1559 := b.eConfig.EIP1559DynamicFees()
+	maxPrice = assets.WeiMin(maxPrice, b.eConfig.PriceMax())
+	baseFee := b.getCurrentBaseFee()
+	if eip1559 && baseFee == nil {
+		return nil, errors.New("BlockHistoryEstimator: no value for latest block base fee; cannot predict EIP-1559 fees")
+	}
+
+	blocks := make([]blockFees, len(blockHistory))
+	for j, block := range blockHistory {
+		blocks[j].prices = make([]*assets.Wei, len(FeePredictionSpeeds))
+		if eip1559 {
+			blocks[j].baseFee = block.BaseFeePerGas
+		}
+		for i, speed := range FeePredictionSpeeds {
+			// Blocks without usable transactions would have included any fee
+			blocks[j].prices[i] = assets.NewWeiI(0)
+			gasPrice, tipCap, err := b.calculatePercentilePrices([]evmtypes.Block{block}, speed.Percentile, eip1559, nil, nil)
+			if err != nil {
+				continue
+			}
+			if eip1559 {
+				blocks[j].prices[i] = tipCap
+			} else {
+				blocks[j].prices[i] = gasPrice
+			}
+		}
+	}
+
+	predictions := make([]FeePrediction, len(FeePredictionSpeeds))
+	for i, speed := range FeePredictionSpeeds {
+		var fee EvmFee
+		gasPrice, tipCap, err := b.calculatePercentilePrices(blockHistory, speed.Percentile, eip1559, nil, nil)
+		if eip1559 {
+			if err != nil {
+				tipCap = b.eConfig.TipCapDefault()
+			}
+			tipCap = assets.WeiMin(assets.WeiMax(tipCap, b.eConfig.TipCapMin()), maxPrice)
+			fee.DynamicFee = DynamicFee{
+				GasFeeCap: calcFeeCap(baseFee, speed.TargetBlocks, tipCap, maxPrice),
+				GasTipCap: tipCap,
+			}
+		} else {
+			if err != nil {
+				gasPrice = b.eConfig.PriceDefault()
+			}
+			fee.GasPrice = assets.WeiMin(assets.WeiMax(gasPrice, b.eConfig.PriceMin()), maxPrice)
+		}
+		predictions[i] = FeePrediction{FeeSpeed: speed, Fee: fee, Confidence: predictionConfidence(blocks, i, speed.TargetBlocks, fee)}
+	}
+	return predictions, nil
+}
+
+// PredictFees returns the fee predictions of the underlying estimator, or ErrFeePredictionNotSupported if it doesn't
+// implement FeePredictor.
+func (e *evmFeeEstimator) PredictFees(ctx context.Context, maxFeePrice *assets.Wei) ([]FeePrediction, error) {
+	predictor, ok := e.EvmEstimator.(FeePredictor)
+	if !ok {
+		return nil, ErrFeePredictionNotSupported
+	}
+	return predictor.PredictFees(ctx, maxFeePrice)
+}
@@ -482,6 +482,65 @@ func (_c *EvmFeeEstimator_OnNewLongestChain_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// PredictFees provides a mock function with given fields: ctx, maxPrice
+func (_m *EvmFeeEstimator) PredictFees(ctx context.Context, maxPrice *assets.Wei) ([]gas.FeePrediction, error) {
+	ret := _m.Called(ctx, maxPrice)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PredictFees")
+	}
+
+	var r0 []gas.FeePrediction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *assets.Wei) ([]gas.FeePrediction, error)); ok {
+		return rf(ctx, maxPrice)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *assets.Wei) []gas.FeePrediction); ok {
+		r0 = rf(ctx, maxPrice)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]gas.FeePrediction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *assets.Wei) error); ok {
+		r1 = rf(ctx, maxPrice)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmFeeEstimator_PredictFees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PredictFees'
+type EvmFeeEstimator_PredictFees_Call struct {
+	*mock.Call
+}
+
+// PredictFees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - maxPrice *assets.Wei
+func (_e *EvmFeeEstimator_Expecter) PredictFees(ctx interface{}, maxPrice interface{}) *EvmFeeEstimator_PredictFees_Call {
+	return &EvmFeeEstimator_PredictFees_Call{Call: _e.mock.On("PredictFees", ctx, maxPrice)}
+}
+
+func (_c *EvmFeeEstimator_PredictFees_Call) Run(run func(ctx context.Context, maxPrice *assets.Wei)) *EvmFeeEstimator_PredictFees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*assets.Wei))
+	})
+	return _c
+}
+
+func (_c *EvmFeeEstimator_PredictFees_Call) Return(_a0 []gas.FeePrediction, _a1 error) *EvmFeeEstimator_PredictFees_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmFeeEstimator_PredictFees_Call) RunAndReturn(run func(context.Context, *assets.Wei) ([]gas.FeePrediction, error)) *EvmFeeEstimator_PredictFees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Ready provides a mock function with no fields
 func (_m *EvmFeeEstimator) Ready() error {
 	ret := _m.Called()
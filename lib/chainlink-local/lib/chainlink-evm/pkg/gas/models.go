@@ -39,6 +39,8 @@ type EvmFeeEstimator interface {
 
 	// GetMaxCost returns the total value = max price x fee units + transferred value
 	GetMaxCost(ctx context.Context, amount assets.Eth, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...fees.Opt) (*big.Int, error)
+	// PredictFees returns the fee predictions of the estimator, or ErrFeePredictionNotSupported if it can't predict fees.
+	PredictFees(ctx context.Context, maxFeePrice *assets.Wei) ([]FeePrediction, error)
 }
 
 type feeEstimatorClient interface {
@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -166,6 +167,29 @@ func TestWrappedEvmEstimator(t *testing.T) {
 		assert.Equal(t, new(big.Int).Add(val.ToInt(), fee), total)
 	})
 
+	t.Run("PredictFees", func(t *testing.T) {
+		lggr := logger.Test(t)
+
+		// the mock estimator can't predict fees
+		estimator := gas.NewEvmFeeEstimator(lggr, getRootEst, true, geCfg, nil)
+		_, err := estimator.PredictFees(ctx, nil)
+		require.ErrorIs(t, err, gas.ErrFeePredictionNotSupported)
+
+		feeHistoryClient := mocks.NewFeeHistoryEstimatorClient(t)
+		feeHistoryClient.On("FeeHistory", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&ethereum.FeeHistory{
+			OldestBlock: big.NewInt(1),
+			Reward:      [][]*big.Int{{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4)}},
+			BaseFee:     []*big.Int{big.NewInt(10), big.NewInt(10)},
+		}, nil).Once()
+		feeHistoryEstimator := gas.NewFeeHistoryEstimator(lggr, feeHistoryClient, gas.FeeHistoryEstimatorConfig{BlockHistorySize: 1, EIP1559: true}, big.NewInt(0), nil)
+		require.NoError(t, feeHistoryEstimator.RefreshDynamicPrice())
+		estimator = gas.NewEvmFeeEstimator(lggr, func(logger.Logger) gas.EvmEstimator { return feeHistoryEstimator }, true, geCfg, nil)
+		predictions, err := estimator.PredictFees(ctx, assets.NewWeiI(100))
+		require.NoError(t, err)
+		require.Len(t, predictions, len(gas.FeePredictionSpeeds))
+		assert.Equal(t, assets.NewWeiI(4), predictions[0].Fee.GasTipCap)
+	})
+
 	t.Run("Name", func(t *testing.T) {
 		lggr := logger.Test(t)
 
@@ -28,6 +28,7 @@ var (
 type suggestedPriceConfig interface {
 	BumpPercent() uint16
 	BumpMin() *assets.Wei
+	EIP1559DynamicFees() bool
 }
 
 type suggestedPriceEstimatorClient interface {
@@ -35,6 +36,7 @@ type suggestedPriceEstimatorClient interface {
 }
 
 // SuggestedPriceEstimator is an Estimator which uses the suggested gas price from eth_gasPrice.
+// If EIP-1559 dynamic fees are enabled it also uses the suggested tip from eth_maxPriorityFeePerGas and the base fee of the latest block.
 type SuggestedPriceEstimator struct {
 	services.StateMachine
 
@@ -45,6 +47,7 @@ type SuggestedPriceEstimator struct {
 
 	gasPriceMu sync.RWMutex
 	GasPrice   *assets.Wei
+	dynamicFee DynamicFee
 
 	chForceRefetch chan (chan struct{})
 	chInitialised  chan struct{}
@@ -135,8 +138,40 @@ func (o *SuggestedPriceEstimator) refreshPrice() {
 	o.logger.Debugw("refreshPrice", "GasPrice", bi)
 
 	o.gasPriceMu.Lock()
-	defer o.gasPriceMu.Unlock()
 	o.GasPrice = bi
+	o.gasPriceMu.Unlock()
+
+	if o.cfg.EIP1559DynamicFees() {
+		o.refreshDynamicFee(ctx)
+	}
+}
+
+// refreshDynamicFee sets the tip cap to the one returned by eth_maxPriorityFeePerGas, and the fee cap to the base fee of
+// the latest block with a BaseFeeBufferPercentage buffer, plus the tip cap.
+func (o *SuggestedPriceEstimator) refreshDynamicFee(ctx context.Context) {
+	var tipCap hexutil.Big
+	if err := o.client.CallContext(ctx, &tipCap, "eth_maxPriorityFeePerGas"); err != nil {
+		o.logger.Warnf("Failed to refresh max priority fee per gas, got error: %s", err)
+		return
+	}
+	var head *types.Head
+	if err := o.client.CallContext(ctx, &head, "eth_getBlockByNumber", "latest", false); err != nil {
+		o.logger.Warnf("Failed to refresh base fee, got error: %s", err)
+		return
+	}
+	if head == nil || head.BaseFeePerGas == nil {
+		o.logger.Warnw("Failed to refresh base fee, latest block has no base fee. Are you trying to run with EIP1559 enabled on a non-EIP1559 chain?", "head", head)
+		return
+	}
+	fee := DynamicFee{GasTipCap: (*assets.Wei)(&tipCap)}
+	// BaseFeeBufferPercentage is used as a safety to catch any fluctuations in the base fee during the next blocks.
+	fee.GasFeeCap = head.BaseFeePerGas.AddPercentage(BaseFeeBufferPercentage).Add(fee.GasTipCap)
+
+	o.logger.Debugw("refreshDynamicFee", "BaseFee", head.BaseFeePerGas, "BlockNumber", head.Number, "GasFeeCap", fee.GasFeeCap, "GasTipCap", fee.GasTipCap)
+
+	o.gasPriceMu.Lock()
+	defer o.gasPriceMu.Unlock()
+	o.dynamicFee = fee
 }
 
 // Uses the force refetch chan to trigger a price update and blocks until complete
@@ -161,14 +196,71 @@ func (o *SuggestedPriceEstimator) forceRefresh(ctx context.Context) (err error)
 
 func (o *SuggestedPriceEstimator) OnNewLongestChain(context.Context, *types.Head) {}
 
-func (*SuggestedPriceEstimator) GetDynamicFee(_ context.Context, _ *assets.Wei) (fee DynamicFee, err error) {
-	err = pkgerrors.New("dynamic fees are not implemented for this estimator")
-	return
+func (o *SuggestedPriceEstimator) GetDynamicFee(_ context.Context, maxGasPriceWei *assets.Wei) (fee DynamicFee, err error) {
+	if !o.cfg.EIP1559DynamicFees() {
+		return fee, pkgerrors.New("can't get dynamic fee, EIP1559 is disabled")
+	}
+	ok := o.IfStarted(func() {
+		if fee = o.getDynamicFee(); fee.GasFeeCap == nil || fee.GasTipCap == nil {
+			err = pkgerrors.New("failed to estimate dynamic fee; dynamic fee not set")
+			return
+		}
+		o.logger.Debugw("GetDynamicFee", "GasFeeCap", fee.GasFeeCap, "GasTipCap", fee.GasTipCap)
+	})
+	if !ok {
+		return fee, pkgerrors.New("estimator is not started")
+	} else if err != nil {
+		return fee, err
+	}
+	// Like for legacy transactions, a tip cap above the max gas price cannot succeed. The fee cap includes a buffer on top
+	// of the base fee, so it is capped to the max gas price instead.
+	if fee.GasTipCap.Cmp(maxGasPriceWei) > 0 {
+		return DynamicFee{}, pkgerrors.Errorf("estimated tip cap: %s is greater than the maximum gas price configured: %s", fee.GasTipCap.String(), maxGasPriceWei.String())
+	}
+	fee.GasFeeCap = assets.WeiMin(fee.GasFeeCap, maxGasPriceWei)
+	return fee, nil
 }
 
-func (*SuggestedPriceEstimator) BumpDynamicFee(_ context.Context, _ DynamicFee, _ *assets.Wei, _ []EvmPriorAttempt) (bumped DynamicFee, err error) {
-	err = pkgerrors.New("dynamic fees are not implemented for this estimator")
-	return
+// BumpDynamicFee refreshes the dynamic fee like BumpLegacyGas, and bumps both the fee cap and the tip cap of the original fee by the larger of
+// BumpPercent and BumpMin. The bumped values are limited by LimitBumpedFee, so they are at least the refreshed market values, are
+// capped to the max gas price, and must be bumped by at least MinimumBumpPercentage to be accepted by the RPC.
+func (o *SuggestedPriceEstimator) BumpDynamicFee(ctx context.Context, originalFee DynamicFee, maxGasPriceWei *assets.Wei, _ []EvmPriorAttempt) (bumped DynamicFee, err error) {
+	if !o.cfg.EIP1559DynamicFees() {
+		return bumped, pkgerrors.New("can't bump dynamic fee, EIP1559 is disabled")
+	}
+	// According to geth's spec we need to bump both maxFeePerGas and maxPriorityFeePerGas for the new attempt to be accepted by the RPC
+	if originalFee.GasFeeCap == nil ||
+		originalFee.GasTipCap == nil ||
+		originalFee.GasTipCap.Cmp(originalFee.GasFeeCap) > 0 ||
+		originalFee.GasFeeCap.Cmp(maxGasPriceWei) >= 0 {
+		return bumped, fmt.Errorf("%w: error while retrieving original dynamic fees: (originalFeePerGas: %s - originalPriorityFeePerGas: %s). Maximum price configured: %s",
+			fees.ErrBump, originalFee.GasFeeCap, originalFee.GasTipCap, maxGasPriceWei)
+	}
+
+	var current DynamicFee
+	ok := o.IfStarted(func() {
+		// A failed refresh keeps the dynamic fee from the previous update
+		if refreshErr := o.forceRefresh(ctx); refreshErr != nil {
+			o.logger.Warnw("Failed to refresh dynamic fee before bumping", "err", refreshErr)
+		}
+		current = o.getDynamicFee()
+	})
+	if !ok {
+		return bumped, pkgerrors.New("estimator is not started")
+	}
+
+	bumpedTipCap := assets.NewWei(fees.MaxBumpedFee(originalFee.GasTipCap.ToInt(), o.cfg.BumpPercent(), o.cfg.BumpMin().ToInt()))
+	if bumpedTipCap, err = LimitBumpedFee(originalFee.GasTipCap, current.GasTipCap, bumpedTipCap, maxGasPriceWei); err != nil {
+		return bumped, fmt.Errorf("failed to limit maxPriorityFeePerGas: %w", err)
+	}
+	bumpedFeeCap := assets.NewWei(fees.MaxBumpedFee(originalFee.GasFeeCap.ToInt(), o.cfg.BumpPercent(), o.cfg.BumpMin().ToInt()))
+	if bumpedFeeCap, err = LimitBumpedFee(originalFee.GasFeeCap, current.GasFeeCap, bumpedFeeCap, maxGasPriceWei); err != nil {
+		return bumped, fmt.Errorf("failed to limit maxFeePerGas: %w", err)
+	}
+
+	bumped = DynamicFee{GasFeeCap: bumpedFeeCap, GasTipCap: bumpedTipCap}
+	o.logger.Debugw("BumpDynamicFee", "originalFee", originalFee, "marketFee", current, "bumpedFee", bumped)
+	return bumped, nil
 }
 
 func (o *SuggestedPriceEstimator) GetLegacyGas(ctx context.Context, _ []byte, gasLimit uint64, maxGasPriceWei *assets.Wei, opts ...fees.Opt) (gasPrice *assets.Wei, chainSpecificGasLimit uint64, err error) {
@@ -242,3 +334,9 @@ func (o *SuggestedPriceEstimator) getGasPrice() (GasPrice *assets.Wei) {
 	defer o.gasPriceMu.RUnlock()
 	return o.GasPrice
 }
+
+func (o *SuggestedPriceEstimator) getDynamicFee() DynamicFee {
+	o.gasPriceMu.RLock()
+	defer o.gasPriceMu.RUnlock()
+	return o.dynamicFee
+}
@@ -18,6 +18,8 @@ import (
 	"github.com/smartcontractkit/chainlink-evm/pkg/gas"
 	"github.com/smartcontractkit/chainlink-evm/pkg/gas/mocks"
 	rollupMocks "github.com/smartcontractkit/chainlink-evm/pkg/gas/rollups/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+	"github.com/smartcontractkit/chainlink-framework/chains/fees"
 )
 
 func TestSuggestedPriceEstimator(t *testing.T) {
@@ -107,13 +109,13 @@ func TestSuggestedPriceEstimator(t *testing.T) {
 		assert.EqualError(t, err, "failed to estimate gas; gas price not set")
 	})
 
-	t.Run("calling GetDynamicFee always returns error", func(t *testing.T) {
+	t.Run("calling GetDynamicFee with EIP1559 disabled returns error", func(t *testing.T) {
 		feeEstimatorClient := mocks.NewFeeEstimatorClient(t)
 		l1Oracle := rollupMocks.NewL1Oracle(t)
 
 		o := gas.NewSuggestedPriceEstimator(logger.Test(t), feeEstimatorClient, cfg, l1Oracle)
 		_, err := o.GetDynamicFee(tests.Context(t), maxGasPrice)
-		assert.EqualError(t, err, "dynamic fees are not implemented for this estimator")
+		assert.EqualError(t, err, "can't get dynamic fee, EIP1559 is disabled")
 	})
 
 	t.Run("calling BumpLegacyGas on unstarted estimator returns error", func(t *testing.T) {
@@ -125,7 +127,7 @@ func TestSuggestedPriceEstimator(t *testing.T) {
 		assert.EqualError(t, err, "estimator is not started")
 	})
 
-	t.Run("calling BumpDynamicFee always returns error", func(t *testing.T) {
+	t.Run("calling BumpDynamicFee with EIP1559 disabled returns error", func(t *testing.T) {
 		feeEstimatorClient := mocks.NewFeeEstimatorClient(t)
 		l1Oracle := rollupMocks.NewL1Oracle(t)
 
@@ -135,7 +137,7 @@ func TestSuggestedPriceEstimator(t *testing.T) {
 			GasTipCap: assets.NewWeiI(5),
 		}
 		_, err := o.BumpDynamicFee(tests.Context(t), fee, maxGasPrice, nil)
-		assert.EqualError(t, err, "dynamic fees are not implemented for this estimator")
+		assert.EqualError(t, err, "can't bump dynamic fee, EIP1559 is disabled")
 	})
 
 	t.Run("calling BumpLegacyGas on started estimator returns new price buffered with bumpPercent", func(t *testing.T) {
@@ -261,3 +263,96 @@ func TestSuggestedPriceEstimator(t *testing.T) {
 		assert.Equal(t, gasLimit, chainSpecificGasLimit)
 	})
 }
+
+func TestSuggestedPriceEstimator_DynamicFee(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.NewWeiI(200)
+	cfg := &gas.MockGasEstimatorConfig{BumpPercentF: 10, BumpMinF: assets.NewWei(big.NewInt(1)), BumpThresholdF: 1, EIP1559DynamicFeesF: true}
+
+	// newClient returns a client suggesting the given tip caps, one per refresh, on top of a base fee of 100 wei
+	newClient := func(t *testing.T, tipCaps ...int64) *mocks.FeeEstimatorClient {
+		feeEstimatorClient := mocks.NewFeeEstimatorClient(t)
+		feeEstimatorClient.On("CallContext", mock.Anything, mock.Anything, "eth_gasPrice").Return(nil).Run(func(args mock.Arguments) {
+			res := args.Get(1).(*hexutil.Big)
+			(*big.Int)(res).SetInt64(42)
+		})
+		for _, tipCap := range tipCaps {
+			feeEstimatorClient.On("CallContext", mock.Anything, mock.Anything, "eth_maxPriorityFeePerGas").Return(nil).Run(func(args mock.Arguments) {
+				res := args.Get(1).(*hexutil.Big)
+				(*big.Int)(res).SetInt64(tipCap)
+			}).Once()
+		}
+		feeEstimatorClient.On("CallContext", mock.Anything, mock.Anything, "eth_getBlockByNumber", "latest", false).Return(nil).Run(func(args mock.Arguments) {
+			res := args.Get(1).(**evmtypes.Head)
+			*res = &evmtypes.Head{Number: 42, BaseFeePerGas: assets.NewWeiI(100)}
+		})
+		return feeEstimatorClient
+	}
+
+	t.Run("calling GetDynamicFee on unstarted estimator returns error", func(t *testing.T) {
+		o := gas.NewSuggestedPriceEstimator(logger.Test(t), mocks.NewFeeEstimatorClient(t), cfg, rollupMocks.NewL1Oracle(t))
+		_, err := o.GetDynamicFee(tests.Context(t), maxGasPrice)
+		assert.EqualError(t, err, "estimator is not started")
+	})
+
+	t.Run("calling GetDynamicFee on started estimator returns the suggested tip cap on top of the buffered base fee", func(t *testing.T) {
+		o := gas.NewSuggestedPriceEstimator(logger.Test(t), newClient(t, 5), cfg, rollupMocks.NewL1Oracle(t))
+		servicetest.RunHealthy(t, o)
+
+		fee, err := o.GetDynamicFee(tests.Context(t), maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{GasFeeCap: assets.NewWeiI(145), GasTipCap: assets.NewWeiI(5)}, fee)
+
+		fee, err = o.GetDynamicFee(tests.Context(t), assets.NewWeiI(120))
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{GasFeeCap: assets.NewWeiI(120), GasTipCap: assets.NewWeiI(5)}, fee)
+
+		_, err = o.GetDynamicFee(tests.Context(t), assets.NewWeiI(4))
+		assert.EqualError(t, err, "estimated tip cap: 5 wei is greater than the maximum gas price configured: 4 wei")
+	})
+
+	t.Run("calling GetDynamicFee on started estimator if initial call failed returns error", func(t *testing.T) {
+		feeEstimatorClient := mocks.NewFeeEstimatorClient(t)
+		feeEstimatorClient.On("CallContext", mock.Anything, mock.Anything, "eth_gasPrice").Return(nil)
+		feeEstimatorClient.On("CallContext", mock.Anything, mock.Anything, "eth_maxPriorityFeePerGas").Return(pkgerrors.New("kaboom"))
+
+		o := gas.NewSuggestedPriceEstimator(logger.Test(t), feeEstimatorClient, cfg, rollupMocks.NewL1Oracle(t))
+		servicetest.RunHealthy(t, o)
+
+		_, err := o.GetDynamicFee(tests.Context(t), maxGasPrice)
+		assert.EqualError(t, err, "failed to estimate dynamic fee; dynamic fee not set")
+	})
+
+	t.Run("calling BumpDynamicFee on started estimator bumps the original fee by bumpPercent up to the market fee", func(t *testing.T) {
+		o := gas.NewSuggestedPriceEstimator(logger.Test(t), newClient(t, 5, 5), cfg, rollupMocks.NewL1Oracle(t))
+		servicetest.RunHealthy(t, o)
+
+		bumped, err := o.BumpDynamicFee(tests.Context(t), gas.DynamicFee{GasFeeCap: assets.NewWeiI(100), GasTipCap: assets.NewWeiI(10)}, maxGasPrice, nil)
+		require.NoError(t, err)
+		// The fee cap is raised to the market fee cap, the tip cap is bumped by 10%
+		assert.Equal(t, gas.DynamicFee{GasFeeCap: assets.NewWeiI(145), GasTipCap: assets.NewWeiI(11)}, bumped)
+	})
+
+	t.Run("calling BumpDynamicFee on started estimator uses the refreshed market tip cap", func(t *testing.T) {
+		o := gas.NewSuggestedPriceEstimator(logger.Test(t), newClient(t, 5, 50), cfg, rollupMocks.NewL1Oracle(t))
+		servicetest.RunHealthy(t, o)
+
+		bumped, err := o.BumpDynamicFee(tests.Context(t), gas.DynamicFee{GasFeeCap: assets.NewWeiI(150), GasTipCap: assets.NewWeiI(10)}, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{GasFeeCap: assets.NewWeiI(190), GasTipCap: assets.NewWeiI(50)}, bumped)
+	})
+
+	t.Run("calling BumpDynamicFee returns error if the original fee can't be bumped", func(t *testing.T) {
+		o := gas.NewSuggestedPriceEstimator(logger.Test(t), newClient(t, 5, 5), cfg, rollupMocks.NewL1Oracle(t))
+		servicetest.RunHealthy(t, o)
+
+		_, err := o.BumpDynamicFee(tests.Context(t), gas.DynamicFee{GasFeeCap: maxGasPrice, GasTipCap: assets.NewWeiI(10)}, maxGasPrice, nil)
+		assert.ErrorIs(t, err, fees.ErrBump)
+
+		// The bumped fee cap would be capped to the max gas price, less than 10% above the original fee cap
+		_, err = o.BumpDynamicFee(tests.Context(t), gas.DynamicFee{GasFeeCap: assets.NewWeiI(190), GasTipCap: assets.NewWeiI(10)}, maxGasPrice, nil)
+		assert.ErrorIs(t, err, fees.ErrBump)
+		assert.ErrorContains(t, err, "failed to limit maxFeePerGas")
+	})
+}
@@ -0,0 +1,110 @@
+package heads
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+)
+
+var promFinalityViolations = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "head_tracker_finality_violations",
+	Help: "Number of blocks whose hash in the canonical chain changed after the head tracker saw them finalized",
+}, []string{"evmChainID"})
+
+// FinalityViolation is a block which was reorged after the head tracker marked it as finalized.
+// It wraps types.ErrFinalityViolated, so it can be reported as a health error.
+type FinalityViolation struct {
+	ChainID       string
+	BlockNumber   int64
+	FinalizedHash common.Hash // hash of the block when it was marked as finalized
+	CanonicalHash common.Hash // hash of the block in the canonical chain it was reorged into
+	DetectedAt    time.Time
+}
+
+func (v FinalityViolation) Error() string {
+	return fmt.Sprintf("%s: block %d was finalized with hash %s, but the canonical chain now has hash %s",
+		commontypes.ErrFinalityViolated, v.BlockNumber, v.FinalizedHash, v.CanonicalHash)
+}
+
+func (v FinalityViolation) Unwrap() error {
+	return commontypes.ErrFinalityViolated
+}
+
+// FinalityViolationDetector is implemented by the head savers and trackers which detect finality violations.
+//
+// A violation means that the data processed from the reorged blocks, e.g. transactions marked as finalized or logs,
+// may be wrong and needs manual intervention. It is reported, and the finalizer stops marking transactions as
+// finalized, until an operator reconciles that data and calls AcknowledgeFinalityViolation, or the node restarts.
+type FinalityViolationDetector interface {
+	// FinalityViolation returns the latest violation detected since the node started and not acknowledged, or nil.
+	FinalityViolation() *FinalityViolation
+	// SubscribeFinalityViolations returns a channel receiving the violations detected from now on, and a function to
+	// unsubscribe. Violations are dropped if the channel is full, FinalityViolation always returns the latest one.
+	SubscribeFinalityViolations() (<-chan FinalityViolation, func())
+	// AcknowledgeFinalityViolation clears the latest violation if it is at blockNumber, and returns whether it did.
+	// A newer violation at another block is kept, so it cannot be acknowledged by mistake.
+	AcknowledgeFinalityViolation(blockNumber int64) bool
+}
+
+// finalityViolationsBufferSize is the number of violations buffered for each subscriber.
+const finalityViolationsBufferSize = 10
+
+// finalityViolations keeps the latest violation and publishes new ones to the subscribers.
+type finalityViolations struct {
+	mu     sync.RWMutex
+	latest *FinalityViolation
+	subs   map[chan FinalityViolation]struct{}
+}
+
+func (f *finalityViolations) FinalityViolation() *FinalityViolation {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.latest
+}
+
+func (f *finalityViolations) SubscribeFinalityViolations() (<-chan FinalityViolation, func()) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.subs == nil {
+		f.subs = make(map[chan FinalityViolation]struct{})
+	}
+	ch := make(chan FinalityViolation, finalityViolationsBufferSize)
+	f.subs[ch] = struct{}{}
+	return ch, func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if _, ok := f.subs[ch]; ok {
+			delete(f.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+func (f *finalityViolations) acknowledge(blockNumber int64) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.latest == nil || f.latest.BlockNumber != blockNumber {
+		return false
+	}
+	f.latest = nil
+	return true
+}
+
+func (f *finalityViolations) publish(v FinalityViolation) {
+	promFinalityViolations.WithLabelValues(v.ChainID).Inc()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.latest = &v
+	for ch := range f.subs {
+		select {
+		case ch <- v:
+		default:
+		}
+	}
+}
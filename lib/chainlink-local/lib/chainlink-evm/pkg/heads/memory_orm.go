@@ -0,0 +1,129 @@
+package heads
+
+import (
+	"cmp"
+	"context"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+)
+
+var _ ORM = &MemoryORM{}
+
+// MemoryORM keeps heads in memory instead of Postgres, for local devnets and tests running without a database server.
+type MemoryORM struct {
+	chainID ubig.Big
+
+	mu     sync.RWMutex
+	heads  map[common.Hash]*evmtypes.Head
+	lastID uint64
+}
+
+// NewMemoryORM creates a MemoryORM scoped to chainID.
+func NewMemoryORM(chainID big.Int) *MemoryORM {
+	return &MemoryORM{
+		chainID: ubig.Big(chainID),
+		heads:   make(map[common.Hash]*evmtypes.Head),
+	}
+}
+
+func (orm *MemoryORM) IdempotentInsertHead(_ context.Context, head *evmtypes.Head) error {
+	orm.mu.Lock()
+	defer orm.mu.Unlock()
+	if _, ok := orm.heads[head.Hash]; ok {
+		return nil
+	}
+	orm.lastID++
+	// only the columns persisted by DbORM are kept
+	stored := copyHead(head)
+	stored.ID = orm.lastID
+	stored.CreatedAt = time.Now()
+	stored.EVMChainID = &orm.chainID
+	orm.heads[head.Hash] = stored
+	return nil
+}
+
+func (orm *MemoryORM) TrimOldHeads(_ context.Context, minBlockNumber int64) (err error) {
+	orm.mu.Lock()
+	defer orm.mu.Unlock()
+	for hash, head := range orm.heads {
+		if head.Number < minBlockNumber {
+			delete(orm.heads, hash)
+		}
+	}
+	return nil
+}
+
+func (orm *MemoryORM) LatestHead(ctx context.Context) (head *evmtypes.Head, err error) {
+	heads, err := orm.LatestHeads(ctx, 0)
+	if len(heads) == 0 {
+		return nil, err
+	}
+	return heads[0], err
+}
+
+func (orm *MemoryORM) LatestHeads(_ context.Context, minBlockNumer int64) (heads []*evmtypes.Head, err error) {
+	orm.mu.RLock()
+	defer orm.mu.RUnlock()
+	for _, head := range orm.heads {
+		if head.Number >= minBlockNumer {
+			heads = append(heads, copyHead(head))
+		}
+	}
+	slices.SortFunc(heads, func(a, b *evmtypes.Head) int {
+		if c := cmp.Compare(b.Number, a.Number); c != 0 {
+			return c
+		}
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.ID, a.ID)
+	})
+	return heads, nil
+}
+
+func (orm *MemoryORM) HeadByHash(_ context.Context, hash common.Hash) (head *evmtypes.Head, err error) {
+	orm.mu.RLock()
+	defer orm.mu.RUnlock()
+	if head, ok := orm.heads[hash]; ok {
+		return copyHead(head), nil
+	}
+	return nil, nil
+}
+
+func (orm *MemoryORM) EarliestHeadInLatestChain(ctx context.Context) (head *evmtypes.Head, err error) {
+	head, err = orm.LatestHead(ctx)
+	if head == nil {
+		return nil, err
+	}
+	orm.mu.RLock()
+	defer orm.mu.RUnlock()
+	for {
+		parent, ok := orm.heads[head.ParentHash]
+		if !ok || parent.Number >= head.Number {
+			return head, nil
+		}
+		head = copyHead(parent)
+	}
+}
+
+// copyHead returns a new head, so callers linking parents or marking heads finalized do not modify the stored ones.
+func copyHead(head *evmtypes.Head) *evmtypes.Head {
+	return &evmtypes.Head{
+		ID:            head.ID,
+		Hash:          head.Hash,
+		Number:        head.Number,
+		ParentHash:    head.ParentHash,
+		CreatedAt:     head.CreatedAt,
+		Timestamp:     head.Timestamp,
+		L1BlockNumber: head.L1BlockNumber,
+		EVMChainID:    head.EVMChainID,
+		BaseFeePerGas: head.BaseFeePerGas,
+	}
+}
@@ -26,6 +26,9 @@ type ORM interface {
 	LatestHeads(ctx context.Context, minBlockNumber int64) (heads []*evmtypes.Head, err error)
 	// HeadByHash fetches the head with the given hash from the db, returns nil if none exists
 	HeadByHash(ctx context.Context, hash common.Hash) (head *evmtypes.Head, err error)
+	// EarliestHeadInLatestChain returns the earliest stored ancestor of the latest head, or nil if there are no heads.
+	// Heads are only trimmed below the latest finalized head minus HistoryDepth, so it is a finalized head.
+	EarliestHeadInLatestChain(ctx context.Context) (head *evmtypes.Head, err error)
 }
 
 var _ ORM = &DbORM{}
@@ -84,6 +87,22 @@ func (orm *DbORM) HeadByHash(ctx context.Context, hash common.Hash) (head *evmty
 	return head, err
 }
 
+func (orm *DbORM) EarliestHeadInLatestChain(ctx context.Context) (head *evmtypes.Head, err error) {
+	head = new(evmtypes.Head)
+	err = orm.ds.GetContext(ctx, head, `
+	WITH RECURSIVE chain AS (
+		(SELECT * FROM evm.heads WHERE evm_chain_id = $1 ORDER BY number DESC, created_at DESC, id DESC LIMIT 1)
+		UNION ALL
+		SELECT h.* FROM evm.heads h JOIN chain c ON h.evm_chain_id = $1 AND h.hash = c.parent_hash AND h.number < c.number
+	)
+	SELECT * FROM chain ORDER BY number ASC LIMIT 1`, orm.chainID)
+	if pkgerrors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	err = pkgerrors.Wrap(err, "EarliestHeadInLatestChain failed")
+	return
+}
+
 type nullORM struct{}
 
 func NewNullORM() ORM {
@@ -109,3 +128,7 @@ func (orm *nullORM) LatestHeads(ctx context.Context, minBlockNumer int64) (heads
 func (orm *nullORM) HeadByHash(ctx context.Context, hash common.Hash) (head *evmtypes.Head, err error) {
 	return nil, nil
 }
+
+func (orm *nullORM) EarliestHeadInLatestChain(ctx context.Context) (head *evmtypes.Head, err error) {
+	return nil, nil
+}
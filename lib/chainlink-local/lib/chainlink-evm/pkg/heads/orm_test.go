@@ -1,6 +1,7 @@
 package heads_test
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -11,6 +12,9 @@ import (
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/heads"
 	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 )
 
 func TestORM_IdempotentInsertHead(t *testing.T) {
@@ -109,3 +113,82 @@ func TestORM_LatestHeads_NoRows(t *testing.T) {
 	require.Empty(t, heads)
 	require.NoError(t, err)
 }
+
+func TestORM_EarliestHeadInLatestChain(t *testing.T) {
+	t.Parallel()
+
+	db := testutils.NewSqlxDB(t)
+	testEarliestHeadInLatestChain(t, heads.NewORM(*testutils.FixtureChainID, db))
+}
+
+func testEarliestHeadInLatestChain(t *testing.T, orm heads.ORM) {
+	ctx := tests.Context(t)
+	head, err := orm.EarliestHeadInLatestChain(ctx)
+	require.NoError(t, err)
+	require.Nil(t, head)
+
+	// H2 <- H3 <- H4 <- H5, H3' is not in the latest chain
+	chain := []*evmtypes.Head{testutils.Head(2)}
+	for i := 3; i <= 5; i++ {
+		h := evmtypes.NewHead(big.NewInt(int64(i)), utils.NewHash(), chain[len(chain)-1].Hash, ubig.New(testutils.FixtureChainID))
+		chain = append(chain, &h)
+	}
+	uncle := testutils.Head(1)
+	for _, h := range append(chain, uncle) {
+		require.NoError(t, orm.IdempotentInsertHead(ctx, h))
+	}
+
+	head, err = orm.EarliestHeadInLatestChain(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, chain[0].Hash, head.Hash)
+	assert.Equal(t, int64(2), head.Number)
+
+	require.NoError(t, orm.TrimOldHeads(ctx, 4))
+	head, err = orm.EarliestHeadInLatestChain(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, chain[2].Hash, head.Hash)
+}
+
+func TestMemoryORM(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	orm := heads.NewMemoryORM(*testutils.FixtureChainID)
+
+	latest, err := orm.LatestHead(ctx)
+	require.NoError(t, err)
+	require.Nil(t, latest)
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, orm.IdempotentInsertHead(ctx, testutils.Head(i)))
+	}
+	uncleHead := testutils.Head(5)
+	require.NoError(t, orm.IdempotentInsertHead(ctx, uncleHead))
+	require.NoError(t, orm.IdempotentInsertHead(ctx, uncleHead))
+
+	latest, err = orm.LatestHead(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(9), latest.Number)
+
+	require.NoError(t, orm.TrimOldHeads(ctx, 5))
+	heads, err := orm.LatestHeads(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, heads, 6)
+	for i := 1; i < len(heads); i++ {
+		require.LessOrEqual(t, heads[i].Number, heads[i-1].Number)
+	}
+
+	head, err := orm.HeadByHash(ctx, uncleHead.Hash)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), head.Number)
+
+	head, err = orm.HeadByHash(ctx, testutils.Head(123).Hash)
+	require.NoError(t, err)
+	require.Nil(t, head)
+}
+
+func TestMemoryORM_EarliestHeadInLatestChain(t *testing.T) {
+	t.Parallel()
+
+	testEarliestHeadInLatestChain(t, heads.NewMemoryORM(*testutils.FixtureChainID))
+}
@@ -3,10 +3,13 @@ package heads
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink-framework/chains/heads"
 
 	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
@@ -16,19 +19,29 @@ type saver struct {
 	orm      ORM
 	config   heads.ChainConfig
 	htConfig heads.TrackerConfig
-	logger   logger.Logger
+	logger   logger.SugaredLogger
 	heads    HeadSet
+
+	finalityMu sync.Mutex
+	// finalized holds the hashes of the heads marked as finalized by height, down to the oldest head kept
+	finalized       map[int64]common.Hash
+	latestFinalized int64
+	violations      finalityViolations
 }
 
-var _ heads.Saver[*evmtypes.Head, common.Hash] = (*saver)(nil)
+var (
+	_ heads.Saver[*evmtypes.Head, common.Hash] = (*saver)(nil)
+	_ FinalityViolationDetector                = (*saver)(nil)
+)
 
 func NewSaver(lggr logger.Logger, orm ORM, config heads.ChainConfig, htConfig heads.TrackerConfig) HeadSaver {
 	return &saver{
-		orm:      orm,
-		config:   config,
-		htConfig: htConfig,
-		logger:   logger.Named(lggr, "HeadSaver"),
-		heads:    NewHeadSet(),
+		orm:       orm,
+		config:    config,
+		htConfig:  htConfig,
+		logger:    logger.Sugared(logger.Named(lggr, "HeadSaver")),
+		heads:     NewHeadSet(),
+		finalized: make(map[int64]common.Hash),
 	}
 }
 
@@ -37,6 +50,7 @@ func (hs *saver) Save(ctx context.Context, head *evmtypes.Head) error {
 	if err := hs.heads.AddHeads(head); err != nil {
 		return err
 	}
+	hs.verifyFinality(hs.heads.HeadByHash(head.Hash))
 
 	return hs.orm.IdempotentInsertHead(ctx, head)
 }
@@ -52,6 +66,21 @@ func (hs *saver) Load(ctx context.Context, latestFinalized int64) (chain *evmtyp
 	if err != nil {
 		return nil, fmt.Errorf("failed to populate cache with loaded heads: %w", err)
 	}
+
+	// Heads saved from now on are verified against the earliest stored head of the latest chain, which was finalized
+	// before the restart. It is up to HistoryDepth blocks older than the latest finalized head, reorgs of the blocks
+	// finalized after it before the restart are not detected.
+	finalized, err := hs.orm.EarliestHeadInLatestChain(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load earliest head of the latest chain: %w", err)
+	}
+	if finalized != nil {
+		hs.finalityMu.Lock()
+		hs.finalized[finalized.Number] = finalized.Hash
+		hs.latestFinalized = max(hs.latestFinalized, finalized.Number)
+		hs.finalityMu.Unlock()
+		hs.verifyFinality(hs.heads.LatestHead())
+	}
 	return hs.heads.LatestHead(), nil
 }
 
@@ -80,13 +109,94 @@ func (hs *saver) Chain(hash common.Hash) *evmtypes.Head {
 
 func (hs *saver) MarkFinalized(ctx context.Context, finalized *evmtypes.Head) error {
 	minBlockToKeep := hs.calculateMinBlockToKeep(finalized.BlockNumber())
+	head := hs.heads.HeadByHash(finalized.BlockHash())
+	hs.verifyFinality(head)
 	if !hs.heads.MarkFinalized(finalized.BlockHash(), minBlockToKeep) {
 		return fmt.Errorf("failed to find %s block in the canonical chain to mark it as finalized", finalized)
 	}
 
+	hs.recordFinalized(head, minBlockToKeep)
 	return hs.orm.TrimOldHeads(ctx, minBlockToKeep)
 }
 
+// FinalityViolation returns the latest finality violation detected since the node started and not acknowledged, or nil.
+func (hs *saver) FinalityViolation() *FinalityViolation {
+	return hs.violations.FinalityViolation()
+}
+
+// SubscribeFinalityViolations returns a channel receiving the finality violations detected from now on.
+func (hs *saver) SubscribeFinalityViolations() (<-chan FinalityViolation, func()) {
+	return hs.violations.SubscribeFinalityViolations()
+}
+
+// AcknowledgeFinalityViolation clears the latest finality violation if it is at blockNumber.
+func (hs *saver) AcknowledgeFinalityViolation(blockNumber int64) bool {
+	if !hs.violations.acknowledge(blockNumber) {
+		return false
+	}
+	hs.logger.Warnw("Finality violation acknowledged, finalized heads are trusted again", "blockNumber", blockNumber)
+	return true
+}
+
+// verifyFinality compares the first head at a finalized height in the chain of head with the hash the head tracker
+// marked as finalized at that height, and reports a finality violation if they differ.
+func (hs *saver) verifyFinality(head *evmtypes.Head) {
+	if head == nil {
+		return
+	}
+	hs.finalityMu.Lock()
+	defer hs.finalityMu.Unlock()
+	for ; head != nil; head = head.Parent.Load() {
+		if head.Number > hs.latestFinalized {
+			continue
+		}
+		finalizedHash, ok := hs.finalized[head.Number]
+		if !ok || finalizedHash == head.Hash {
+			return
+		}
+		violation := FinalityViolation{
+			BlockNumber:   head.Number,
+			FinalizedHash: finalizedHash,
+			CanonicalHash: head.Hash,
+			DetectedAt:    time.Now(),
+		}
+		if head.EVMChainID != nil {
+			violation.ChainID = head.EVMChainID.String()
+		}
+		hs.logger.Criticalw("Finality violation: a block seen as finalized was reorged. This node may not function correctly without manual intervention.",
+			"blockNumber", violation.BlockNumber, "finalizedHash", violation.FinalizedHash, "canonicalHash", violation.CanonicalHash, "err", commontypes.ErrFinalityViolated)
+		// the hashes finalized after the violating block are on the reorged chain too, the violation is only reported
+		// once and the new chain is verified from now on
+		for number := range hs.finalized {
+			if number > head.Number {
+				delete(hs.finalized, number)
+			}
+		}
+		hs.finalized[head.Number] = head.Hash
+		hs.latestFinalized = head.Number
+		hs.violations.publish(violation)
+		return
+	}
+}
+
+// recordFinalized records the hashes of head and its ancestors up to the previous latest finalized head.
+func (hs *saver) recordFinalized(head *evmtypes.Head, minBlockToKeep int64) {
+	hs.finalityMu.Lock()
+	defer hs.finalityMu.Unlock()
+	if head.Number <= hs.latestFinalized {
+		return
+	}
+	for h := head; h != nil && h.Number > hs.latestFinalized; h = h.Parent.Load() {
+		hs.finalized[h.Number] = h.Hash
+	}
+	hs.latestFinalized = head.Number
+	for number := range hs.finalized {
+		if number < minBlockToKeep {
+			delete(hs.finalized, number)
+		}
+	}
+}
+
 var NullSaver HeadSaver = &nullSaver{}
 
 type nullSaver struct{}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 	evmheads "github.com/smartcontractkit/chainlink-evm/pkg/heads"
 
@@ -157,3 +158,96 @@ func TestHeadSaver_Load(t *testing.T) {
 	require.NotNil(t, uncleChain)
 	require.Equal(t, uint32(2), uncleChain.ChainLength()) // h2Uncle -> h1
 }
+
+func TestHeadSaver_FinalityViolation(t *testing.T) {
+	t.Parallel()
+	ctx := tests.Context(t)
+	orm := evmheads.NewMemoryORM(*testutils.FixtureChainID)
+	newSaver := func() evmheads.HeadSaver {
+		return evmheads.NewSaver(logger.Test(t), orm, &config{finalityDepth: 2}, &trackerConfig{historyDepth: 10})
+	}
+	newHead := func(num int, parent common.Hash) *evmtypes.Head {
+		h := evmtypes.NewHead(big.NewInt(int64(num)), utils.NewHash(), parent, ubig.New(testutils.FixtureChainID))
+		return &h
+	}
+
+	// H0 <- H1 <- H2 <- H3 <- H4
+	//         \
+	//           H2' <- H3' <- H4' <- H5'
+	// H3 is finalized before the fork
+	saver := newSaver()
+	violations, unsubscribe := saver.(evmheads.FinalityViolationDetector).SubscribeFinalityViolations()
+	defer unsubscribe()
+	chain := []*evmtypes.Head{newHead(0, utils.NewHash())}
+	for i := 1; i <= 4; i++ {
+		chain = append(chain, newHead(i, chain[i-1].Hash))
+	}
+	for _, h := range chain {
+		require.NoError(t, saver.Save(ctx, h))
+	}
+	require.NoError(t, saver.MarkFinalized(ctx, chain[3]))
+	require.Nil(t, saver.(evmheads.FinalityViolationDetector).FinalityViolation())
+
+	fork := []*evmtypes.Head{chain[1]}
+	for i := 1; i <= 4; i++ {
+		fork = append(fork, newHead(i+1, fork[i-1].Hash))
+	}
+	require.NoError(t, saver.Save(ctx, fork[1]))
+
+	violation := <-violations
+	require.ErrorIs(t, violation, commontypes.ErrFinalityViolated)
+	require.Equal(t, int64(2), violation.BlockNumber)
+	require.Equal(t, chain[2].Hash, violation.FinalizedHash)
+	require.Equal(t, fork[1].Hash, violation.CanonicalHash)
+	require.Equal(t, testutils.FixtureChainID.String(), violation.ChainID)
+	require.Equal(t, violation, *saver.(evmheads.FinalityViolationDetector).FinalityViolation())
+
+	// the violation is reported once, the new chain is verified from now on
+	for _, h := range fork[2:] {
+		require.NoError(t, saver.Save(ctx, h))
+	}
+	require.Empty(t, violations)
+	require.NoError(t, saver.MarkFinalized(ctx, fork[3]))
+
+	// acknowledging another block does not clear the violation
+	detector := saver.(evmheads.FinalityViolationDetector)
+	require.False(t, detector.AcknowledgeFinalityViolation(3))
+	require.NotNil(t, detector.FinalityViolation())
+	require.True(t, detector.AcknowledgeFinalityViolation(2))
+	require.Nil(t, detector.FinalityViolation())
+}
+
+func TestHeadSaver_FinalityViolationAfterRestart(t *testing.T) {
+	t.Parallel()
+	ctx := tests.Context(t)
+	orm := evmheads.NewMemoryORM(*testutils.FixtureChainID)
+	newSaver := func() evmheads.HeadSaver {
+		return evmheads.NewSaver(logger.Test(t), orm, &config{finalityDepth: 2}, &trackerConfig{historyDepth: 1})
+	}
+	newHead := func(num int, parent common.Hash) *evmtypes.Head {
+		h := evmtypes.NewHead(big.NewInt(int64(num)), utils.NewHash(), parent, ubig.New(testutils.FixtureChainID))
+		return &h
+	}
+
+	// H0 <- H1 <- H2 <- H3 <- H4, H3 is finalized and the heads below H2 are trimmed
+	saver := newSaver()
+	chain := []*evmtypes.Head{newHead(0, utils.NewHash())}
+	for i := 1; i <= 4; i++ {
+		chain = append(chain, newHead(i, chain[i-1].Hash))
+	}
+	for _, h := range chain {
+		require.NoError(t, saver.Save(ctx, h))
+	}
+	require.NoError(t, saver.MarkFinalized(ctx, chain[3]))
+
+	// after a restart heads are verified against the earliest stored head of the latest chain
+	saver = newSaver()
+	_, err := saver.Load(ctx, chain[3].Number)
+	require.NoError(t, err)
+	require.Nil(t, saver.(evmheads.FinalityViolationDetector).FinalityViolation())
+	require.NoError(t, saver.Save(ctx, newHead(2, utils.NewHash())))
+	violation := saver.(evmheads.FinalityViolationDetector).FinalityViolation()
+	require.NotNil(t, violation)
+	require.Equal(t, int64(2), violation.BlockNumber)
+	require.Equal(t, chain[2].Hash, violation.FinalizedHash)
+}
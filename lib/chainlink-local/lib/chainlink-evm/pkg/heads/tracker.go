@@ -22,7 +22,7 @@ func NewTracker(
 	headSaver HeadSaver,
 	mailMon *mailbox.Monitor,
 ) Tracker {
-	return heads.NewTracker[*evmtypes.Head, ethereum.Subscription](
+	t := heads.NewTracker[*evmtypes.Head, ethereum.Subscription](
 		lggr,
 		ethClient,
 		config,
@@ -32,6 +32,26 @@ func NewTracker(
 		mailMon,
 		func() *evmtypes.Head { return nil },
 	)
+	if detector, ok := headSaver.(FinalityViolationDetector); ok {
+		return &tracker{Tracker: t, FinalityViolationDetector: detector}
+	}
+	return t
+}
+
+// tracker reports the finality violations detected by its head saver in its health report. Unlike the violations
+// found by the generic tracker, which clear once the next head is consistent, they are reported until acknowledged
+// or the node restarts, as the blocks already processed from the reorged chain need manual intervention.
+type tracker struct {
+	Tracker
+	FinalityViolationDetector
+}
+
+func (t *tracker) HealthReport() map[string]error {
+	report := t.Tracker.HealthReport()
+	if violation := t.FinalityViolation(); violation != nil {
+		report[t.Name()+".FinalityViolation"] = violation
+	}
+	return report
 }
 
 var NullTracker Tracker = &nullTracker{}
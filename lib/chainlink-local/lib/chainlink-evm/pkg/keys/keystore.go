@@ -76,16 +76,28 @@ type store struct {
 
 	internal.Locker[Mutex]
 
-	lastUsedMu sync.Mutex
-	lastUsed   map[common.Address]time.Time
+	policy SelectionPolicy
+
+	lastUsedMu    sync.Mutex
+	lastUsed      map[common.Address]time.Time
+	currentWeight map[common.Address]int64
 }
 
 // NewStore returns a new Store backed by ks.
-func NewStore(ks core.Keystore) Store {
-	return &store{
-		ks:       ks,
-		lastUsed: make(map[common.Address]time.Time),
+func NewStore(ks core.Keystore, opts ...StoreOption) Store {
+	return newStore(ks, opts...)
+}
+
+func newStore(ks core.Keystore, opts ...StoreOption) *store {
+	s := &store{
+		ks:            ks,
+		lastUsed:      make(map[common.Address]time.Time),
+		currentWeight: make(map[common.Address]int64),
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *store) CheckEnabled(ctx context.Context, address common.Address) error {
@@ -123,9 +135,6 @@ func (s *store) SignRawUnhashedBytes(ctx context.Context, address common.Address
 }
 
 func (s *store) GetNextAddress(ctx context.Context, whitelist ...common.Address) (next common.Address, err error) {
-	s.lastUsedMu.Lock()
-	defer s.lastUsedMu.Unlock()
-
 	if len(whitelist) == 0 {
 		whitelist, err = s.EnabledAddresses(ctx)
 		if err != nil {
@@ -150,9 +159,31 @@ func (s *store) GetNextAddress(ctx context.Context, whitelist ...common.Address)
 		})
 	}
 
+	// The weights may be expensive to compute, e.g. by querying the database, so they are computed before locking.
+	var weights []int64
+	if s.policy != nil {
+		weights = s.weights(ctx, whitelist)
+	}
+
+	s.lastUsedMu.Lock()
+	defer s.lastUsedMu.Unlock()
+
+	if weights != nil {
+		next = s.nextWeighted(whitelist, weights)
+	} else {
+		next = s.nextLRU(whitelist)
+	}
+
+	s.lastUsed[next] = time.Now()
+
+	return
+}
+
+// nextLRU returns the least recently used of the addresses.
+func (s *store) nextLRU(addresses []common.Address) (next common.Address) {
 	var lru time.Time
 
-	for _, addr := range whitelist {
+	for _, addr := range addresses {
 		lastUsed, ok := s.lastUsed[addr]
 		if !ok {
 			// never
@@ -164,9 +195,6 @@ func (s *store) GetNextAddress(ctx context.Context, whitelist ...common.Address)
 			next = addr
 		}
 	}
-
-	s.lastUsed[next] = time.Now()
-
 	return
 }
 
@@ -176,12 +204,9 @@ type chainStore struct {
 }
 
 // NewChainStore returns a new ChainStore for chainID backed by ks.
-func NewChainStore(ks core.Keystore, chainID *big.Int) ChainStore {
+func NewChainStore(ks core.Keystore, chainID *big.Int, opts ...StoreOption) ChainStore {
 	return &chainStore{
-		store: &store{
-			ks:       ks,
-			lastUsed: make(map[common.Address]time.Time),
-		},
+		store:   newStore(ks, opts...),
 		chainID: chainID,
 	}
 }
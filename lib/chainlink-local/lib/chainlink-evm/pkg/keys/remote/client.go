@@ -0,0 +1,255 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/jpillora/backoff"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/keys"
+)
+
+const (
+	pathAccounts    = "/accounts"
+	pathSign        = "/sign"
+	pathSignMessage = "/sign-message"
+	pathSignTx      = "/sign-tx"
+
+	defaultTimeout    = 10 * time.Second
+	defaultMaxRetries = 3
+)
+
+type accountsResponse struct {
+	Accounts []string `json:"accounts"`
+}
+
+type signRequest struct {
+	Account common.Address `json:"account"`
+	Data    hexutil.Bytes  `json:"data"`
+}
+
+type signMessageRequest struct {
+	Account common.Address `json:"account"`
+	Message hexutil.Bytes  `json:"message"`
+}
+
+type signTxRequest struct {
+	Account common.Address `json:"account"`
+	ChainID *hexutil.Big   `json:"chainID"`
+	Tx      hexutil.Bytes  `json:"tx"`
+}
+
+type signResponse struct {
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// Config configures a Client.
+type Config struct {
+	// URL is the base URL of the signing service.
+	URL *url.URL
+	// AuthToken is sent as a bearer token with every request.
+	AuthToken string
+	// Timeout is the timeout of each request attempt. Defaults to 10s.
+	Timeout time.Duration
+	// MaxRetries is the number of times a request is retried after a network error or a 5xx status. Defaults to 3,
+	// negative to disable retries.
+	MaxRetries int
+	// Policies are enforced before sending requests, in addition to the policies of the signing service.
+	Policies Policies
+	// HTTPClient defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+var _ core.Keystore = &Client{}
+
+// Client is a client of the signing service. It implements core.Keystore, with Sign signing raw bytes.
+type Client struct {
+	cfg Config
+	// newBackoff returns the backoff between retries
+	newBackoff func() backoff.Backoff
+}
+
+// NewClient returns a new Client of the signing service at cfg.URL.
+func NewClient(cfg Config) (*Client, error) {
+	if cfg.URL == nil {
+		return nil, errors.New("missing signing service URL")
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = defaultMaxRetries
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+	return &Client{cfg: cfg, newBackoff: func() backoff.Backoff {
+		return backoff.Backoff{
+			Min:    100 * time.Millisecond,
+			Max:    5 * time.Second,
+			Jitter: true,
+		}
+	}}, nil
+}
+
+// Accounts returns the accounts of the signing service.
+func (c *Client) Accounts(ctx context.Context) ([]string, error) {
+	var resp accountsResponse
+	if err := c.post(ctx, pathAccounts, struct{}{}, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
+	}
+	return resp.Accounts, nil
+}
+
+// Sign signs the raw bytes data with account.
+func (c *Client) Sign(ctx context.Context, account string, data []byte) ([]byte, error) {
+	if !common.IsHexAddress(account) {
+		return nil, fmt.Errorf("invalid account: %s", account)
+	}
+	address := common.HexToAddress(account)
+	if err := c.cfg.Policies.checkRaw(address); err != nil {
+		return nil, err
+	}
+	var resp signResponse
+	if err := c.post(ctx, pathSign, signRequest{Account: address, Data: data}, &resp); err != nil {
+		return nil, fmt.Errorf("failed to sign: %w", err)
+	}
+	return resp.Signature, nil
+}
+
+// SignMessage signs the text hash of message with address, see accounts.TextHash.
+func (c *Client) SignMessage(ctx context.Context, address common.Address, message []byte) ([]byte, error) {
+	var resp signResponse
+	if err := c.post(ctx, pathSignMessage, signMessageRequest{Account: address, Message: message}, &resp); err != nil {
+		return nil, fmt.Errorf("failed to sign message: %w", err)
+	}
+	return resp.Signature, nil
+}
+
+// SignTx signs tx for chainID with fromAddress, and verifies the signature of the returned transaction.
+func (c *Client) SignTx(ctx context.Context, chainID *big.Int, fromAddress common.Address, tx *types.Transaction) (*types.Transaction, error) {
+	if err := c.cfg.Policies.checkTx(fromAddress, tx); err != nil {
+		return nil, err
+	}
+	b, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode transaction: %w", err)
+	}
+	var resp signResponse
+	req := signTxRequest{Account: fromAddress, ChainID: (*hexutil.Big)(chainID), Tx: b}
+	if err = c.post(ctx, pathSignTx, req, &resp); err != nil {
+		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+	signer := types.LatestSignerForChainID(chainID)
+	signed, err := tx.WithSignature(signer, resp.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction signature: %w", err)
+	}
+	if sender, err := types.Sender(signer, signed); err != nil {
+		return nil, fmt.Errorf("invalid transaction signature: %w", err)
+	} else if sender != fromAddress {
+		return nil, fmt.Errorf("transaction signed by %s instead of %s", sender, fromAddress)
+	}
+	return signed, nil
+}
+
+// post sends req to path and decodes the response into resp, retrying after network errors and 5xx statuses.
+func (c *Client) post(ctx context.Context, path string, req, resp any) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	b := c.newBackoff()
+	for attempt := 0; ; attempt++ {
+		var retryable bool
+		retryable, err = c.do(ctx, path, body, resp)
+		if err == nil || !retryable || attempt >= c.cfg.MaxRetries {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w (last error: %w)", ctx.Err(), err)
+		case <-time.After(b.Duration()):
+		}
+	}
+}
+
+// do sends a single request and returns whether its error is retryable.
+func (c *Client) do(ctx context.Context, path string, body []byte, resp any) (retryable bool, err error) {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.URL.JoinPath(path).String(), bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.cfg.AuthToken)
+
+	res, err := c.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer res.Body.Close()
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return true, err
+	}
+	if res.StatusCode != http.StatusOK {
+		var e errorResponse
+		_ = json.Unmarshal(b, &e)
+		switch {
+		case res.StatusCode == http.StatusForbidden:
+			return false, fmt.Errorf("%w: %s", ErrPolicyViolation, e.Error)
+		case res.StatusCode >= http.StatusInternalServerError:
+			return true, fmt.Errorf("status %d: %s", res.StatusCode, e.Error)
+		default:
+			return false, fmt.Errorf("status %d: %s", res.StatusCode, e.Error)
+		}
+	}
+	if err = json.Unmarshal(b, resp); err != nil {
+		return false, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return false, nil
+}
+
+var _ keys.ChainStore = &chainStore{}
+
+type chainStore struct {
+	keys.Store
+	c       *Client
+	chainID *big.Int
+}
+
+// NewChainStore returns a new keys.ChainStore for chainID signing with the signing service of c.
+func NewChainStore(c *Client, chainID *big.Int, opts ...keys.StoreOption) keys.ChainStore {
+	return &chainStore{
+		Store:   keys.NewStore(c, opts...),
+		c:       c,
+		chainID: chainID,
+	}
+}
+
+func (s *chainStore) SignMessage(ctx context.Context, address common.Address, message []byte) ([]byte, error) {
+	return s.c.SignMessage(ctx, address, message)
+}
+
+func (s *chainStore) SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction) (*types.Transaction, error) {
+	return s.c.SignTx(ctx, s.chainID, fromAddress, tx)
+}
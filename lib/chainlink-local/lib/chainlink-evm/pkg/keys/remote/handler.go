@@ -0,0 +1,115 @@
+package remote
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
+)
+
+// maxRequestSize is the maximum size of the body of the requests served by Handler.
+const maxRequestSize = 1 << 20
+
+// Handler serves the signing protocol with the keys of a core.Keystore, enforcing the policy of each key. It is a
+// reference implementation of the signing service, e.g. to run a local stub signer in tests.
+type Handler struct {
+	ks        core.Keystore
+	authToken string
+	policies  Policies
+}
+
+var _ http.Handler = &Handler{}
+
+// NewHandler returns a new Handler signing with ks the requests authenticated with authToken.
+func NewHandler(ks core.Keystore, authToken string, policies Policies) *Handler {
+	return &Handler{ks: ks, authToken: authToken, policies: policies}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Bearer "+h.authToken)) != 1 {
+		writeError(w, http.StatusUnauthorized, errors.New("unauthorized"))
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestSize)
+	dec := json.NewDecoder(r.Body)
+	ctx := r.Context()
+
+	var sig []byte
+	var err error
+	switch r.URL.Path {
+	case pathAccounts:
+		var as []string
+		if as, err = h.ks.Accounts(ctx); err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, accountsResponse{Accounts: as})
+		return
+	case pathSign:
+		var req signRequest
+		if err = dec.Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		if err = h.policies.checkRaw(req.Account); err != nil {
+			writeError(w, http.StatusForbidden, err)
+			return
+		}
+		sig, err = h.ks.Sign(ctx, req.Account.String(), req.Data)
+	case pathSignMessage:
+		var req signMessageRequest
+		if err = dec.Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		sig, err = h.ks.Sign(ctx, req.Account.String(), accounts.TextHash(req.Message))
+	case pathSignTx:
+		var req signTxRequest
+		if err = dec.Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		if req.ChainID == nil {
+			writeError(w, http.StatusBadRequest, errors.New("missing chainID"))
+			return
+		}
+		tx := new(types.Transaction)
+		if err = tx.UnmarshalBinary(req.Tx); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		if err = h.policies.checkTx(req.Account, tx); err != nil {
+			writeError(w, http.StatusForbidden, err)
+			return
+		}
+		hash := types.LatestSignerForChainID(req.ChainID.ToInt()).Hash(tx)
+		sig, err = h.ks.Sign(ctx, req.Account.String(), hash[:])
+	default:
+		writeError(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, signResponse{Signature: sig})
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,83 @@
+// Package remote implements a keys.ChainStore delegating signing to an external signing service, so that private keys
+// never live on the node host, and a Handler serving the signing protocol from a core.Keystore.
+//
+// The protocol is JSON over HTTP. Every request is a POST authenticated with a bearer token:
+//
+//	/accounts      {}                                  -> {"accounts": ["0x..."]}
+//	/sign          {"account", "data"}                 -> {"signature"}
+//	/sign-message  {"account", "message"}              -> {"signature"}
+//	/sign-tx       {"account", "chainID", "tx"}        -> {"signature"}
+//
+// Byte fields are hex encoded, and tx is the binary encoding of the unsigned transaction. Errors are returned with a
+// non 2xx status and {"error"}, with 403 Forbidden if the request is not allowed by the Policy of the key.
+package remote
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrPolicyViolation is returned when signing is not allowed by the Policy of the key.
+var ErrPolicyViolation = errors.New("policy violation")
+
+// Policy restricts what is signed with a key.
+type Policy struct {
+	// AllowedTo are the recipients of the transactions signed with the key. Any recipient is allowed if empty.
+	AllowedTo []common.Address
+	// MaxValue is the maximum value of the transactions signed with the key. Unlimited if nil.
+	MaxValue *big.Int
+	// AllowRaw allows signing raw bytes with the key. Raw bytes may be the hash of any transaction, so they are never
+	// signed for keys restricting their transactions unless AllowRaw is set.
+	AllowRaw bool
+}
+
+// restrictsTxs returns true if the policy restricts the transactions signed with the key.
+func (p Policy) restrictsTxs() bool {
+	return len(p.AllowedTo) > 0 || p.MaxValue != nil
+}
+
+// CheckTx returns an error wrapping ErrPolicyViolation if the policy does not allow signing tx.
+func (p Policy) CheckTx(tx *types.Transaction) error {
+	if len(p.AllowedTo) > 0 {
+		if tx.To() == nil {
+			return fmt.Errorf("%w: contract creation not allowed", ErrPolicyViolation)
+		}
+		if !slices.Contains(p.AllowedTo, *tx.To()) {
+			return fmt.Errorf("%w: recipient %s not allowed", ErrPolicyViolation, tx.To())
+		}
+	}
+	if p.MaxValue != nil && tx.Value().Cmp(p.MaxValue) > 0 {
+		return fmt.Errorf("%w: value %s exceeds maximum %s", ErrPolicyViolation, tx.Value(), p.MaxValue)
+	}
+	return nil
+}
+
+// CheckRaw returns an error wrapping ErrPolicyViolation if the policy does not allow signing raw bytes.
+func (p Policy) CheckRaw() error {
+	if p.restrictsTxs() && !p.AllowRaw {
+		return fmt.Errorf("%w: raw signing not allowed", ErrPolicyViolation)
+	}
+	return nil
+}
+
+// Policies are the policies of each key. Keys without a policy are unrestricted.
+type Policies map[common.Address]Policy
+
+func (ps Policies) checkTx(account common.Address, tx *types.Transaction) error {
+	if p, ok := ps[account]; ok {
+		return p.CheckTx(tx)
+	}
+	return nil
+}
+
+func (ps Policies) checkRaw(account common.Address) error {
+	if p, ok := ps[account]; ok {
+		return p.CheckRaw()
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package remote_test
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/keys/keystest"
+	"github.com/smartcontractkit/chainlink-evm/pkg/keys/remote"
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+)
+
+const authToken = "secret"
+
+func newClient(t *testing.T, handler http.Handler, cfg remote.Config) *remote.Client {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	cfg.URL = u
+	if cfg.AuthToken == "" {
+		cfg.AuthToken = authToken
+	}
+	c, err := remote.NewClient(cfg)
+	require.NoError(t, err)
+	return c
+}
+
+func TestChainStore(t *testing.T) {
+	t.Parallel()
+
+	ks := keystest.NewMemoryChainStore()
+	k0, k1 := ks.MustCreate(t), ks.MustCreate(t)
+	allowedTo := testutils.NewAddress()
+	policies := remote.Policies{k1: {AllowedTo: []common.Address{allowedTo}, MaxValue: big.NewInt(100)}}
+	chainID := testutils.FixtureChainID
+	s := remote.NewChainStore(newClient(t, remote.NewHandler(ks, authToken, policies), remote.Config{}), chainID)
+	ctx := tests.Context(t)
+
+	t.Run("lists accounts", func(t *testing.T) {
+		addrs, err := s.EnabledAddresses(ctx)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []common.Address{k0, k1}, addrs)
+		require.NoError(t, s.CheckEnabled(ctx, k1))
+		require.Error(t, s.CheckEnabled(ctx, testutils.NewAddress()))
+	})
+
+	t.Run("signs transactions", func(t *testing.T) {
+		tx := types.NewTx(&types.DynamicFeeTx{ChainID: chainID, Nonce: 1, To: &allowedTo, Value: big.NewInt(100), Gas: 21_000})
+		for _, from := range []common.Address{k0, k1} {
+			signed, err := s.SignTx(ctx, from, tx)
+			require.NoError(t, err)
+			sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+			require.NoError(t, err)
+			assert.Equal(t, from, sender)
+		}
+	})
+
+	t.Run("signs messages", func(t *testing.T) {
+		sig, err := s.SignMessage(ctx, k0, []byte("hello"))
+		require.NoError(t, err)
+		pub, err := crypto.SigToPub(accounts.TextHash([]byte("hello")), sig)
+		require.NoError(t, err)
+		assert.Equal(t, k0, crypto.PubkeyToAddress(*pub))
+	})
+
+	t.Run("enforces policies", func(t *testing.T) {
+		other := testutils.NewAddress()
+		tx := types.NewTx(&types.DynamicFeeTx{ChainID: chainID, To: &other, Gas: 21_000})
+		_, err := s.SignTx(ctx, k1, tx)
+		require.ErrorIs(t, err, remote.ErrPolicyViolation)
+		assert.ErrorContains(t, err, "recipient")
+
+		tx = types.NewTx(&types.DynamicFeeTx{ChainID: chainID, To: &allowedTo, Value: big.NewInt(101), Gas: 21_000})
+		_, err = s.SignTx(ctx, k1, tx)
+		require.ErrorIs(t, err, remote.ErrPolicyViolation)
+		assert.ErrorContains(t, err, "exceeds maximum")
+
+		hash := crypto.Keccak256([]byte("tx"))
+		_, err = s.SignRawUnhashedBytes(ctx, k1, hash)
+		require.ErrorIs(t, err, remote.ErrPolicyViolation)
+		_, err = s.SignRawUnhashedBytes(ctx, k0, hash)
+		require.NoError(t, err)
+	})
+
+	t.Run("enforces policies before sending requests", func(t *testing.T) {
+		c := newClient(t, remote.NewHandler(ks, authToken, nil), remote.Config{Policies: policies})
+		other := testutils.NewAddress()
+		tx := types.NewTx(&types.DynamicFeeTx{ChainID: chainID, To: &other, Gas: 21_000})
+		_, err := c.SignTx(ctx, chainID, k1, tx)
+		require.ErrorIs(t, err, remote.ErrPolicyViolation)
+		_, err = c.Sign(ctx, k1.String(), crypto.Keccak256([]byte("tx")))
+		require.ErrorIs(t, err, remote.ErrPolicyViolation)
+	})
+
+	t.Run("rejects unauthenticated requests", func(t *testing.T) {
+		c := newClient(t, remote.NewHandler(ks, authToken, nil), remote.Config{AuthToken: "wrong"})
+		_, err := c.Accounts(ctx)
+		require.ErrorContains(t, err, "status 401")
+	})
+}
+
+func TestClient_Retry(t *testing.T) {
+	t.Parallel()
+
+	ks := keystest.NewMemoryChainStore()
+	k0 := ks.MustCreate(t)
+	handler := remote.NewHandler(ks, authToken, nil)
+
+	t.Run("retries failed requests", func(t *testing.T) {
+		var calls atomic.Int32
+		c := newClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			handler.ServeHTTP(w, r)
+		}), remote.Config{})
+
+		as, err := c.Accounts(tests.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, []string{k0.String()}, as)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("times out slow requests", func(t *testing.T) {
+		var calls atomic.Int32
+		done := make(chan struct{})
+		c := newClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			<-done
+		}), remote.Config{Timeout: 10 * time.Millisecond, MaxRetries: 1})
+		t.Cleanup(func() { close(done) })
+
+		_, err := c.Accounts(tests.Context(t))
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("does not retry rejected requests", func(t *testing.T) {
+		var calls atomic.Int32
+		c := newClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			handler.ServeHTTP(w, r)
+		}), remote.Config{AuthToken: "wrong"})
+
+		_, err := c.Accounts(tests.Context(t))
+		require.Error(t, err)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
@@ -0,0 +1,99 @@
+package keys
+
+import (
+	"context"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SelectionPolicy weights the addresses selected by RoundRobin.GetNextAddress. Addresses are selected in proportion to
+// their weight, and addresses with a weight of 0 are skipped, unless every address has a weight of 0. Weight is called
+// without holding the Store's locks, so it may query the database.
+type SelectionPolicy interface {
+	Weight(ctx context.Context, address common.Address) uint32
+}
+
+// SelectionPolicyFunc is a func implementing SelectionPolicy.
+type SelectionPolicyFunc func(ctx context.Context, address common.Address) uint32
+
+func (f SelectionPolicyFunc) Weight(ctx context.Context, address common.Address) uint32 {
+	return f(ctx, address)
+}
+
+// NewPriorityPolicy returns a SelectionPolicy weighting each address by its priority.
+func NewPriorityPolicy(priority func(address common.Address) uint32) SelectionPolicy {
+	return SelectionPolicyFunc(func(_ context.Context, address common.Address) uint32 {
+		return priority(address)
+	})
+}
+
+// CombinePolicies returns a SelectionPolicy weighting each address by the product of its weights in policies, so an
+// address skipped by any of the policies is skipped. Nil policies are ignored.
+func CombinePolicies(policies ...SelectionPolicy) SelectionPolicy {
+	return SelectionPolicyFunc(func(ctx context.Context, address common.Address) uint32 {
+		weight := uint64(1)
+		for _, p := range policies {
+			if p == nil {
+				continue
+			}
+			weight *= uint64(p.Weight(ctx, address))
+			if weight == 0 {
+				return 0
+			}
+			weight = min(weight, math.MaxUint32)
+		}
+		return uint32(weight)
+	})
+}
+
+// StoreOption configures the Store returned by NewStore and NewChainStore.
+type StoreOption func(*store)
+
+// WithSelectionPolicy makes GetNextAddress select addresses by smooth weighted round robin, with the weights of
+// policy, instead of selecting the least recently used address.
+func WithSelectionPolicy(policy SelectionPolicy) StoreOption {
+	return func(s *store) {
+		s.policy = policy
+	}
+}
+
+// weights returns the weights of the addresses by the policy.
+func (s *store) weights(ctx context.Context, addresses []common.Address) []int64 {
+	weights := make([]int64, len(addresses))
+	for i, addr := range addresses {
+		weights[i] = int64(s.policy.Weight(ctx, addr))
+	}
+	return weights
+}
+
+// nextWeighted returns the next of the addresses by smooth weighted round robin: every address accumulates its weight
+// on each selection, and the address with the highest accumulated weight is selected and set back by the total weight.
+// Ties are broken by least recent use. If every address has a weight of 0, the least recently used address is returned.
+// The caller must hold lastUsedMu.
+func (s *store) nextWeighted(addresses []common.Address, weights []int64) common.Address {
+	var total int64
+	for _, w := range weights {
+		total += w
+	}
+	if total == 0 {
+		return s.nextLRU(addresses)
+	}
+
+	var next common.Address
+	var found bool
+	for i, addr := range addresses {
+		if weights[i] == 0 {
+			delete(s.currentWeight, addr)
+			continue
+		}
+		s.currentWeight[addr] += weights[i]
+		if !found || s.currentWeight[addr] > s.currentWeight[next] ||
+			s.currentWeight[addr] == s.currentWeight[next] && s.lastUsed[addr].Before(s.lastUsed[next]) {
+			next = addr
+			found = true
+		}
+	}
+	s.currentWeight[next] -= total
+	return next
+}
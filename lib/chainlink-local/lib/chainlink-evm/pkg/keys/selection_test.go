@@ -0,0 +1,98 @@
+package keys_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/keys"
+	"github.com/smartcontractkit/chainlink-evm/pkg/keys/keystest"
+)
+
+func countNext(t *testing.T, s keys.Store, n int, whitelist ...common.Address) map[common.Address]int {
+	counts := map[common.Address]int{}
+	for range n {
+		addr, err := s.GetNextAddress(tests.Context(t), whitelist...)
+		require.NoError(t, err)
+		counts[addr]++
+	}
+	return counts
+}
+
+func TestStore_GetNextAddress(t *testing.T) {
+	t.Parallel()
+
+	ks := keystest.NewMemoryChainStore()
+	k0, k1, k2 := ks.MustCreate(t), ks.MustCreate(t), ks.MustCreate(t)
+
+	t.Run("round robin", func(t *testing.T) {
+		counts := countNext(t, keys.NewStore(ks), 30)
+		assert.Equal(t, map[common.Address]int{k0: 10, k1: 10, k2: 10}, counts)
+	})
+
+	t.Run("weighted by priority", func(t *testing.T) {
+		priorities := map[common.Address]uint32{k0: 1, k1: 2, k2: 3}
+		s := keys.NewStore(ks, keys.WithSelectionPolicy(keys.NewPriorityPolicy(func(a common.Address) uint32 { return priorities[a] })))
+		assert.Equal(t, map[common.Address]int{k0: 5, k1: 10, k2: 15}, countNext(t, s, 30))
+		assert.Equal(t, map[common.Address]int{k0: 1, k1: 2}, countNext(t, s, 3, k0, k1))
+	})
+
+	t.Run("skips keys with weight 0", func(t *testing.T) {
+		skipped := map[common.Address]bool{k1: true}
+		healthy := keys.SelectionPolicyFunc(func(_ context.Context, a common.Address) uint32 {
+			if skipped[a] {
+				return 0
+			}
+			return 1
+		})
+		s := keys.NewChainStore(ks, nil, keys.WithSelectionPolicy(keys.CombinePolicies(healthy, nil)))
+		assert.Equal(t, map[common.Address]int{k0: 5, k2: 5}, countNext(t, s, 10))
+
+		// the least recently used key is selected if every key is skipped
+		skipped = map[common.Address]bool{k0: true, k1: true, k2: true}
+		assert.Equal(t, map[common.Address]int{k1: 1}, countNext(t, s, 1))
+		assert.Equal(t, map[common.Address]int{k0: 1, k2: 1}, countNext(t, s, 2, k0, k2))
+	})
+
+	t.Run("weights keys without blocking other selections", func(t *testing.T) {
+		blocking, unblock := make(chan struct{}), make(chan struct{})
+		slow := keys.SelectionPolicyFunc(func(_ context.Context, a common.Address) uint32 {
+			if a == k0 {
+				close(blocking)
+				<-unblock
+			}
+			return 1
+		})
+		s := keys.NewStore(ks, keys.WithSelectionPolicy(slow))
+
+		done := make(chan common.Address)
+		go func() {
+			addr, err := s.GetNextAddress(tests.Context(t), k0)
+			assert.NoError(t, err)
+			done <- addr
+		}()
+		<-blocking
+		assert.Equal(t, map[common.Address]int{k1: 1}, countNext(t, s, 1, k1))
+		close(unblock)
+		assert.Equal(t, k0, <-done)
+	})
+}
+
+func TestCombinePolicies(t *testing.T) {
+	t.Parallel()
+
+	addr := common.Address{1}
+	weight := func(w uint32) keys.SelectionPolicy {
+		return keys.NewPriorityPolicy(func(common.Address) uint32 { return w })
+	}
+	ctx := tests.Context(t)
+	assert.Equal(t, uint32(6), keys.CombinePolicies(weight(2), weight(3)).Weight(ctx, addr))
+	assert.Equal(t, uint32(0), keys.CombinePolicies(weight(2), weight(0)).Weight(ctx, addr))
+	assert.Equal(t, uint32(1), keys.CombinePolicies().Weight(ctx, addr))
+	assert.Equal(t, uint32(1<<32-1), keys.CombinePolicies(weight(1<<20), weight(1<<20), weight(2)).Weight(ctx, addr))
+}
@@ -0,0 +1,290 @@
+package logpoller
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/client"
+)
+
+var (
+	promLpBackfillRemainingBlocks = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "log_poller_backfill_remaining_blocks",
+		Help: "Number of blocks left to backfill by the backfill in progress, 0 when no backfill is running",
+	}, []string{"chainID"})
+	promLpBackfillBlocksPerSecond = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "log_poller_backfill_blocks_per_second",
+		Help: "Rate at which the backfill in progress saves blocks, 0 when no backfill is running",
+	}, []string{"chainID"})
+)
+
+// BackfillProgress is the progress of a backfill of the blocks [From, To].
+type BackfillProgress struct {
+	From      int64
+	To        int64
+	NextBlock int64 // first block whose logs have not been saved yet
+	StartedAt time.Time
+}
+
+// BlocksPerSecond returns the average rate at which blocks have been saved since the backfill started.
+func (p BackfillProgress) BlocksPerSecond() float64 {
+	elapsed := time.Since(p.StartedAt).Seconds()
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(p.NextBlock-p.From) / elapsed
+}
+
+// ETA returns the estimated time until the backfill completes, or 0 if no block has been saved yet.
+func (p BackfillProgress) ETA() time.Duration {
+	rate := p.BlocksPerSecond()
+	if rate == 0 {
+		return 0
+	}
+	return time.Duration(float64(p.To-p.NextBlock+1) / rate * float64(time.Second))
+}
+
+func (p BackfillProgress) String() string {
+	return fmt.Sprintf("blocks %d-%d, next block %d, %.1f blocks/sec, ETA %s",
+		p.From, p.To, p.NextBlock, p.BlocksPerSecond(), p.ETA().Round(time.Second))
+}
+
+// backfillChunk is the result of fetching the logs of the blocks [from, to].
+type backfillChunk struct {
+	from, to int64
+	logs     []Log
+	endBlock *Block // the block to, only fetched and saved if there are logs
+	err      error
+}
+
+// replayBackfill backfills the blocks [fromBlock, toBlock] for Replay, saving a checkpoint after each batch.
+// If a previous replay with the same filters was interrupted after saving the logs of fromBlock, it resumes
+// from its checkpoint instead of starting over.
+func (lp *logPoller) replayBackfill(ctx context.Context, fromBlock, toBlock int64) error {
+	filtersHash := lp.filtersHash()
+	start := fromBlock
+	checkpoint, err := lp.orm.SelectBackfillCheckpoint(ctx)
+	switch {
+	case err == nil:
+		if checkpoint.FiltersHash == filtersHash && checkpoint.FromBlock <= fromBlock && fromBlock < checkpoint.NextBlock {
+			lp.lggr.Infow("Resuming interrupted replay from checkpoint", "fromBlock", fromBlock, "checkpointFromBlock", checkpoint.FromBlock, "nextBlock", checkpoint.NextBlock)
+			fromBlock, start = checkpoint.FromBlock, checkpoint.NextBlock
+		}
+	case !errors.Is(err, sql.ErrNoRows):
+		return fmt.Errorf("failed to load backfill checkpoint: %w", err)
+	}
+
+	if start <= toBlock {
+		err = lp.backfillWithCheckpoints(ctx, start, toBlock, func(nextBlock int64) error {
+			return lp.orm.UpsertBackfillCheckpoint(ctx, BackfillCheckpoint{FromBlock: fromBlock, NextBlock: nextBlock, FiltersHash: filtersHash})
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return lp.orm.DeleteBackfillCheckpoint(ctx)
+}
+
+// filtersHash identifies the merged filters used to query logs, so that a checkpoint is only resumed for the same filters.
+func (lp *logPoller) filtersHash() common.Hash {
+	q := lp.Filter(nil, nil, nil)
+	data := make([]byte, 0, (len(q.Addresses)+1)*common.HashLength)
+	for _, addr := range q.Addresses {
+		data = append(data, addr.Bytes()...)
+	}
+	if len(q.Topics) == 0 {
+		data = append(data, AnonymousEventSig.Bytes()...)
+	}
+	for _, topics := range q.Topics {
+		for _, eventSig := range topics {
+			data = append(data, eventSig.Bytes()...)
+		}
+	}
+	return crypto.Keccak256Hash(data)
+}
+
+// backfill will query FilterLogs in batches for logs in the
+// block range [start, end] and save them to the db.
+func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
+	return lp.backfillWithCheckpoints(ctx, start, end, nil)
+}
+
+// backfillWithCheckpoints backfills [start, end] with up to backfillConcurrency batches fetched concurrently.
+// Batches are saved in order, so the latest saved block never skips over blocks which are still being fetched,
+// and checkpoint is called with the next block to backfill after each batch is saved.
+func (lp *logPoller) backfillWithCheckpoints(ctx context.Context, start, end int64, checkpoint func(nextBlock int64) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	progress := &BackfillProgress{From: start, To: end, NextBlock: start, StartedAt: time.Now()}
+	lp.backfillProgress.Store(progress)
+	defer func() {
+		lp.backfillProgress.CompareAndSwap(progress, nil)
+		if progress.NextBlock > start {
+			lp.reportBackfillProgress(nil)
+		}
+	}()
+
+	var batchSize atomic.Int64
+	batchSize.Store(lp.backfillBatchSize)
+	concurrency := max(lp.backfillConcurrency, 1)
+
+	var wg sync.WaitGroup
+	// Each chunk gets a result channel queued in order, the queue capacity bounds the number of chunks being fetched.
+	chunks := make(chan chan backfillChunk, concurrency-1)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(chunks)
+		for from := start; from <= end; {
+			result := make(chan backfillChunk, 1)
+			select {
+			case chunks <- result:
+			case <-ctx.Done():
+				return
+			}
+			if ctx.Err() != nil {
+				result <- backfillChunk{err: ctx.Err()}
+				return
+			}
+			to := min(from+batchSize.Load()-1, end)
+			wg.Add(1)
+			go func(from, to int64) {
+				defer wg.Done()
+				result <- lp.fetchBackfillChunk(ctx, from, to, &batchSize)
+			}(from, to)
+			from = to + 1
+		}
+	}()
+
+	var err error
+	for result := range chunks {
+		chunk := <-result
+		if err = chunk.err; err == nil {
+			err = lp.saveBackfillChunk(ctx, chunk)
+		}
+		if err == nil && checkpoint != nil {
+			err = checkpoint(chunk.to + 1)
+		}
+		if err != nil {
+			cancel()
+			break
+		}
+
+		next := *progress
+		next.NextBlock = chunk.to + 1
+		progress = &next
+		lp.backfillProgress.Store(progress)
+		lp.reportBackfillProgress(progress)
+	}
+	// Result channels are buffered, so draining the queue lets every fetch return.
+	for range chunks {
+	}
+	wg.Wait()
+	if err == nil {
+		lp.logsMismatch.Store(nil)
+	}
+	return err
+}
+
+// fetchBackfillChunk queries the logs of [from, to] with FilterLogs calls spanning at most batchSize blocks.
+// batchSize is shared by the concurrent fetches and halved when the RPC returns too many results.
+func (lp *logPoller) fetchBackfillChunk(ctx context.Context, from, to int64, batchSize *atomic.Int64) backfillChunk {
+	chunk := backfillChunk{from: from, to: to}
+	gethLogs, err := lp.filterLogsRange(ctx, lp.latencyMonitor.FilterLogs, from, to, batchSize)
+	if err == nil && lp.verifyFinalizedLogs {
+		gethLogs, err = lp.verifyLogs(ctx, from, to, gethLogs, batchSize)
+	}
+	if err != nil {
+		chunk.err = err
+		return chunk
+	}
+	if len(gethLogs) == 0 {
+		return chunk
+	}
+
+	blocks, err := lp.blocksFromFinalizedLogs(ctx, gethLogs, uint64(to)) //nolint:gosec // G115
+	if err != nil {
+		chunk.err = err
+		return chunk
+	}
+	chunk.endBlock = &blocks[len(blocks)-1]
+	if gethLogs[len(gethLogs)-1].BlockNumber != uint64(to) { //nolint:gosec // G115
+		// Pop endblock if there were no logs for it, so that length of blocks & gethLogs are the same to pass to convertLogs
+		blocks = blocks[:len(blocks)-1]
+	}
+
+	lp.lggr.Debugw("Backfill found logs", "from", from, "to", to, "logs", len(gethLogs), "blocks", blocks)
+	chunk.logs = lp.matchAnonymousLogs(convertLogs(gethLogs, blocks, lp.lggr, lp.ec.ConfiguredChainID()))
+	return chunk
+}
+
+// filterLogsRange queries the logs of [from, to] with filterLogs calls spanning at most batchSize blocks.
+func (lp *logPoller) filterLogsRange(ctx context.Context, filterLogs func(context.Context, ethereum.FilterQuery) ([]types.Log, error), from, to int64, batchSize *atomic.Int64) ([]types.Log, error) {
+	var gethLogs []types.Log
+	for start := from; start <= to; {
+		size := batchSize.Load()
+		end := min(start+size-1, to)
+		logs, err := filterLogs(ctx, lp.Filter(big.NewInt(start), big.NewInt(end), nil))
+		if err != nil {
+			if !client.IsTooManyResults(err, lp.clientErrors) {
+				lp.lggr.Errorw("Unable to query for logs", "err", err, "from", start, "to", end)
+				return nil, err
+			}
+
+			if size == 1 {
+				lp.lggr.Criticalw("Too many log results in a single block, failed to retrieve logs! Node may be running in a degraded state.", "err", err, "from", start, "to", end, "LogBackfillBatchSize", lp.backfillBatchSize)
+				return nil, err
+			}
+			// Only the first fetch to exceed the limit at this size halves it.
+			if batchSize.CompareAndSwap(size, size/2) {
+				lp.lggr.Warnw("Too many log results, halving block range batch size.  Consider increasing LogBackfillBatchSize if this happens frequently", "err", err, "from", start, "to", end, "newBatchSize", size/2, "LogBackfillBatchSize", lp.backfillBatchSize)
+			}
+			continue
+		}
+		gethLogs = append(gethLogs, logs...)
+		start = end + 1
+	}
+	return gethLogs, nil
+}
+
+func (lp *logPoller) saveBackfillChunk(ctx context.Context, chunk backfillChunk) error {
+	if chunk.endBlock == nil {
+		return nil
+	}
+	if err := lp.orm.InsertLogsWithBlock(ctx, chunk.logs, *chunk.endBlock); err != nil {
+		lp.lggr.Warnw("Unable to insert logs, retrying", "err", err, "from", chunk.from, "to", chunk.to)
+		return err
+	}
+	lp.recordLogsPerBlock(chunk.logs)
+	lp.subs.publishLogs(chunk.logs, chunk.endBlock.BlockNumber)
+	return nil
+}
+
+// reportBackfillProgress updates the backfill metrics, progress is nil once the backfill is over.
+func (lp *logPoller) reportBackfillProgress(progress *BackfillProgress) {
+	chainID := lp.ec.ConfiguredChainID().String()
+	if progress == nil {
+		promLpBackfillRemainingBlocks.WithLabelValues(chainID).Set(0)
+		promLpBackfillBlocksPerSecond.WithLabelValues(chainID).Set(0)
+		return
+	}
+	promLpBackfillRemainingBlocks.WithLabelValues(chainID).Set(float64(progress.To - progress.NextBlock + 1))
+	promLpBackfillBlocksPerSecond.WithLabelValues(chainID).Set(progress.BlocksPerSecond())
+	if progress.To-progress.From >= lp.backfillBatchSize {
+		lp.lggr.Infow("Backfill progress", "progress", progress)
+	}
+}
@@ -0,0 +1,213 @@
+package logpoller
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+)
+
+// ErrNoEventABI is returned when decoding a log whose event has no ABI in the registered filters.
+var ErrNoEventABI = errors.New("no event ABI registered for log")
+
+// DecodedLog is a log together with its event arguments decoded by name. Indexed arguments of dynamic types
+// (string, bytes, arrays) are only available as the common.Hash stored in the topic.
+type DecodedLog struct {
+	Log
+	Event  string
+	Fields map[string]any
+}
+
+// DecodeLog decodes the topics and data of the log with the event ABI.
+func DecodeLog(event abi.Event, log Log) (DecodedLog, error) {
+	topics := log.GetTopics()
+	if !event.Anonymous {
+		if len(topics) == 0 || topics[0] != event.ID {
+			return DecodedLog{}, fmt.Errorf("log event sig %s does not match event %s", log.EventSig, event.Sig)
+		}
+		topics = topics[1:]
+	}
+
+	var indexed abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+
+	fields := make(map[string]any, len(event.Inputs))
+	if err := abi.ParseTopicsIntoMap(fields, indexed, topics); err != nil {
+		return DecodedLog{}, fmt.Errorf("failed to decode topics of %s: %w", event.Sig, err)
+	}
+	if err := event.Inputs.UnpackIntoMap(fields, log.Data); err != nil {
+		return DecodedLog{}, fmt.Errorf("failed to decode data of %s: %w", event.Sig, err)
+	}
+
+	return DecodedLog{Log: log, Event: event.Name, Fields: fields}, nil
+}
+
+// DecodeLogs decodes the logs with the event ABIs of the registered filters matching their address and event sig.
+// ErrNoEventABI is returned if there is no ABI for one of the logs.
+func (lp *logPoller) DecodeLogs(logs []Log) ([]DecodedLog, error) {
+	type addressEvent struct {
+		address common.Address
+		event   common.Hash
+	}
+
+	lp.filterMu.RLock()
+	events := make(map[addressEvent]abi.Event)
+	for _, filter := range lp.filters {
+		for _, event := range filter.Events {
+			for _, address := range filter.Addresses {
+				events[addressEvent{address: address, event: filterEventSig(event)}] = event
+			}
+		}
+	}
+	lp.filterMu.RUnlock()
+
+	decoded := make([]DecodedLog, len(logs))
+	for i, log := range logs {
+		event, ok := events[addressEvent{address: log.Address, event: log.EventSig}]
+		if !ok {
+			return nil, fmt.Errorf("%w: address %s, event sig %s", ErrNoEventABI, log.Address, log.EventSig)
+		}
+
+		var err error
+		if decoded[i], err = DecodeLog(event, log); err != nil {
+			return nil, err
+		}
+	}
+	return decoded, nil
+}
+
+// NewEventFieldFilter returns an expression matching the logs of the event whose argument named field compares to
+// value with op. The argument is looked up in the ABI, so callers don't have to compute topic or data word indexes.
+// value must be of the Go type the ABI packs the argument from, e.g. *big.Int for uint256.
+//
+// Only arguments of elementary types are supported. Indexed strings and bytes are compared by hash, so like signed
+// integers, which are compared by their two's complement encoding, they only support Eq and Neq.
+func NewEventFieldFilter(event abi.Event, field string, op primitives.ComparisonOperator, value any) (query.Expression, error) {
+	if _, err := cmpOpToString(op); err != nil {
+		return query.Expression{}, err
+	}
+
+	var (
+		topicIndex uint64
+		wordIndex  int
+	)
+	if !event.Anonymous {
+		topicIndex = 1
+	}
+
+	for _, arg := range event.Inputs {
+		if arg.Name != field {
+			if arg.Indexed {
+				topicIndex++
+			} else {
+				wordIndex += headWords(arg.Type)
+			}
+			continue
+		}
+
+		encoded, hashed, err := encodeFieldValue(arg, value)
+		if err != nil {
+			return query.Expression{}, fmt.Errorf("field %s of %s: %w", field, event.Sig, err)
+		}
+		if (hashed || arg.Type.T == abi.IntTy) && op != primitives.Eq && op != primitives.Neq {
+			return query.Expression{}, fmt.Errorf("field %s of %s of type %s only supports equality comparisons",
+				field, event.Sig, arg.Type)
+		}
+
+		comparers := []HashedValueComparator{{Values: []common.Hash{encoded}, Operator: op}}
+		var fieldFilter query.Expression
+		if arg.Indexed {
+			if topicIndex > 3 {
+				return query.Expression{}, fmt.Errorf("field %s of %s: invalid index for topic: %d", field, event.Sig, topicIndex)
+			}
+			fieldFilter = NewEventByTopicFilter(topicIndex, comparers)
+		} else {
+			fieldFilter = NewEventByWordFilter(wordIndex, comparers)
+		}
+
+		return query.And(NewEventSigFilter(filterEventSig(event)), fieldFilter), nil
+	}
+
+	return query.Expression{}, fmt.Errorf("event %s has no field %s", event.Sig, field)
+}
+
+// filterEventSig returns the event sig the logs of the event are stored with, AnonymousEventSig for anonymous events.
+func filterEventSig(event abi.Event) common.Hash {
+	if event.Anonymous {
+		return AnonymousEventSig
+	}
+	return event.ID
+}
+
+// encodeFieldValue returns the 32 byte word the argument value is stored as in the topics or data of a log.
+// hashed is true for indexed strings and bytes, which are stored as the keccak256 hash of the value.
+func encodeFieldValue(arg abi.Argument, value any) (encoded common.Hash, hashed bool, err error) {
+	switch arg.Type.T {
+	case abi.IntTy, abi.UintTy, abi.BoolTy, abi.AddressTy, abi.FixedBytesTy, abi.HashTy:
+		packed, err := abi.Arguments{{Type: arg.Type}}.Pack(value)
+		if err != nil {
+			return common.Hash{}, false, err
+		}
+		return common.BytesToHash(packed), false, nil
+	case abi.StringTy, abi.BytesTy:
+		if !arg.Indexed {
+			return common.Hash{}, false, fmt.Errorf("non-indexed %s is not supported", arg.Type)
+		}
+		switch v := value.(type) {
+		case string:
+			return crypto.Keccak256Hash([]byte(v)), true, nil
+		case []byte:
+			return crypto.Keccak256Hash(v), true, nil
+		default:
+			return common.Hash{}, false, fmt.Errorf("unexpected value type %T for %s", value, arg.Type)
+		}
+	default:
+		return common.Hash{}, false, fmt.Errorf("type %s is not supported", arg.Type)
+	}
+}
+
+// headWords returns the number of 32 byte words an argument takes in the head of the abi encoded data.
+func headWords(t abi.Type) int {
+	if isDynamicType(t) {
+		return 1
+	}
+	switch t.T {
+	case abi.ArrayTy:
+		return t.Size * headWords(*t.Elem)
+	case abi.TupleTy:
+		words := 0
+		for _, elem := range t.TupleElems {
+			words += headWords(*elem)
+		}
+		return words
+	default:
+		return 1
+	}
+}
+
+func isDynamicType(t abi.Type) bool {
+	switch t.T {
+	case abi.StringTy, abi.BytesTy, abi.SliceTy:
+		return true
+	case abi.ArrayTy:
+		return isDynamicType(*t.Elem)
+	case abi.TupleTy:
+		for _, elem := range t.TupleElems {
+			if isDynamicType(*elem) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
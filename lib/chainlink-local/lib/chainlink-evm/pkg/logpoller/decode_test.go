@@ -0,0 +1,138 @@
+package logpoller
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+)
+
+const transferABI = `[{"type":"event","name":"Transfer","anonymous":false,"inputs":[
+	{"name":"from","type":"address","indexed":true},
+	{"name":"to","type":"address","indexed":true},
+	{"name":"amount","type":"uint256","indexed":false},
+	{"name":"memo","type":"string","indexed":false},
+	{"name":"nonce","type":"uint64","indexed":false}
+]}]`
+
+func transferEvent(t *testing.T) abi.Event {
+	parsed, err := abi.JSON(strings.NewReader(transferABI))
+	require.NoError(t, err)
+	return parsed.Events["Transfer"]
+}
+
+func transferLog(t *testing.T, chainID *big.Int, block int64, from, to common.Address, amount int64, nonce uint64) Log {
+	event := transferEvent(t)
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(amount), "memo", nonce)
+	require.NoError(t, err)
+	return Log{
+		EVMChainID:     ubig.New(chainID),
+		BlockHash:      common.BigToHash(big.NewInt(block)),
+		BlockNumber:    block,
+		BlockTimestamp: time.Now(),
+		Address:        subAddress,
+		EventSig:       event.ID,
+		Topics:         [][]byte{event.ID.Bytes(), common.BytesToHash(from.Bytes()).Bytes(), common.BytesToHash(to.Bytes()).Bytes()},
+		Data:           data,
+	}
+}
+
+func TestDecodeLog(t *testing.T) {
+	event := transferEvent(t)
+	from, to := common.HexToAddress("0x01"), common.HexToAddress("0x02")
+	log := transferLog(t, big.NewInt(1), 1, from, to, 100, 7)
+
+	decoded, err := DecodeLog(event, log)
+	require.NoError(t, err)
+	assert.Equal(t, "Transfer", decoded.Event)
+	assert.Equal(t, from, decoded.Fields["from"])
+	assert.Equal(t, to, decoded.Fields["to"])
+	assert.Equal(t, big.NewInt(100), decoded.Fields["amount"])
+	assert.Equal(t, "memo", decoded.Fields["memo"])
+	assert.Equal(t, uint64(7), decoded.Fields["nonce"])
+
+	log.EventSig = common.HexToHash("0x1111")
+	log.Topics[0] = log.EventSig.Bytes()
+	_, err = DecodeLog(event, log)
+	require.Error(t, err)
+}
+
+func TestNewEventFieldFilter(t *testing.T) {
+	ctx := testutils.Context(t)
+	event := transferEvent(t)
+	chainID := big.NewInt(1)
+	orm := NewMemoryORM(chainID)
+
+	alice, bob := common.HexToAddress("0x0a"), common.HexToAddress("0x0b")
+	for block := int64(1); block <= 4; block++ {
+		log := transferLog(t, chainID, block, alice, bob, block*100, uint64(block)) //nolint:gosec // G115
+		if block%2 == 0 {
+			log = transferLog(t, chainID, block, bob, alice, block*100, uint64(block)) //nolint:gosec // G115
+		}
+		require.NoError(t, orm.InsertLogsWithBlock(ctx, []Log{log}, Block{BlockHash: log.BlockHash, BlockNumber: block}))
+	}
+
+	blocks := func(field string, op primitives.ComparisonOperator, value any) []int64 {
+		exp, err := NewEventFieldFilter(event, field, op, value)
+		require.NoError(t, err)
+		logs, err := orm.FilteredLogs(ctx, []query.Expression{exp}, query.NewLimitAndSort(query.Limit{}, query.NewSortBySequence(query.Asc)), "")
+		require.NoError(t, err)
+		return blockNumbers(logs)
+	}
+
+	assert.Equal(t, []int64{1, 3}, blocks("from", primitives.Eq, alice))
+	assert.Equal(t, []int64{1, 3}, blocks("to", primitives.Eq, bob))
+	assert.Equal(t, []int64{3, 4}, blocks("amount", primitives.Gt, big.NewInt(200)))
+	assert.Equal(t, []int64{1, 2}, blocks("nonce", primitives.Lte, uint64(2)))
+
+	_, err := NewEventFieldFilter(event, "unknown", primitives.Eq, alice)
+	require.ErrorContains(t, err, "has no field unknown")
+	_, err = NewEventFieldFilter(event, "memo", primitives.Eq, "memo")
+	require.ErrorContains(t, err, "not supported")
+	_, err = NewEventFieldFilter(event, "amount", primitives.Gt, int64(1))
+	require.Error(t, err, "value of the wrong Go type")
+}
+
+func TestLogPoller_DecodeLogs(t *testing.T) {
+	ctx := testutils.Context(t)
+	event := transferEvent(t)
+	chainID := big.NewInt(1)
+	lp := NewLogPoller(NewMemoryORM(chainID), nil, logger.Test(t), nil, Opts{PollPeriod: time.Second})
+
+	require.ErrorContains(t, lp.RegisterFilter(ctx, Filter{
+		Name:      "transfers",
+		Addresses: []common.Address{subAddress},
+		EventSigs: []common.Hash{subEventSig},
+		Events:    []abi.Event{event},
+	}), "is not one of the filter event sigs")
+
+	log := transferLog(t, chainID, 1, common.HexToAddress("0x0a"), common.HexToAddress("0x0b"), 100, 1)
+	_, err := lp.DecodeLogs([]Log{log})
+	require.ErrorIs(t, err, ErrNoEventABI)
+
+	require.NoError(t, lp.RegisterFilter(ctx, Filter{
+		Name:      "transfers",
+		Addresses: []common.Address{subAddress},
+		EventSigs: []common.Hash{event.ID},
+		Events:    []abi.Event{event},
+	}))
+	// the ABIs are kept when the filters are reloaded from the db
+	require.NoError(t, lp.loadFilters(ctx))
+
+	decoded, err := lp.DecodeLogs([]Log{log})
+	require.NoError(t, err)
+	require.Len(t, decoded, 1)
+	assert.Equal(t, big.NewInt(100), decoded[0].Fields["amount"])
+}
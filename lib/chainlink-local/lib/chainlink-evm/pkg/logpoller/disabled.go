@@ -129,3 +129,15 @@ func (d disabled) FindLCA(ctx context.Context) (*Block, error) {
 func (d disabled) DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error {
 	return ErrDisabled
 }
+
+func (d disabled) Subscribe(ctx context.Context, filterName string, fromBlock int64) (*Subscription, error) {
+	return nil, ErrDisabled
+}
+
+func (d disabled) DecodeLogs(logs []Log) ([]DecodedLog, error) {
+	return nil, ErrDisabled
+}
+
+func (d disabled) FilterStorageReport(ctx context.Context) ([]FilterStorageReport, error) {
+	return nil, ErrDisabled
+}
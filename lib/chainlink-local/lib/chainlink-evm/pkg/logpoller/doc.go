@@ -18,5 +18,15 @@
 //     of them have expired.  Default retention of 0 on any matching filter guarantees permanent retention.
 //   - After calling Replay(fromBlock), all blocks including that one to the latest chain tip will be polled
 //     with the current filter. This can be used on first time job add to specify a start block from which you wish to capture
-//     existing logs.
+//     existing logs. Backfilled batches are fetched by up to BackfillConcurrency workers and saved in order, and a
+//     replay interrupted by a crash resumes from its last saved batch, as long as the filters have not changed.
+//   - With VerifyFinalizedLogs, backfilled logs are checked against the blooms of their blocks, and against the logs of
+//     VerificationClient if set. They are re-fetched on mismatch, and a persistent mismatch fails the backfill and is
+//     reported by HealthReport as ErrLogsIncomplete.
+//   - After calling Subscribe(filterName), the logs matching that filter are pushed to the subscriber right after they
+//     are saved, followed by a RemovedLogs notification whenever a reorg removes blocks that logs were delivered from.
+//     Slow subscribers never block polling, they catch up from the db instead.
+//
+// Logs are stored in Postgres by DSORM. MemoryORM provides the same queries without a database server for local
+// devnets and tests, but nothing it stores survives a node restart.
 package logpoller
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"math/big"
+	"os"
 	"strings"
 	"testing"
 	"time"
@@ -93,10 +94,7 @@ func SetupTH(t testing.TB, opts logpoller.Opts) TestHarness {
 	lggr := logger.Test(t)
 	chainID := testutils.NewRandomEVMChainID()
 	chainID2 := testutils.NewRandomEVMChainID()
-	db := testutils.NewSqlxDB(t)
-
-	o := logpoller.NewORM(chainID, db, lggr)
-	o2 := logpoller.NewORM(chainID2, db, lggr)
+	o, o2 := newTestORMs(t, chainID, chainID2, lggr)
 	owner := testutils.MustNewSimTransactor(t)
 	// Needed for the new sim if you are using Rollback
 	owner.GasTipCap = big.NewInt(1000000000)
@@ -148,6 +146,16 @@ func SetupTH(t testing.TB, opts logpoller.Opts) TestHarness {
 	}
 }
 
+// newTestORMs returns ORMs backed by the test database, or by memory when CL_LOGPOLLER_ORM=memory is set, so the
+// harness based tests can run against either backend.
+func newTestORMs(t testing.TB, chainID, chainID2 *big.Int, lggr logger.Logger) (logpoller.ORM, logpoller.ORM) {
+	if os.Getenv("CL_LOGPOLLER_ORM") == "memory" {
+		return logpoller.NewMemoryORM(chainID), logpoller.NewMemoryORM(chainID2)
+	}
+	db := testutils.NewSqlxDB(t)
+	return logpoller.NewORM(chainID, db, lggr), logpoller.NewORM(chainID2, db, lggr)
+}
+
 func (th *TestHarness) PollAndSaveLogs(ctx context.Context, currentBlockNumber int64) int64 {
 	th.LogPoller.PollAndSaveLogs(ctx, currentBlockNumber)
 	latest, _ := th.LogPoller.LatestBlock(ctx)
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"math/big"
 	"math/rand/v2"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -16,6 +17,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -30,8 +32,8 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mathutil"
 
-	"github.com/smartcontractkit/chainlink-evm/pkg/client"
 	"github.com/smartcontractkit/chainlink-evm/pkg/config"
+	"github.com/smartcontractkit/chainlink-evm/pkg/heads"
 	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
 	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 )
@@ -72,6 +74,15 @@ type LogPoller interface {
 
 	// chainlink-common query filtering
 	FilteredLogs(ctx context.Context, filter []query.Expression, limitAndSort query.LimitAndSort, queryName string) ([]Log, error)
+
+	// Push based delivery of new logs
+	Subscribe(ctx context.Context, filterName string, fromBlock int64) (*Subscription, error)
+
+	// Decoding with the event ABIs of the registered filters
+	DecodeLogs(logs []Log) ([]DecodedLog, error)
+
+	// Storage used by the logs of each filter
+	FilterStorageReport(ctx context.Context) ([]FilterStorageReport, error)
 }
 
 type LogPollerTest interface {
@@ -115,17 +126,31 @@ type logPoller struct {
 	finalityDepth            int64         // finality depth is taken to mean that block (head - finality) is finalized. If `useFinalityTag` is set to true, this value is ignored, because finalityDepth is fetched from chain
 	keepFinalizedBlocksDepth int64         // the number of blocks behind the last finalized block we keep in database
 	backfillBatchSize        int64         // batch size to use when backfilling finalized logs
+	backfillConcurrency      int64         // number of batches to fetch concurrently when backfilling finalized logs
 	rpcBatchSize             int64         // batch size to use for fallback RPC calls made in GetBlocks
 	logPrunePageSize         int64
 	clientErrors             config.ClientErrors
-	backupPollerNextBlock    int64 // next block to be processed by Backup LogPoller
-	backupPollerBlockDelay   int64 // how far behind regular LogPoller should BackupLogPoller run. 0 = disabled
+	backupPollerNextBlock    int64  // next block to be processed by Backup LogPoller
+	backupPollerBlockDelay   int64  // how far behind regular LogPoller should BackupLogPoller run. 0 = disabled
+	verifyFinalizedLogs      bool   // compare backfilled logs against block blooms, and against verificationClient if set
+	verificationClient       Client // second RPC whose logs are compared with ec's logs when verifying finalized logs
 
 	filterMu        sync.RWMutex
 	filters         map[string]Filter
 	filterDirty     bool
 	cachedAddresses []common.Address
 	cachedEventSigs []common.Hash
+	// cachedAddressSigs holds the event sigs of the filters of each address
+	cachedAddressSigs map[common.Address]map[common.Hash]struct{}
+	// cachedAnonymous holds the event sigs of the other filters of each address with an anonymous filter
+	cachedAnonymous map[common.Address]map[common.Hash]struct{}
+
+	subs    subscriptions
+	storage storageAccounting
+
+	backfillProgress atomic.Pointer[BackfillProgress]
+	// logsMismatch is the last discrepancy found when verifying finalized logs, cleared once a verified backfill completes
+	logsMismatch atomic.Pointer[logsMismatch]
 
 	replayStart    chan int64
 	replayComplete chan error
@@ -145,11 +170,14 @@ type Opts struct {
 	UseFinalityTag           bool
 	FinalityDepth            int64
 	BackfillBatchSize        int64
+	BackfillConcurrency      int64 // number of batches fetched concurrently when backfilling, 0 or 1 is sequential, see EVM.LogBackfillConcurrency
 	RPCBatchSize             int64
 	KeepFinalizedBlocksDepth int64
 	BackupPollerBlockDelay   int64
 	LogPrunePageSize         int64
 	ClientErrors             config.ClientErrors
+	VerifyFinalizedLogs      bool   // verify backfilled logs against block blooms and VerificationClient, re-fetching them on mismatch
+	VerificationClient       Client // optional second RPC node used to verify the logs of finalized blocks
 }
 
 // NewLogPoller creates a log poller. Note there is an assumption
@@ -177,15 +205,23 @@ func NewLogPoller(orm ORM, ec Client, lggr logger.Logger, headTracker HeadTracke
 		finalityDepth:            opts.FinalityDepth,
 		useFinalityTag:           opts.UseFinalityTag,
 		backfillBatchSize:        opts.BackfillBatchSize,
+		backfillConcurrency:      opts.BackfillConcurrency,
 		rpcBatchSize:             opts.RPCBatchSize,
 		keepFinalizedBlocksDepth: opts.KeepFinalizedBlocksDepth,
 		logPrunePageSize:         opts.LogPrunePageSize,
 		clientErrors:             opts.ClientErrors,
+		verifyFinalizedLogs:      opts.VerifyFinalizedLogs,
+		verificationClient:       opts.VerificationClient,
 		filters:                  make(map[string]Filter),
 		filterDirty:              true, // Always build Filter on first call to cache an empty filter if nothing registered yet.
 	}
 }
 
+// AnonymousEventSig is the event sig of filters matching the anonymous events of their addresses, which have no
+// signature in topic0. Logs matched by an anonymous filter are stored with AnonymousEventSig as their event sig, and
+// all of their topics are kept in Log.Topics, so topic index 0 can be used to query the first indexed argument.
+var AnonymousEventSig = common.MaxHash
+
 type Filter struct {
 	Name         string // see FilterName(id, args) below
 	Addresses    evmtypes.AddressArray
@@ -196,6 +232,9 @@ type Filter struct {
 	Retention    time.Duration      // maximum amount of time to retain logs
 	MaxLogsKept  uint64             // maximum number of logs to retain ( 0 = unlimited )
 	LogsPerBlock uint64             // rate limit ( maximum # of logs per block, 0 = unlimited )
+	// Events are the optional ABIs of EventSigs used by DecodeLogs. They are not stored in the db, the registered
+	// filters keep them when filters are reloaded.
+	Events []abi.Event `db:"-"`
 }
 
 // FilterName is a suggested convenience function for clients to construct unique filter names
@@ -245,6 +284,11 @@ func (filter *Filter) Contains(other *Filter) bool {
 			return false
 		}
 	}
+	for _, ev := range other.Events {
+		if !slices.ContainsFunc(filter.Events, func(e abi.Event) bool { return e.ID == ev.ID }) {
+			return false
+		}
+	}
 	return true
 }
 
@@ -259,8 +303,12 @@ func (filter *Filter) Contains(other *Filter) bool {
 //	RegisterFilter(event2, addr2)
 //
 // will result in the poller saving (event1, addr2) or (event2, addr1) as well, should it exist.
-// Generally speaking this is harmless. We enforce that EventSigs and Addresses are non-empty,
-// which means that anonymous events are not supported and log.Topics >= 1 always (log.Topics[0] is the event signature).
+// Generally speaking this is harmless. We enforce that EventSigs and Addresses are non-empty.
+// Anonymous events are captured by including AnonymousEventSig in EventSigs, which matches every log of the
+// filter Addresses whose topic0 is not the event sig of another filter on the same address, including logs
+// without topics. Those logs are stored with AnonymousEventSig, and their indexed arguments can be queried by
+// topic position starting at topic index 0. Registering an anonymous filter disables the topic0 restriction
+// of the eth filter query, so all logs of the registered addresses are fetched and the unmatched ones dropped.
 // The filter may be unregistered later by Filter.Name
 // Warnings/debug information is keyed by filter name.
 func (lp *logPoller) RegisterFilter(ctx context.Context, filter Filter) error {
@@ -281,6 +329,11 @@ func (lp *logPoller) RegisterFilter(ctx context.Context, filter Filter) error {
 			return pkgerrors.Errorf("empty address")
 		}
 	}
+	for _, event := range filter.Events {
+		if !slices.Contains(filter.EventSigs, filterEventSig(event)) {
+			return pkgerrors.Errorf("event %s is not one of the filter event sigs", event.Sig)
+		}
+	}
 
 	lp.filterMu.Lock()
 	defer lp.filterMu.Unlock()
@@ -323,6 +376,7 @@ func (lp *logPoller) UnregisterFilter(ctx context.Context, name string) error {
 	}
 	delete(lp.filters, name)
 	lp.filterDirty = true
+	lp.storage.forgetFilter(lp.ec.ConfiguredChainID().String(), name)
 	return nil
 }
 
@@ -368,19 +422,35 @@ func (lp *logPoller) Filter(from, to *big.Int, bh *common.Hash) ethereum.FilterQ
 	lp.filterMu.Lock()
 	defer lp.filterMu.Unlock()
 	if !lp.filterDirty {
-		return ethereum.FilterQuery{FromBlock: from, ToBlock: to, BlockHash: bh, Topics: [][]common.Hash{lp.cachedEventSigs}, Addresses: lp.cachedAddresses}
+		return lp.filterQuery(from, to, bh)
 	}
 	var (
-		addressMp  = make(map[common.Address]struct{})
-		eventSigMp = make(map[common.Hash]struct{})
+		addressMp   = make(map[common.Address]struct{})
+		eventSigMp  = make(map[common.Hash]struct{})
+		addressSigs = make(map[common.Address]map[common.Hash]struct{})
+		anonymous   = make(map[common.Address]map[common.Hash]struct{})
 	)
 	// Merge filters.
 	for _, filter := range lp.filters {
+		isAnonymous := slices.Contains(filter.EventSigs, AnonymousEventSig)
 		for _, addr := range filter.Addresses {
 			addressMp[addr] = struct{}{}
+			if addressSigs[addr] == nil {
+				addressSigs[addr] = make(map[common.Hash]struct{})
+			}
+			if isAnonymous {
+				anonymous[addr] = addressSigs[addr]
+			}
+			for _, eventSig := range filter.EventSigs {
+				if eventSig != AnonymousEventSig {
+					addressSigs[addr][eventSig] = struct{}{}
+				}
+			}
 		}
 		for _, eventSig := range filter.EventSigs {
-			eventSigMp[eventSig] = struct{}{}
+			if eventSig != AnonymousEventSig {
+				eventSigMp[eventSig] = struct{}{}
+			}
 		}
 	}
 	addresses := make([]common.Address, 0, len(addressMp))
@@ -406,8 +476,44 @@ func (lp *logPoller) Filter(from, to *big.Int, bh *common.Hash) ethereum.FilterQ
 	}
 	lp.cachedAddresses = addresses
 	lp.cachedEventSigs = eventSigs
+	lp.cachedAddressSigs = addressSigs
+	lp.cachedAnonymous = anonymous
 	lp.filterDirty = false
-	return ethereum.FilterQuery{FromBlock: from, ToBlock: to, BlockHash: bh, Topics: [][]common.Hash{eventSigs}, Addresses: addresses}
+	return lp.filterQuery(from, to, bh)
+}
+
+// filterQuery returns the eth filter query of the cached filters, it must be called with filterMu held.
+func (lp *logPoller) filterQuery(from, to *big.Int, bh *common.Hash) ethereum.FilterQuery {
+	query := ethereum.FilterQuery{FromBlock: from, ToBlock: to, BlockHash: bh, Addresses: lp.cachedAddresses}
+	// Anonymous events may have any topic0 or no topics at all, logs are matched by matchAnonymousLogs instead.
+	if len(lp.cachedAnonymous) == 0 {
+		query.Topics = [][]common.Hash{lp.cachedEventSigs}
+	}
+	return query
+}
+
+// matchAnonymousLogs sets the event sig of logs matched by anonymous filters to AnonymousEventSig. Since the eth
+// filter query does not restrict topic0 while anonymous filters are registered, logs of other addresses whose topic0
+// is not one of the event sigs are dropped.
+func (lp *logPoller) matchAnonymousLogs(logs []Log) []Log {
+	lp.filterMu.RLock()
+	defer lp.filterMu.RUnlock()
+	if len(lp.cachedAnonymous) == 0 {
+		return logs
+	}
+
+	matched := logs[:0]
+	for _, l := range logs {
+		if sigs, ok := lp.cachedAnonymous[l.Address]; ok {
+			if _, ok := sigs[l.EventSig]; !ok || len(l.Topics) == 0 {
+				l.EventSig = AnonymousEventSig
+			}
+		} else if len(l.Topics) == 0 || !slices.Contains(lp.cachedEventSigs, l.EventSig) {
+			continue
+		}
+		matched = append(matched, l)
+	}
+	return matched
 }
 
 // Replay signals that the poller should resume from a new block.
@@ -445,7 +551,7 @@ func (lp *logPoller) Replay(ctx context.Context, fromBlock int64) (err error) {
 		return err
 	}
 	if fromBlock <= savedFinalizedBlockNumber {
-		err = lp.backfill(ctx, fromBlock, savedFinalizedBlockNumber)
+		err = lp.replayBackfill(ctx, fromBlock, savedFinalizedBlockNumber)
 		if err != nil {
 			return err
 		}
@@ -528,6 +634,7 @@ func (lp *logPoller) Close() error {
 		}
 		close(lp.stopCh)
 		lp.wg.Wait()
+		lp.subs.closeAll()
 		return nil
 	})
 }
@@ -543,8 +650,21 @@ func (lp *logPoller) Name() string {
 	return lp.lggr.Name()
 }
 
+// HealthReport includes the logs discrepancies found by VerifyFinalizedLogs, reported as ErrLogsIncomplete until a
+// backfill succeeds. Finality violations detected by the head tracker are reported too, so that contract readers stop
+// serving finalized data from a reorged chain. The progress of backfills is not a health issue, it is reported by the
+// log_poller_backfill metrics and logs instead.
 func (lp *logPoller) HealthReport() map[string]error {
-	return map[string]error{lp.Name(): lp.Healthy()}
+	report := map[string]error{lp.Name(): lp.Healthy()}
+	if mismatch := lp.logsMismatch.Load(); mismatch != nil {
+		report[lp.Name()+".LogsVerification"] = mismatch
+	}
+	if detector, ok := lp.headTracker.(heads.FinalityViolationDetector); ok {
+		if violation := detector.FinalityViolation(); violation != nil {
+			report[lp.Name()+".FinalityViolation"] = *violation
+		}
+	}
+	return report
 }
 
 func (lp *logPoller) GetReplayFromBlock(ctx context.Context, requested int64) (int64, error) {
@@ -591,6 +711,10 @@ func (lp *logPoller) lockAndLoadFilters(ctx context.Context) (filters map[string
 	if err != nil {
 		return filters, err
 	}
+	for name, filter := range filters {
+		filter.Events = lp.filters[name].Events
+		filters[name] = filter
+	}
 
 	lp.filters = filters
 	lp.filterDirty = true
@@ -750,6 +874,9 @@ func (lp *logPoller) backgroundWorkerRun() {
 				lp.lggr.Debugw("finished pruning expired logs")
 				successfulExpiredLogPrunes++
 			}
+			if err := lp.refreshFilterStorageStats(ctx); err != nil {
+				lp.lggr.Errorw("unable to refresh filter storage stats", "err", err)
+			}
 		}
 	}
 }
@@ -842,6 +969,12 @@ func convertLogs(logs []types.Log, blocks []Block, lggr logger.Logger, chainID *
 		if i == 0 || len(blocks) == len(logs) {
 			blockTimestamp = blocks[i].BlockTimestamp
 		}
+		// The first topic is the event signature, unless the event is anonymous. Logs without topics can only be
+		// anonymous, anonymous logs with topics are identified by matchAnonymousLogs.
+		eventSig := AnonymousEventSig
+		if len(l.Topics) > 0 {
+			eventSig = l.Topics[0]
+		}
 		lgs = append(lgs, Log{
 			EVMChainID: ubig.New(chainID),
 			LogIndex:   int64(l.Index),
@@ -850,7 +983,7 @@ func convertLogs(logs []types.Log, blocks []Block, lggr logger.Logger, chainID *
 			// in many places.
 			BlockNumber:    int64(l.BlockNumber),
 			BlockTimestamp: blockTimestamp,
-			EventSig:       l.Topics[0], // First topic is always event signature.
+			EventSig:       eventSig,
 			Topics:         convertTopics(l.Topics),
 			Address:        l.Address,
 			TxHash:         l.TxHash,
@@ -892,53 +1025,6 @@ func (lp *logPoller) blocksFromFinalizedLogs(ctx context.Context, logs []types.L
 	return blocks, nil
 }
 
-// backfill will query FilterLogs in batches for logs in the
-// block range [start, end] and save them to the db.
-func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
-	batchSize := lp.backfillBatchSize
-	for from := start; from <= end; from += batchSize {
-		to := mathutil.Min(from+batchSize-1, end)
-
-		gethLogs, err := lp.latencyMonitor.FilterLogs(ctx, lp.Filter(big.NewInt(from), big.NewInt(to), nil))
-		if err != nil {
-			if !client.IsTooManyResults(err, lp.clientErrors) {
-				lp.lggr.Errorw("Unable to query for logs", "err", err, "from", from, "to", to)
-				return err
-			}
-
-			if batchSize == 1 {
-				lp.lggr.Criticalw("Too many log results in a single block, failed to retrieve logs! Node may be running in a degraded state.", "err", err, "from", from, "to", to, "LogBackfillBatchSize", lp.backfillBatchSize)
-				return err
-			}
-			batchSize /= 2
-			lp.lggr.Warnw("Too many log results, halving block range batch size.  Consider increasing LogBackfillBatchSize if this happens frequently", "err", err, "from", from, "to", to, "newBatchSize", batchSize, "LogBackfillBatchSize", lp.backfillBatchSize)
-			from -= batchSize // counteract +=batchSize on next loop iteration, so starting block does not change
-			continue
-		}
-		if len(gethLogs) == 0 {
-			continue
-		}
-		blocks, err := lp.blocksFromFinalizedLogs(ctx, gethLogs, uint64(to)) //nolint:gosec // G115
-		if err != nil {
-			return err
-		}
-
-		endblock := blocks[len(blocks)-1]
-		if gethLogs[len(gethLogs)-1].BlockNumber != uint64(to) {
-			// Pop endblock if there were no logs for it, so that length of blocks & gethLogs are the same to pass to convertLogs
-			blocks = blocks[:len(blocks)-1]
-		}
-
-		lp.lggr.Debugw("Backfill found logs", "from", from, "to", to, "logs", len(gethLogs), "blocks", blocks)
-		err = lp.orm.InsertLogsWithBlock(ctx, convertLogs(gethLogs, blocks, lp.lggr, lp.ec.ConfiguredChainID()), endblock)
-		if err != nil {
-			lp.lggr.Warnw("Unable to insert logs, retrying", "err", err, "from", from, "to", to)
-			return err
-		}
-	}
-	return nil
-}
-
 // getCurrentBlockMaybeHandleReorg accepts a block number
 // and will return that block if its parent points to our last saved block.
 // One can optionally pass the block header if it has already been queried to avoid an extra RPC call.
@@ -1004,6 +1090,7 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 			// We return an error here which will cause us to restart polling from lastBlockSaved + 1
 			return nil, err2
 		}
+		lp.subs.publishReorg(blockAfterLCA.Number)
 		return blockAfterLCA, nil
 	}
 	// No reorg, return current block.
@@ -1105,15 +1192,14 @@ func (lp *logPoller) pollAndSaveLogs(ctx context.Context, currentBlockNumber int
 			BlockTimestamp:       currentBlock.Timestamp,
 			FinalizedBlockNumber: latestFinalizedBlockNumber,
 		}
-		err = lp.orm.InsertLogsWithBlock(
-			ctx,
-			convertLogs(logs, []Block{block}, lp.lggr, lp.ec.ConfiguredChainID()),
-			block,
-		)
+		converted := lp.matchAnonymousLogs(convertLogs(logs, []Block{block}, lp.lggr, lp.ec.ConfiguredChainID()))
+		err = lp.orm.InsertLogsWithBlock(ctx, converted, block)
 		if err != nil {
 			lp.lggr.Warnw("Unable to save logs resuming from last saved block + 1", "err", err, "block", currentBlockNumber)
 			return nil
 		}
+		lp.recordLogsPerBlock(converted)
+		lp.subs.publishLogs(converted, currentBlockNumber)
 		// Update current block.
 		// Same reorg detection on unfinalized blocks.
 		currentBlockNumber++
@@ -1212,11 +1298,14 @@ func (lp *logPoller) PruneOldBlocks(ctx context.Context) (bool, error) {
 func (lp *logPoller) PruneExpiredLogs(ctx context.Context) (bool, error) {
 	done := true
 
-	rowsRemoved, err := lp.orm.DeleteExpiredLogs(ctx, lp.logPrunePageSize)
+	expired, err := lp.orm.DeleteExpiredLogs(ctx, lp.logPrunePageSize)
 	if err != nil {
 		lp.lggr.Errorw("Unable to find excess logs for pruning", "err", err)
 		return false, err
-	} else if lp.logPrunePageSize != 0 && rowsRemoved == lp.logPrunePageSize {
+	}
+	lp.recordPrunedLogs(expired)
+	rowsRemoved := countPrunedLogs(expired)
+	if lp.logPrunePageSize != 0 && rowsRemoved == lp.logPrunePageSize {
 		done = false
 	}
 
@@ -1229,13 +1318,16 @@ func (lp *logPoller) PruneExpiredLogs(ctx context.Context) (bool, error) {
 		lp.lggr.Errorw("Unable to find excess logs for pruning", "err", err)
 		return false, err
 	}
-	rowsRemoved, err = lp.orm.DeleteLogsByRowID(ctx, rowIDs)
+	excess, err := lp.orm.DeleteExcessLogs(ctx, rowIDs)
 	if err != nil {
 		lp.lggr.Errorw("Unable to prune excess logs", "err", err)
-	} else if lp.logPrunePageSize != 0 && rowsRemoved == lp.logPrunePageSize {
+		return false, err
+	}
+	lp.recordPrunedLogs(excess)
+	if rowsRemoved = countPrunedLogs(excess); lp.logPrunePageSize != 0 && rowsRemoved == lp.logPrunePageSize {
 		done = false
 	}
-	return done, err
+	return done, nil
 }
 
 // PruneUnmatchedLogs will attempt to remove any logs which no longer match a registered filter. Returns whether all unmatched
@@ -1620,7 +1712,11 @@ func (lp *logPoller) IndexedLogsWithSigsExcluding(ctx context.Context, address c
 
 // DeleteLogsAndBlocksAfter - removes blocks and logs starting from the specified block
 func (lp *logPoller) DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error {
-	return lp.orm.DeleteLogsAndBlocksAfter(ctx, start)
+	if err := lp.orm.DeleteLogsAndBlocksAfter(ctx, start); err != nil {
+		return err
+	}
+	lp.subs.publishReorg(start)
+	return nil
 }
 
 func (lp *logPoller) FindLCA(ctx context.Context) (*Block, error) {
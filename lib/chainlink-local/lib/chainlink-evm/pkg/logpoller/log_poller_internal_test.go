@@ -2,21 +2,25 @@ package logpoller
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	pkgerrors "github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -29,11 +33,13 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/client/clienttest"
+	"github.com/smartcontractkit/chainlink-evm/pkg/heads"
 	"github.com/smartcontractkit/chainlink-evm/pkg/heads/headstest"
 	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller/internal/log_emitter"
 	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
 	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
 	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 )
 
 var (
@@ -172,6 +178,10 @@ func TestLogPoller_ConvertLogs(t *testing.T) {
 			[]types.Log{},
 			[]Block{},
 			0},
+		{"NoTopics",
+			[]types.Log{{}},
+			[]Block{{BlockTimestamp: time.Now()}},
+			1},
 		{"TooManyBlocks",
 			[]types.Log{{}},
 			[]Block{{}, {}},
@@ -197,6 +207,48 @@ func TestLogPoller_ConvertLogs(t *testing.T) {
 	}
 }
 
+func TestLogPoller_AnonymousFilter(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	lggr := logger.Test(t)
+	lp := NewLogPoller(NewMemoryORM(big.NewInt(53)), nil, lggr, nil, Opts{PollPeriod: time.Second})
+
+	a1 := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbb")
+	a2 := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbc")
+	log1 := EmitterABI.Events["Log1"].ID
+	log2 := EmitterABI.Events["Log2"].ID
+	require.NoError(t, lp.RegisterFilter(ctx, Filter{Name: "emitter", EventSigs: []common.Hash{log1}, Addresses: []common.Address{a1}}))
+	assert.Equal(t, [][]common.Hash{{log1}}, lp.Filter(nil, nil, nil).Topics)
+
+	require.NoError(t, lp.RegisterFilter(ctx, Filter{Name: "anonymous", EventSigs: []common.Hash{AnonymousEventSig, log2}, Addresses: []common.Address{a2}}))
+	f := lp.Filter(nil, nil, nil)
+	assert.Equal(t, []common.Address{a1, a2}, f.Addresses)
+	assert.Nil(t, f.Topics, "topic0 of anonymous events is not restricted")
+
+	other := common.HexToHash("0x1234")
+	logs := convertLogs([]types.Log{
+		{Address: a1, Topics: []common.Hash{log1}, Index: 0},
+		{Address: a1, Topics: []common.Hash{other}, Index: 1},
+		{Address: a1, Index: 2},
+		{Address: a2, Topics: []common.Hash{log2}, Index: 3},
+		{Address: a2, Topics: []common.Hash{log1, other}, Index: 4},
+		{Address: a2, Index: 5},
+	}, []Block{{BlockTimestamp: time.Now()}}, lggr, big.NewInt(53))
+	logs = lp.matchAnonymousLogs(logs)
+	require.Len(t, logs, 4)
+	for i, expected := range []struct {
+		index    int64
+		eventSig common.Hash
+	}{{0, log1}, {3, log2}, {4, AnonymousEventSig}, {5, AnonymousEventSig}} {
+		assert.Equal(t, expected.index, logs[i].LogIndex)
+		assert.Equal(t, expected.eventSig, logs[i].EventSig)
+	}
+	assert.Equal(t, []common.Hash{log1, other}, logs[2].GetTopics(), "topics of anonymous logs are kept")
+
+	require.NoError(t, lp.UnregisterFilter(ctx, "anonymous"))
+	assert.Equal(t, [][]common.Hash{{log1}}, lp.Filter(nil, nil, nil).Topics)
+}
+
 func TestFilterName(t *testing.T) {
 	t.Parallel()
 	assert.Equal(t, "a - b:c:d", FilterName("a", "b", "c", "d"))
@@ -765,6 +817,312 @@ func Test_PollAndSaveLogs_BackfillFinalityViolation(t *testing.T) {
 	})
 }
 
+// finalityViolationTracker is a head tracker which detected a finality violation.
+type finalityViolationTracker struct {
+	HeadTracker
+	violation *heads.FinalityViolation
+}
+
+func (f *finalityViolationTracker) FinalityViolation() *heads.FinalityViolation { return f.violation }
+
+func (f *finalityViolationTracker) SubscribeFinalityViolations() (<-chan heads.FinalityViolation, func()) {
+	return make(chan heads.FinalityViolation), func() {}
+}
+
+func (f *finalityViolationTracker) AcknowledgeFinalityViolation(blockNumber int64) bool {
+	if f.violation == nil || f.violation.BlockNumber != blockNumber {
+		return false
+	}
+	f.violation = nil
+	return true
+}
+
+func TestLogPoller_HealthReport_HeadTrackerFinalityViolation(t *testing.T) {
+	t.Parallel()
+	lggr := logger.Test(t)
+	headTracker := &finalityViolationTracker{HeadTracker: headstest.NewTracker[*evmtypes.Head, common.Hash](t)}
+	lp := NewLogPoller(NewMemoryORM(testutils.NewRandomEVMChainID()), clienttest.NewClient(t), lggr, headTracker, Opts{PollPeriod: time.Second})
+	require.False(t, services.ContainsError(lp.HealthReport(), commontypes.ErrFinalityViolated))
+
+	headTracker.violation = &heads.FinalityViolation{BlockNumber: 5, FinalizedHash: utils.NewHash(), CanonicalHash: utils.NewHash()}
+	report := lp.HealthReport()
+	require.ErrorIs(t, report[lp.Name()+".FinalityViolation"], commontypes.ErrFinalityViolated)
+	require.True(t, services.ContainsError(report, commontypes.ErrFinalityViolated))
+
+	require.True(t, headTracker.AcknowledgeFinalityViolation(5))
+	require.False(t, services.ContainsError(lp.HealthReport(), commontypes.ErrFinalityViolated))
+}
+
+func TestLogPoller_ReplayBackfillCheckpoint(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	lggr := logger.Test(t)
+	chainID := testutils.NewRandomEVMChainID()
+	orm := NewMemoryORM(chainID)
+	addr := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbc")
+	eventSig := EmitterABI.Events["Log1"].ID
+
+	ec := clienttest.NewClient(t)
+	ec.EXPECT().ConfiguredChainID().Return(chainID)
+	ec.EXPECT().BatchCallContext(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, elems []rpc.BatchElem) error {
+		for _, e := range elems {
+			num := int64(200) // latest and finalized
+			if n, err := hexutil.DecodeUint64(e.Args[0].(string)); err == nil {
+				num = int64(n) //nolint:gosec // G115
+			}
+			*e.Result.(*evmtypes.Head) = newHeadVal(num)
+		}
+		return nil
+	})
+	var (
+		mu       sync.Mutex
+		fetched  []int64
+		failFrom atomic.Int64
+	)
+	failFrom.Store(41)
+	ec.EXPECT().FilterLogs(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fq ethereum.FilterQuery) ([]types.Log, error) {
+		from := fq.FromBlock.Int64()
+		if from == failFrom.Load() {
+			return nil, errors.New("connection reset")
+		}
+		mu.Lock()
+		fetched = append(fetched, from)
+		mu.Unlock()
+		return []types.Log{{Address: addr, Topics: []common.Hash{eventSig}, BlockNumber: uint64(from), BlockHash: common.BigToHash(big.NewInt(from))}}, nil //nolint:gosec // G115
+	})
+
+	lp := NewLogPoller(orm, ec, lggr, nil, Opts{PollPeriod: time.Hour, BackfillBatchSize: 10, BackfillConcurrency: 4, RPCBatchSize: 10})
+	require.NoError(t, lp.RegisterFilter(ctx, Filter{Name: "replay", EventSigs: []common.Hash{eventSig}, Addresses: []common.Address{addr}}))
+
+	// the batches after the failed one may be fetched concurrently, but are not saved
+	require.ErrorContains(t, lp.replayBackfill(ctx, 1, 100), "connection reset")
+	checkpoint, err := orm.SelectBackfillCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), checkpoint.FromBlock)
+	assert.Equal(t, int64(41), checkpoint.NextBlock)
+	latest, err := orm.SelectLatestBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(40), latest.BlockNumber)
+	assert.Nil(t, lp.backfillProgress.Load())
+
+	// a replay from a block within the checkpoint resumes from it
+	failFrom.Store(0)
+	fetched = nil
+	require.NoError(t, lp.replayBackfill(ctx, 5, 100))
+	slices.Sort(fetched)
+	assert.Equal(t, []int64{41, 51, 61, 71, 81, 91}, fetched)
+	logs, err := orm.SelectLogsByBlockRange(ctx, 1, 100)
+	require.NoError(t, err)
+	assert.Len(t, logs, 10)
+	_, err = orm.SelectBackfillCheckpoint(ctx)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestDSORM_BackfillCheckpoint(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	chainID := testutils.NewRandomEVMChainID()
+	orm := NewORM(chainID, nil, logger.Test(t))
+
+	_, err := orm.SelectBackfillCheckpoint(ctx)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// the checkpoint is shared with the copies of the ORM used in transactions
+	require.NoError(t, orm.new(nil).UpsertBackfillCheckpoint(ctx, BackfillCheckpoint{FromBlock: 1, NextBlock: 41}))
+	checkpoint, err := orm.SelectBackfillCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(41), checkpoint.NextBlock)
+	assert.Equal(t, chainID, checkpoint.EVMChainID.ToInt())
+
+	require.NoError(t, orm.DeleteBackfillCheckpoint(ctx))
+	_, err = orm.new(nil).SelectBackfillCheckpoint(ctx)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestLogPoller_VerifyFinalizedLogs(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	chainID := testutils.NewRandomEVMChainID()
+	addr := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbc")
+	eventSig := EmitterABI.Events["Log1"].ID
+	newLog := func(num int64) types.Log {
+		return types.Log{Address: addr, Topics: []common.Hash{eventSig}, BlockNumber: uint64(num), BlockHash: common.BigToHash(big.NewInt(num))} //nolint:gosec // G115
+	}
+	var matchingBloom types.Bloom
+	matchingBloom.Add(addr.Bytes())
+	matchingBloom.Add(eventSig.Bytes())
+
+	// blocks 5 and 15 have a log, block 10 is a bloom false positive
+	newClient := func(t *testing.T) *clienttest.Client {
+		ec := clienttest.NewClient(t)
+		ec.EXPECT().ConfiguredChainID().Return(chainID).Maybe()
+		ec.EXPECT().BatchCallContext(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, elems []rpc.BatchElem) error {
+			for _, e := range elems {
+				num := int64(200) // latest and finalized
+				if n, err := hexutil.DecodeUint64(e.Args[0].(string)); err == nil {
+					num = int64(n) //nolint:gosec // G115
+				}
+				switch result := e.Result.(type) {
+				case *evmtypes.Head:
+					*result = newHeadVal(num)
+				case *blockBloom:
+					*result = blockBloom{Number: hexutil.Uint64(num), Hash: common.BigToHash(big.NewInt(num))} //nolint:gosec // G115
+					if num == 5 || num == 10 || num == 15 {
+						result.Bloom = matchingBloom
+					}
+				}
+			}
+			return nil
+		}).Maybe()
+		return ec
+	}
+	// the primary RPC omits the log of block 15 the first partialResponses times
+	newPrimary := func(t *testing.T, partialResponses int) *clienttest.Client {
+		ec := newClient(t)
+		var calls atomic.Int64
+		ec.EXPECT().FilterLogs(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fq ethereum.FilterQuery) ([]types.Log, error) {
+			if calls.Add(1) <= int64(partialResponses) {
+				return []types.Log{newLog(5)}, nil
+			}
+			return []types.Log{newLog(5), newLog(15)}, nil
+		})
+		return ec
+	}
+	newLogPoller := func(ec Client, verificationClient Client) (*logPoller, ORM) {
+		orm := NewMemoryORM(chainID)
+		lp := NewLogPoller(orm, ec, logger.Test(t), nil, Opts{PollPeriod: time.Hour, BackfillBatchSize: 20, RPCBatchSize: 8, VerifyFinalizedLogs: true, VerificationClient: verificationClient})
+		require.NoError(t, lp.RegisterFilter(ctx, Filter{Name: "verify", EventSigs: []common.Hash{eventSig}, Addresses: []common.Address{addr}}))
+		return lp, orm
+	}
+
+	t.Run("re-fetches partial logs detected by bloom and accepts bloom false positives", func(t *testing.T) {
+		ec := newPrimary(t, 1)
+		lp, orm := newLogPoller(ec, nil)
+		require.NoError(t, lp.backfill(ctx, 1, 20))
+		logs, err := orm.SelectLogsByBlockRange(ctx, 1, 20)
+		require.NoError(t, err)
+		assert.Len(t, logs, 2)
+		ec.AssertNumberOfCalls(t, "FilterLogs", 2)
+		assert.NoError(t, lp.HealthReport()[lp.Name()+".LogsVerification"])
+	})
+
+	t.Run("re-fetches partial logs detected by verification RPC", func(t *testing.T) {
+		verificationClient := newClient(t)
+		verificationClient.EXPECT().FilterLogs(mock.Anything, mock.Anything).Return([]types.Log{newLog(5), newLog(15)}, nil)
+		ec := newPrimary(t, 2)
+		lp, orm := newLogPoller(ec, verificationClient)
+		require.NoError(t, lp.backfill(ctx, 1, 20))
+		logs, err := orm.SelectLogsByBlockRange(ctx, 1, 20)
+		require.NoError(t, err)
+		assert.Len(t, logs, 2)
+		ec.AssertNumberOfCalls(t, "FilterLogs", 3)
+	})
+
+	t.Run("reports persistent mismatch", func(t *testing.T) {
+		verificationClient := newClient(t)
+		verificationClient.EXPECT().FilterLogs(mock.Anything, mock.Anything).Return([]types.Log{newLog(5), newLog(15)}, nil)
+		lp, orm := newLogPoller(newPrimary(t, maxLogsRefetches+1), verificationClient)
+		err := lp.backfill(ctx, 1, 20)
+		require.ErrorIs(t, err, ErrLogsIncomplete)
+		assert.ErrorContains(t, err, "log count differs from verification RPC in blocks [15]")
+		_, err = orm.SelectLatestBlock(ctx)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		require.ErrorIs(t, lp.HealthReport()[lp.Name()+".LogsVerification"], ErrLogsIncomplete)
+
+		// the mismatch is cleared once the RPC returns complete logs again
+		require.NoError(t, lp.backfill(ctx, 1, 20))
+		assert.NoError(t, lp.HealthReport()[lp.Name()+".LogsVerification"])
+	})
+}
+
+func TestLogPoller_FilterStorageReport(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	chainID := testutils.NewRandomEVMChainID()
+	orm := NewMemoryORM(chainID)
+	ec := clienttest.NewClient(t)
+	ec.EXPECT().ConfiguredChainID().Return(chainID)
+	lp := NewLogPoller(orm, ec, logger.Test(t), nil, Opts{PollPeriod: time.Hour})
+
+	eventSig := EmitterABI.Events["Log1"].ID
+	expiring := common.HexToAddress("0x1234")
+	capped := common.HexToAddress("0x1235")
+	require.NoError(t, lp.RegisterFilter(ctx, Filter{Name: "expiring", Addresses: []common.Address{expiring}, EventSigs: []common.Hash{eventSig}, Retention: time.Millisecond, LogsPerBlock: 1}))
+	require.NoError(t, lp.RegisterFilter(ctx, Filter{Name: "capped", Addresses: []common.Address{capped}, EventSigs: []common.Hash{eventSig}, MaxLogsKept: 1}))
+
+	timestamp := time.Now().Add(-time.Hour)
+	for num := int64(1); num <= 5; num++ {
+		blockHash := common.BigToHash(big.NewInt(num))
+		logs := []Log{
+			{EVMChainID: ubig.New(chainID), LogIndex: 0, BlockHash: blockHash, BlockNumber: num, BlockTimestamp: timestamp, EventSig: eventSig, Topics: [][]byte{eventSig.Bytes()}, Address: expiring},
+			{EVMChainID: ubig.New(chainID), LogIndex: 1, BlockHash: blockHash, BlockNumber: num, BlockTimestamp: timestamp, EventSig: eventSig, Topics: [][]byte{eventSig.Bytes()}, Address: expiring},
+			{EVMChainID: ubig.New(chainID), LogIndex: 2, BlockHash: blockHash, BlockNumber: num, BlockTimestamp: timestamp, EventSig: eventSig, Topics: [][]byte{eventSig.Bytes()}, Address: capped},
+		}
+		require.NoError(t, orm.InsertLogsWithBlock(ctx, logs, Block{BlockHash: blockHash, BlockNumber: num, BlockTimestamp: timestamp, FinalizedBlockNumber: num - 2}))
+		lp.recordLogsPerBlock(logs)
+	}
+
+	// the storage stats are empty until the first log pruning cycle
+	report, err := lp.FilterStorageReport(ctx)
+	require.NoError(t, err)
+	require.Len(t, report, 2)
+	assert.Equal(t, FilterStorageStats{Name: "capped"}, report[0].FilterStorageStats)
+	assert.True(t, report[0].StatsUpdatedAt.IsZero())
+
+	done, err := lp.PruneExpiredLogs(ctx)
+	require.NoError(t, err)
+	assert.True(t, done)
+	require.NoError(t, lp.refreshFilterStorageStats(ctx))
+
+	report, err = lp.FilterStorageReport(ctx)
+	require.NoError(t, err)
+	require.Len(t, report, 2)
+	assert.False(t, report[0].StatsUpdatedAt.IsZero())
+	assert.Equal(t, "capped", report[0].Name)
+	assert.Equal(t, uint64(1), report[0].MaxLogsKept)
+	// only the logs of the 3 finalized blocks are pruned down to MaxLogsKept
+	assert.Equal(t, int64(2), report[0].PrunedLogs)
+	assert.Equal(t, int64(3), report[0].LogCount)
+	assert.Equal(t, FilterStorageReport{
+		FilterStorageStats: FilterStorageStats{Name: "expiring"},
+		StatsUpdatedAt:     report[1].StatsUpdatedAt,
+		Retention:          time.Millisecond,
+		LogsPerBlock:       1,
+		PrunedLogs:         10,
+		// every block had 2 expiring logs
+		LogsPerBlockExceeded: 5,
+	}, report[1])
+
+	assert.InDelta(t, 10, testutil.ToFloat64(promLpFilterPrunedLogs.WithLabelValues(chainID.String(), "expiring")), 0)
+	assert.InDelta(t, 2, testutil.ToFloat64(promLpFilterPrunedLogs.WithLabelValues(chainID.String(), "capped")), 0)
+
+	// the pruned logs are accumulated across cycles
+	lp.recordPrunedLogs([]PrunedLogs{{Address: expiring, EventSig: eventSig, Count: 3}})
+	report, err = lp.FilterStorageReport(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(13), report[1].PrunedLogs)
+	assert.InDelta(t, 13, testutil.ToFloat64(promLpFilterPrunedLogs.WithLabelValues(chainID.String(), "expiring")), 0)
+
+	require.NoError(t, lp.UnregisterFilter(ctx, "expiring"))
+	report, err = lp.FilterStorageReport(ctx)
+	require.NoError(t, err)
+	require.Len(t, report, 1)
+	assert.Equal(t, "capped", report[0].Name)
+	// the metrics of the unregistered filter are removed
+	assert.False(t, promLpFilterPrunedLogs.DeleteLabelValues(chainID.String(), "expiring"))
+	assert.False(t, promLpFilterLogsPerBlockExceeded.DeleteLabelValues(chainID.String(), "expiring"))
+}
+
+func TestBackfillProgress(t *testing.T) {
+	progress := BackfillProgress{From: 1, To: 1000, NextBlock: 1, StartedAt: time.Now().Add(-10 * time.Second)}
+	assert.Zero(t, progress.ETA())
+
+	progress.NextBlock = 101
+	assert.InDelta(t, 10, progress.BlocksPerSecond(), 0.1)
+	assert.InDelta(t, 90*time.Second, progress.ETA(), float64(time.Second))
+	assert.Contains(t, progress.String(), "next block 101")
+}
+
 func benchmarkFilter(b *testing.B, nFilters, nAddresses, nEvents int) {
 	lggr := logger.Test(b)
 	lpOpts := Opts{
@@ -0,0 +1,238 @@
+package logpoller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var promLpLogsMismatches = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "log_poller_logs_verification_mismatches",
+	Help: "Number of finalized block ranges whose logs did not match the block blooms or the verification RPC",
+}, []string{"chainID"})
+
+// ErrLogsIncomplete is reported by HealthReport when the logs returned by the RPC for finalized blocks still do not
+// match the block blooms or the verification RPC after being re-fetched.
+var ErrLogsIncomplete = errors.New("incomplete logs returned by RPC")
+
+// maxLogsRefetches is the number of times the logs of a range are re-fetched before giving up on a mismatch.
+const maxLogsRefetches = 2
+
+// logsMismatch lists the blocks of [from, to] whose logs do not match their bloom or the verification RPC.
+type logsMismatch struct {
+	from, to int64
+	// notInBloom are the blocks with logs whose address or topics are not in the block bloom, or whose hash
+	// does not match the block
+	notInBloom []uint64
+	// bloomOnly are the blocks whose bloom matches a filter without any log returned for it. Blooms have false
+	// positives, so these are only re-fetched once and accepted if the RPC returns the same logs again.
+	bloomOnly []uint64
+	// countMismatch are the blocks with a different number of logs on the verification RPC
+	countMismatch []uint64
+}
+
+func (m *logsMismatch) empty() bool {
+	return len(m.notInBloom) == 0 && len(m.bloomOnly) == 0 && len(m.countMismatch) == 0
+}
+
+func (m *logsMismatch) Error() string {
+	var reasons []string
+	if len(m.notInBloom) > 0 {
+		reasons = append(reasons, fmt.Sprintf("logs not in block bloom in blocks %v", m.notInBloom))
+	}
+	if len(m.bloomOnly) > 0 {
+		reasons = append(reasons, fmt.Sprintf("block bloom matches filters without logs in blocks %v", m.bloomOnly))
+	}
+	if len(m.countMismatch) > 0 {
+		reasons = append(reasons, fmt.Sprintf("log count differs from verification RPC in blocks %v", m.countMismatch))
+	}
+	return fmt.Sprintf("%s for blocks %d-%d: %s", ErrLogsIncomplete, m.from, m.to, strings.Join(reasons, ", "))
+}
+
+func (m *logsMismatch) Unwrap() error {
+	return ErrLogsIncomplete
+}
+
+// verifyLogs checks the logs of the finalized blocks [from, to] against the block blooms, and against the logs
+// returned by the verification RPC if there is one. The logs are re-fetched on mismatch, and if the mismatch
+// persists it is returned and reported by HealthReport.
+func (lp *logPoller) verifyLogs(ctx context.Context, from, to int64, logs []types.Log, batchSize *atomic.Int64) ([]types.Log, error) {
+	for attempt := 0; ; attempt++ {
+		mismatch, err := lp.findLogsMismatch(ctx, from, to, logs, batchSize)
+		if err != nil {
+			return nil, err
+		}
+		if mismatch.empty() {
+			return logs, nil
+		}
+		if attempt > 0 && len(mismatch.notInBloom) == 0 && len(mismatch.countMismatch) == 0 {
+			lp.lggr.Debugw("Block blooms match filters without logs after re-fetching, assuming bloom false positives", "from", from, "to", to, "blocks", mismatch.bloomOnly)
+			return logs, nil
+		}
+
+		promLpLogsMismatches.WithLabelValues(lp.ec.ConfiguredChainID().String()).Inc()
+		if attempt == maxLogsRefetches {
+			lp.lggr.Criticalw("Logs returned by RPC still do not match after re-fetching! RPC may be returning partial eth_getLogs results.", "err", mismatch)
+			lp.logsMismatch.Store(mismatch)
+			return nil, mismatch
+		}
+		lp.lggr.Warnw("Logs returned by RPC do not match, re-fetching", "err", mismatch, "attempt", attempt+1)
+		if logs, err = lp.filterLogsRange(ctx, lp.latencyMonitor.FilterLogs, from, to, batchSize); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// findLogsMismatch compares the logs of [from, to] with the block blooms and the verification RPC.
+func (lp *logPoller) findLogsMismatch(ctx context.Context, from, to int64, logs []types.Log, batchSize *atomic.Int64) (*logsMismatch, error) {
+	mismatch := &logsMismatch{from: from, to: to}
+	blooms, err := lp.fetchBlockBlooms(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch block blooms: %w", err)
+	}
+
+	byBlock := make(map[uint64][]types.Log)
+	for _, log := range logs {
+		byBlock[log.BlockNumber] = append(byBlock[log.BlockNumber], log)
+	}
+	addressSigs, anonymous := lp.filterAddressSigs()
+	for num := uint64(from); num <= uint64(to); num++ { //nolint:gosec // G115
+		block := blooms[num]
+		if !logsInBloom(block, byBlock[num]) {
+			mismatch.notInBloom = append(mismatch.notInBloom, num)
+		} else if bloomMatchesMissingLogs(block.Bloom, byBlock[num], addressSigs, anonymous) {
+			mismatch.bloomOnly = append(mismatch.bloomOnly, num)
+		}
+	}
+
+	if lp.verificationClient != nil {
+		verificationLogs, err := lp.filterLogsRange(ctx, lp.verificationClient.FilterLogs, from, to, batchSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch logs from verification RPC: %w", err)
+		}
+		counts := make(map[uint64]int)
+		for _, log := range verificationLogs {
+			counts[log.BlockNumber]++
+		}
+		for num, blockLogs := range byBlock {
+			if counts[num] != len(blockLogs) {
+				mismatch.countMismatch = append(mismatch.countMismatch, num)
+			}
+		}
+		for num := range counts {
+			if _, ok := byBlock[num]; !ok {
+				mismatch.countMismatch = append(mismatch.countMismatch, num)
+			}
+		}
+		slices.Sort(mismatch.countMismatch)
+	}
+	return mismatch, nil
+}
+
+// filterAddressSigs returns the event sigs of each filter address, and the event sigs of the addresses with an
+// anonymous filter. The returned maps are replaced rather than updated when the filters change.
+func (lp *logPoller) filterAddressSigs() (addressSigs, anonymous map[common.Address]map[common.Hash]struct{}) {
+	lp.Filter(nil, nil, nil)
+	lp.filterMu.RLock()
+	defer lp.filterMu.RUnlock()
+	return lp.cachedAddressSigs, lp.cachedAnonymous
+}
+
+// logsInBloom returns whether all the logs belong to the block and have their address and topics in its bloom.
+func logsInBloom(block blockBloom, logs []types.Log) bool {
+	for _, log := range logs {
+		if log.BlockHash != block.Hash || !types.BloomLookup(block.Bloom, log.Address) {
+			return false
+		}
+		for _, topic := range log.Topics {
+			if !types.BloomLookup(block.Bloom, topic) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// bloomMatchesMissingLogs returns whether the bloom contains a filter address and one of its event sigs, or any
+// address with an anonymous filter, without a log returned for it.
+func bloomMatchesMissingLogs(bloom types.Bloom, logs []types.Log, addressSigs, anonymous map[common.Address]map[common.Hash]struct{}) bool {
+	returned := make(map[common.Address]map[common.Hash]struct{})
+	for _, log := range logs {
+		if returned[log.Address] == nil {
+			returned[log.Address] = make(map[common.Hash]struct{})
+		}
+		if len(log.Topics) > 0 {
+			returned[log.Address][log.Topics[0]] = struct{}{}
+		}
+	}
+	for addr, sigs := range addressSigs {
+		if !types.BloomLookup(bloom, addr) {
+			continue
+		}
+		if _, ok := anonymous[addr]; ok {
+			if returned[addr] == nil {
+				return true
+			}
+			continue
+		}
+		for sig := range sigs {
+			if _, ok := returned[addr][sig]; !ok && types.BloomLookup(bloom, sig) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// blockBloom holds the fields of an eth_getBlockByNumber response needed to verify the logs of a block.
+type blockBloom struct {
+	Number hexutil.Uint64 `json:"number"`
+	Hash   common.Hash    `json:"hash"`
+	Bloom  types.Bloom    `json:"logsBloom"`
+}
+
+// fetchBlockBlooms fetches the blooms of the blocks [from, to] in batches of rpcBatchSize.
+func (lp *logPoller) fetchBlockBlooms(ctx context.Context, from, to int64) (map[uint64]blockBloom, error) {
+	batchSize := lp.rpcBatchSize
+	if batchSize <= 0 {
+		batchSize = to - from + 1
+	}
+	blooms := make(map[uint64]blockBloom, to-from+1)
+	for start := from; start <= to; start += batchSize {
+		end := min(start+batchSize-1, to)
+		reqs := make([]rpc.BatchElem, 0, end-start+1)
+		for num := start; num <= end; num++ {
+			reqs = append(reqs, rpc.BatchElem{
+				Method: "eth_getBlockByNumber",
+				Args:   []interface{}{hexutil.EncodeBig(big.NewInt(num)), false},
+				Result: &blockBloom{},
+			})
+		}
+		if err := lp.ec.BatchCallContext(ctx, reqs); err != nil {
+			return nil, err
+		}
+		for i, req := range reqs {
+			if req.Error != nil {
+				return nil, req.Error
+			}
+			block := *req.Result.(*blockBloom)
+			num := uint64(start) + uint64(i) //nolint:gosec // G115
+			if block.Hash == (common.Hash{}) || uint64(block.Number) != num {
+				return nil, fmt.Errorf("expected block %d but got block %d with hash %s", num, block.Number, block.Hash)
+			}
+			blooms[num] = block
+		}
+	}
+	return blooms, nil
+}
@@ -0,0 +1,987 @@
+package logpoller
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+)
+
+// MemoryORM keeps blocks, logs and filters in memory instead of Postgres. It follows the semantics of DSORM,
+// including the DSL of FilteredLogs and pruning, so the log poller can run on local devnets and in tests
+// without a database server. Nothing survives a restart, the log poller backfills from the chain instead.
+type MemoryORM struct {
+	chainID *big.Int
+
+	mu sync.RWMutex
+	// blocks are ordered by block number, unique by number and by hash.
+	blocks []Block
+	hashes map[common.Hash]struct{}
+	// logs are ordered by block number and log index, unique by block hash, block number and log index.
+	logs    []memoryLog
+	logKeys map[memoryLogKey]struct{}
+	lastID  uint64
+	filters map[memoryFilterKey]memoryFilterRow
+
+	checkpoint *BackfillCheckpoint
+}
+
+var _ ORM = &MemoryORM{}
+
+// NewMemoryORM creates a MemoryORM scoped to chainID.
+func NewMemoryORM(chainID *big.Int) *MemoryORM {
+	return &MemoryORM{
+		chainID: chainID,
+		hashes:  make(map[common.Hash]struct{}),
+		logKeys: make(map[memoryLogKey]struct{}),
+		filters: make(map[memoryFilterKey]memoryFilterRow),
+	}
+}
+
+// memoryLog is a log together with its row id, which is used by pruning.
+type memoryLog struct {
+	id  uint64
+	log Log
+}
+
+type memoryLogKey struct {
+	blockHash   common.Hash
+	blockNumber int64
+	logIndex    int64
+}
+
+type memoryTopic struct {
+	value common.Hash
+	set   bool
+}
+
+// memoryFilterKey identifies a row of a filter, filters are stored as one row per address, event and topic
+// combination like in evm.log_poller_filters.
+type memoryFilterKey struct {
+	name    string
+	address common.Address
+	event   common.Hash
+	topics  [3]memoryTopic
+}
+
+type memoryFilterRow struct {
+	memoryFilterKey
+	retention    time.Duration
+	maxLogsKept  uint64
+	logsPerBlock uint64
+}
+
+// InsertBlock is idempotent to support replays.
+func (o *MemoryORM) InsertBlock(_ context.Context, blockHash common.Hash, blockNumber int64, blockTimestamp time.Time, finalizedBlock int64) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.insertBlock(blockHash, blockNumber, blockTimestamp, finalizedBlock)
+	return nil
+}
+
+func (o *MemoryORM) insertBlock(blockHash common.Hash, blockNumber int64, blockTimestamp time.Time, finalizedBlock int64) {
+	if _, ok := o.hashes[blockHash]; ok {
+		return
+	}
+	i, found := slices.BinarySearchFunc(o.blocks, blockNumber, func(b Block, n int64) int {
+		return cmp.Compare(b.BlockNumber, n)
+	})
+	if found {
+		return
+	}
+
+	o.blocks = slices.Insert(o.blocks, i, Block{
+		EVMChainID:           ubig.New(o.chainID),
+		BlockHash:            blockHash,
+		BlockNumber:          blockNumber,
+		BlockTimestamp:       blockTimestamp,
+		FinalizedBlockNumber: finalizedBlock,
+		CreatedAt:            time.Now(),
+	})
+	o.hashes[blockHash] = struct{}{}
+}
+
+// InsertFilter is idempotent, inserting a filter again updates the retention and limits of its rows.
+func (o *MemoryORM) InsertFilter(_ context.Context, filter Filter) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	optional := func(values []common.Hash) []memoryTopic {
+		if len(values) == 0 {
+			return []memoryTopic{{}}
+		}
+		topics := make([]memoryTopic, len(values))
+		for i, v := range values {
+			topics[i] = memoryTopic{value: v, set: true}
+		}
+		return topics
+	}
+
+	for _, address := range filter.Addresses {
+		for _, event := range filter.EventSigs {
+			for _, topic2 := range optional(filter.Topic2) {
+				for _, topic3 := range optional(filter.Topic3) {
+					for _, topic4 := range optional(filter.Topic4) {
+						key := memoryFilterKey{
+							name:    filter.Name,
+							address: address,
+							event:   event,
+							topics:  [3]memoryTopic{topic2, topic3, topic4},
+						}
+						o.filters[key] = memoryFilterRow{
+							memoryFilterKey: key,
+							retention:       filter.Retention,
+							maxLogsKept:     filter.MaxLogsKept,
+							logsPerBlock:    filter.LogsPerBlock,
+						}
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// DeleteFilter removes all events,address pairs associated with the Filter
+func (o *MemoryORM) DeleteFilter(_ context.Context, name string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for key := range o.filters {
+		if key.name == name {
+			delete(o.filters, key)
+		}
+	}
+	return nil
+}
+
+func (o *MemoryORM) SelectBackfillCheckpoint(_ context.Context) (*BackfillCheckpoint, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	if o.checkpoint == nil {
+		return nil, sql.ErrNoRows
+	}
+	checkpoint := *o.checkpoint
+	return &checkpoint, nil
+}
+
+func (o *MemoryORM) UpsertBackfillCheckpoint(_ context.Context, checkpoint BackfillCheckpoint) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	checkpoint.EVMChainID = ubig.New(o.chainID)
+	checkpoint.UpdatedAt = time.Now()
+	o.checkpoint = &checkpoint
+	return nil
+}
+
+func (o *MemoryORM) DeleteBackfillCheckpoint(_ context.Context) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.checkpoint = nil
+	return nil
+}
+
+// SelectFilterStorageStats returns the storage stats of every filter, counting the logs up to and including toBlock.
+// Like pruning, logs are matched to filters by address and event sig only.
+func (o *MemoryORM) SelectFilterStorageStats(_ context.Context, toBlock int64) ([]FilterStorageStats, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	filters := o.loadFilters()
+	stats := make([]FilterStorageStats, 0, len(filters))
+	for _, f := range filters {
+		s := FilterStorageStats{Name: f.Name}
+		for _, l := range o.logs {
+			if l.log.BlockNumber > toBlock || !slices.Contains(f.Addresses, l.log.Address) || !slices.Contains(f.EventSigs, l.log.EventSig) {
+				continue
+			}
+			if s.LogCount == 0 || l.log.BlockNumber < s.OldestBlock {
+				s.OldestBlock = l.log.BlockNumber
+			}
+			s.NewestBlock = max(s.NewestBlock, l.log.BlockNumber)
+			s.LogCount++
+		}
+		stats = append(stats, s)
+	}
+	slices.SortFunc(stats, func(a, b FilterStorageStats) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return stats, nil
+}
+
+// LoadFilters returns all filters for this chain
+func (o *MemoryORM) LoadFilters(_ context.Context) (map[string]Filter, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.loadFilters(), nil
+}
+
+func (o *MemoryORM) loadFilters() map[string]Filter {
+	filters := make(map[string]Filter)
+	for _, row := range o.filters {
+		f := filters[row.name]
+		f.Name = row.name
+		f.Addresses = appendUnique(f.Addresses, row.address)
+		f.EventSigs = appendUnique(f.EventSigs, row.event)
+		topics := []*evmtypes.HashArray{&f.Topic2, &f.Topic3, &f.Topic4}
+		for i, topic := range row.topics {
+			if topic.set {
+				*topics[i] = appendUnique(*topics[i], topic.value)
+			}
+		}
+		f.Retention = max(f.Retention, row.retention)
+		f.MaxLogsKept = max(f.MaxLogsKept, row.maxLogsKept)
+		f.LogsPerBlock = max(f.LogsPerBlock, row.logsPerBlock)
+		filters[row.name] = f
+	}
+
+	for name, f := range filters {
+		slices.SortFunc(f.Addresses, func(a, b common.Address) int { return bytes.Compare(a[:], b[:]) })
+		for _, hashes := range [][]common.Hash{f.EventSigs, f.Topic2, f.Topic3, f.Topic4} {
+			slices.SortFunc(hashes, func(a, b common.Hash) int { return bytes.Compare(a[:], b[:]) })
+		}
+		filters[name] = f
+	}
+	return filters
+}
+
+func appendUnique[T comparable, S ~[]T](s S, v T) S {
+	if slices.Contains(s, v) {
+		return s
+	}
+	return append(s, v)
+}
+
+func (o *MemoryORM) latestBlock() (Block, bool) {
+	if len(o.blocks) == 0 {
+		return Block{}, false
+	}
+	return o.blocks[len(o.blocks)-1], true
+}
+
+// confirmedBlock returns the highest block number which has the given confirmations, false if there are no blocks yet.
+func (o *MemoryORM) confirmedBlock(confs evmtypes.Confirmations) (int64, bool) {
+	latest, ok := o.latestBlock()
+	if !ok {
+		return 0, false
+	}
+	if confs == evmtypes.Finalized {
+		return latest.FinalizedBlockNumber, true
+	}
+	return latest.BlockNumber - int64(confs), true
+}
+
+// blocksFrom returns the index of the first block with a block number >= n.
+func (o *MemoryORM) blocksFrom(n int64) int {
+	return sort.Search(len(o.blocks), func(i int) bool { return o.blocks[i].BlockNumber >= n })
+}
+
+// logsFrom returns the index of the first log with a block number >= n.
+func (o *MemoryORM) logsFrom(n int64) int {
+	return sort.Search(len(o.logs), func(i int) bool { return o.logs[i].log.BlockNumber >= n })
+}
+
+// logsInRange returns the logs with block numbers in [start, end].
+func (o *MemoryORM) logsInRange(start, end int64) []memoryLog {
+	if start > end {
+		return nil
+	}
+	return o.logs[o.logsFrom(start):o.logsFrom(end+1)]
+}
+
+// selectLogs returns the logs with block numbers in [start, end] for which match returns true.
+func (o *MemoryORM) selectLogs(start, end int64, match func(l *Log) bool) []Log {
+	return matchLogs(o.logsInRange(start, end), match)
+}
+
+func matchLogs(logs []memoryLog, match func(l *Log) bool) []Log {
+	var matched []Log
+	for i := range logs {
+		if match(&logs[i].log) {
+			matched = append(matched, logs[i].log)
+		}
+	}
+	return matched
+}
+
+// selectConfirmedLogs returns the logs having the given confirmations for which match returns true.
+func (o *MemoryORM) selectConfirmedLogs(confs evmtypes.Confirmations, match func(l *Log) bool) []Log {
+	end, ok := o.confirmedBlock(confs)
+	if !ok {
+		return nil
+	}
+	return o.selectLogs(0, end, match)
+}
+
+func (o *MemoryORM) SelectBlockByHash(_ context.Context, hash common.Hash) (*Block, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	for i := range o.blocks {
+		if o.blocks[i].BlockHash == hash {
+			b := o.blocks[i]
+			return &b, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (o *MemoryORM) SelectBlockByNumber(_ context.Context, n int64) (*Block, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	i := o.blocksFrom(n)
+	if i == len(o.blocks) || o.blocks[i].BlockNumber != n {
+		return nil, sql.ErrNoRows
+	}
+	b := o.blocks[i]
+	return &b, nil
+}
+
+func (o *MemoryORM) SelectLatestBlock(_ context.Context) (*Block, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	b, ok := o.latestBlock()
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return &b, nil
+}
+
+func (o *MemoryORM) SelectLatestFinalizedBlock(_ context.Context) (*Block, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	finalized, ok := o.confirmedBlock(evmtypes.Finalized)
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	i := o.blocksFrom(finalized + 1)
+	if i == 0 {
+		return nil, sql.ErrNoRows
+	}
+	b := o.blocks[i-1]
+	return &b, nil
+}
+
+func (o *MemoryORM) SelectOldestBlock(_ context.Context, minAllowedBlockNumber int64) (*Block, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	i := o.blocksFrom(minAllowedBlockNumber)
+	if i == len(o.blocks) {
+		return nil, sql.ErrNoRows
+	}
+	b := o.blocks[i]
+	return &b, nil
+}
+
+func (o *MemoryORM) GetBlocksRange(_ context.Context, start int64, end int64) ([]Block, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	var blocks []Block
+	for _, b := range o.blocks[o.blocksFrom(start):] {
+		if b.BlockNumber > end {
+			break
+		}
+		blocks = append(blocks, b)
+	}
+	return blocks, nil
+}
+
+func (o *MemoryORM) SelectLatestLogByEventSigWithConfs(_ context.Context, eventSig common.Hash, address common.Address, confs evmtypes.Confirmations) (*Log, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	logs := o.selectConfirmedLogs(confs, func(l *Log) bool {
+		return l.EventSig == eventSig && l.Address == address
+	})
+	if len(logs) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &logs[len(logs)-1], nil
+}
+
+// execPagedQuery runs query over the same block ranges as RangeQueryer.ExecPagedQuery, so pruning removes the same
+// rows in the same batches as with DSORM.
+func (o *MemoryORM) execPagedQuery(limit, end int64, query func(lower, upper int64) int64) int64 {
+	if limit == 0 {
+		return query(0, end)
+	}
+	if len(o.blocks) == 0 {
+		return 0
+	}
+
+	var rowsAffected, upper int64
+	for lower := o.blocks[0].BlockNumber; rowsAffected < limit; lower = upper + 1 {
+		upper = min(lower+limit-1, end)
+		rowsAffected += query(lower, upper)
+		if upper >= end {
+			break
+		}
+	}
+	return rowsAffected
+}
+
+// DeleteBlocksBefore delete blocks before and including end. When limit is set, it will delete at most limit blocks.
+// Otherwise, it will delete all blocks at once.
+func (o *MemoryORM) DeleteBlocksBefore(_ context.Context, end int64, limit int64) (int64, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.execPagedQuery(limit, end, func(lower, upper int64) int64 {
+		return int64(o.deleteBlocks(lower, upper))
+	}), nil
+}
+
+// deleteBlocks removes the blocks with block numbers in [start, end] and returns how many were removed.
+func (o *MemoryORM) deleteBlocks(start, end int64) int {
+	if start > end {
+		return 0
+	}
+	from, to := o.blocksFrom(start), o.blocksFrom(end+1)
+	for _, b := range o.blocks[from:to] {
+		delete(o.hashes, b.BlockHash)
+	}
+	o.blocks = slices.Delete(o.blocks, from, to)
+	return to - from
+}
+
+func (o *MemoryORM) DeleteLogsAndBlocksAfter(_ context.Context, start int64) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if latest, ok := o.latestBlock(); ok {
+		o.deleteBlocks(start, latest.BlockNumber)
+	}
+	from := o.logsFrom(start)
+	for _, l := range o.logs[from:] {
+		delete(o.logKeys, memoryLogKey{blockHash: l.log.BlockHash, blockNumber: l.log.BlockNumber, logIndex: l.log.LogIndex})
+	}
+	o.logs = o.logs[:from]
+	return nil
+}
+
+// deleteLogs removes the logs whose ids are in ids and returns how many were removed.
+func (o *MemoryORM) deleteLogs(ids map[uint64]struct{}) int64 {
+	if len(ids) == 0 {
+		return 0
+	}
+	before := len(o.logs)
+	o.logs = slices.DeleteFunc(o.logs, func(l memoryLog) bool {
+		if _, ok := ids[l.id]; !ok {
+			return false
+		}
+		delete(o.logKeys, memoryLogKey{blockHash: l.log.BlockHash, blockNumber: l.log.BlockNumber, logIndex: l.log.LogIndex})
+		return true
+	})
+	return int64(before - len(o.logs))
+}
+
+func (o *MemoryORM) SelectUnmatchedLogIDs(_ context.Context, limit int64) (ids []uint64, err error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	latest, ok := o.latestBlock()
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	type addressEvent struct {
+		address common.Address
+		event   common.Hash
+	}
+	matched := make(map[addressEvent]struct{})
+	for key := range o.filters {
+		matched[addressEvent{address: key.address, event: key.event}] = struct{}{}
+	}
+
+	o.execPagedQuery(limit, latest.FinalizedBlockNumber, func(lower, upper int64) int64 {
+		var rows int64
+		for _, l := range o.logsInRange(lower, upper) {
+			if _, ok := matched[addressEvent{address: l.log.Address, event: l.log.EventSig}]; !ok {
+				ids = append(ids, l.id)
+				rows++
+			}
+		}
+		return rows
+	})
+	return ids, nil
+}
+
+// SelectExcessLogIDs finds any logs old enough that MaxLogsKept has been exceeded for every filter they match.
+func (o *MemoryORM) SelectExcessLogIDs(_ context.Context, limit int64) (results []uint64, err error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	latest, ok := o.latestBlock()
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	filters := o.loadFilters()
+
+	o.execPagedQuery(limit, latest.FinalizedBlockNumber, func(lower, upper int64) int64 {
+		logs := slices.Clone(o.logsInRange(lower, upper))
+		// Count logs matching each filter in the same order as DSORM, labeling anything after the
+		// filter.MaxLogsKept'th as old
+		slices.SortStableFunc(logs, func(a, b memoryLog) int {
+			if c := cmp.Compare(a.log.BlockNumber, b.log.BlockNumber); c != 0 {
+				return c
+			}
+			return cmp.Compare(b.log.LogIndex, a.log.LogIndex)
+		})
+
+		old := make(map[uint64]bool)
+		for _, f := range filters {
+			var n uint64
+			for _, l := range logs {
+				if !slices.Contains(f.Addresses, l.log.Address) || !slices.Contains(f.EventSigs, l.log.EventSig) {
+					continue
+				}
+				n++
+				isOld := f.MaxLogsKept != 0 && n > f.MaxLogsKept
+				if prev, seen := old[l.id]; seen {
+					isOld = isOld && prev
+				}
+				old[l.id] = isOld
+			}
+		}
+
+		// Return all logs considered old by every filter they match
+		var rows int64
+		for _, l := range o.logsInRange(lower, upper) {
+			if old[l.id] {
+				results = append(results, l.id)
+				rows++
+			}
+		}
+		return rows
+	})
+	return results, nil
+}
+
+// DeleteExpiredLogs removes any logs which either:
+//   - don't match any currently registered filters, or
+//   - have a timestamp older than any matching filter's retention, UNLESS there is at
+//     least one matching filter with retention=0
+func (o *MemoryORM) DeleteExpiredLogs(_ context.Context, limit int64) ([]PrunedLogs, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	type addressEvent struct {
+		address common.Address
+		event   common.Hash
+	}
+	type retention struct {
+		min, max time.Duration
+	}
+	retentions := make(map[addressEvent]retention)
+	for _, row := range o.filters {
+		key := addressEvent{address: row.address, event: row.event}
+		r, ok := retentions[key]
+		if !ok {
+			r = retention{min: row.retention, max: row.retention}
+		}
+		r.min = min(r.min, row.retention)
+		r.max = max(r.max, row.retention)
+		retentions[key] = r
+	}
+
+	now := time.Now()
+	ids := make(map[uint64]struct{})
+	counts := make(map[addressEvent]int64)
+	for _, l := range o.logs {
+		if limit > 0 && int64(len(ids)) >= limit {
+			break
+		}
+		key := addressEvent{address: l.log.Address, event: l.log.EventSig}
+		r, ok := retentions[key]
+		if !ok || r.min <= 0 {
+			continue
+		}
+		if !l.log.BlockTimestamp.After(now.Add(-r.max)) {
+			ids[l.id] = struct{}{}
+			counts[key]++
+		}
+	}
+	o.deleteLogs(ids)
+
+	expired := make([]PrunedLogs, 0, len(counts))
+	for key, count := range counts {
+		expired = append(expired, PrunedLogs{Address: key.address, EventSig: key.event, Count: count})
+	}
+	return expired, nil
+}
+
+// DeleteExcessLogs deletes the logs found by SelectExcessLogIDs and returns the number of deleted logs of each address
+// and event sig.
+func (o *MemoryORM) DeleteExcessLogs(_ context.Context, rowIDs []uint64) ([]PrunedLogs, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	type addressEvent struct {
+		address common.Address
+		event   common.Hash
+	}
+	ids := make(map[uint64]struct{}, len(rowIDs))
+	for _, id := range rowIDs {
+		ids[id] = struct{}{}
+	}
+	counts := make(map[addressEvent]int64)
+	for _, l := range o.logs {
+		if _, ok := ids[l.id]; ok {
+			counts[addressEvent{address: l.log.Address, event: l.log.EventSig}]++
+		}
+	}
+	o.deleteLogs(ids)
+
+	pruned := make([]PrunedLogs, 0, len(counts))
+	for key, count := range counts {
+		pruned = append(pruned, PrunedLogs{Address: key.address, EventSig: key.event, Count: count})
+	}
+	return pruned, nil
+}
+
+// DeleteLogsByRowID accepts a list of log row id's to delete
+func (o *MemoryORM) DeleteLogsByRowID(_ context.Context, rowIDs []uint64) (int64, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	ids := make(map[uint64]struct{}, len(rowIDs))
+	for _, id := range rowIDs {
+		ids[id] = struct{}{}
+	}
+	return o.deleteLogs(ids), nil
+}
+
+// InsertLogs is idempotent to support replays.
+func (o *MemoryORM) InsertLogs(_ context.Context, logs []Log) error {
+	if err := o.validateLogs(logs); err != nil {
+		return err
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.insertLogs(logs)
+	return nil
+}
+
+func (o *MemoryORM) InsertLogsWithBlock(_ context.Context, logs []Log, block Block) error {
+	if err := o.validateLogs(logs); err != nil {
+		return err
+	}
+	// Block and logs are inserted under the same lock to ensure atomicity
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.insertBlock(block.BlockHash, block.BlockNumber, block.BlockTimestamp, block.FinalizedBlockNumber)
+	o.insertLogs(logs)
+	return nil
+}
+
+func (o *MemoryORM) insertLogs(logs []Log) {
+	now := time.Now()
+	for _, l := range logs {
+		key := memoryLogKey{blockHash: l.BlockHash, blockNumber: l.BlockNumber, logIndex: l.LogIndex}
+		if _, ok := o.logKeys[key]; ok {
+			continue
+		}
+		o.logKeys[key] = struct{}{}
+		o.lastID++
+
+		l.CreatedAt = now
+		// logs are mostly inserted in order, so this is usually an append
+		i := sort.Search(len(o.logs), func(i int) bool {
+			other := o.logs[i].log
+			return other.BlockNumber > l.BlockNumber || (other.BlockNumber == l.BlockNumber && other.LogIndex > l.LogIndex)
+		})
+		o.logs = slices.Insert(o.logs, i, memoryLog{id: o.lastID, log: l})
+	}
+}
+
+func (o *MemoryORM) validateLogs(logs []Log) error {
+	for _, log := range logs {
+		if o.chainID.Cmp(log.EVMChainID.ToInt()) != 0 {
+			return pkgerrors.Errorf("invalid chainID in log got %v want %v", log.EVMChainID.ToInt(), o.chainID)
+		}
+	}
+	return nil
+}
+
+func (o *MemoryORM) SelectLogsByBlockRange(_ context.Context, start, end int64) ([]Log, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.selectLogs(start, end, func(*Log) bool { return true }), nil
+}
+
+// SelectLogs finds the logs in a given block range.
+func (o *MemoryORM) SelectLogs(_ context.Context, start, end int64, address common.Address, eventSig common.Hash) ([]Log, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.selectLogs(start, end, func(l *Log) bool {
+		return l.Address == address && l.EventSig == eventSig
+	}), nil
+}
+
+// SelectLogsCreatedAfter finds logs created after some timestamp.
+func (o *MemoryORM) SelectLogsCreatedAfter(_ context.Context, address common.Address, eventSig common.Hash, after time.Time, confs evmtypes.Confirmations) ([]Log, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.selectConfirmedLogs(confs, func(l *Log) bool {
+		return l.Address == address && l.EventSig == eventSig && l.BlockTimestamp.After(after)
+	}), nil
+}
+
+// SelectLogsWithSigs finds the logs in the given block range with the given event signatures
+// emitted from the given address.
+func (o *MemoryORM) SelectLogsWithSigs(_ context.Context, start, end int64, address common.Address, eventSigs []common.Hash) ([]Log, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.selectLogs(start, end, func(l *Log) bool {
+		return l.Address == address && slices.Contains(eventSigs, l.EventSig)
+	}), nil
+}
+
+// SelectLatestLogEventSigsAddrsWithConfs finds the latest log by (address, event) combination that matches a list of Addresses and list of events
+func (o *MemoryORM) SelectLatestLogEventSigsAddrsWithConfs(_ context.Context, fromBlock int64, addresses []common.Address, eventSigs []common.Hash, confs evmtypes.Confirmations) ([]Log, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	end, ok := o.confirmedBlock(confs)
+	if !ok {
+		return nil, nil
+	}
+
+	type addressEvent struct {
+		address common.Address
+		event   common.Hash
+	}
+	latest := make(map[addressEvent]int)
+	logs := o.selectLogs(fromBlock, end, func(l *Log) bool {
+		return slices.Contains(addresses, l.Address) && slices.Contains(eventSigs, l.EventSig)
+	})
+	for i, l := range logs {
+		latest[addressEvent{address: l.Address, event: l.EventSig}] = i
+	}
+
+	var results []Log
+	for i, l := range logs {
+		if latest[addressEvent{address: l.Address, event: l.EventSig}] == i {
+			results = append(results, l)
+		}
+	}
+	return results, nil
+}
+
+// SelectLatestBlockByEventSigsAddrsWithConfs finds the latest block number that matches a list of Addresses and list of events. It returns 0 if there is no matching block
+func (o *MemoryORM) SelectLatestBlockByEventSigsAddrsWithConfs(_ context.Context, fromBlock int64, eventSigs []common.Hash, addresses []common.Address, confs evmtypes.Confirmations) (int64, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	end, ok := o.confirmedBlock(confs)
+	if !ok {
+		return 0, nil
+	}
+	logs := o.selectLogs(fromBlock, end, func(l *Log) bool {
+		return slices.Contains(addresses, l.Address) && slices.Contains(eventSigs, l.EventSig)
+	})
+	if len(logs) == 0 {
+		return 0, nil
+	}
+	return logs[len(logs)-1].BlockNumber, nil
+}
+
+// logTopic returns topics[index] of the log, false if the log has no such topic.
+func logTopic(l *Log, index int) ([]byte, bool) {
+	if index < 0 || index >= len(l.Topics) {
+		return nil, false
+	}
+	return l.Topics[index], true
+}
+
+// logDataWord returns the wordIndex'th 32 byte word of the log data, it is truncated or empty if the data is shorter.
+func logDataWord(l *Log, wordIndex int) []byte {
+	start := 32 * wordIndex
+	if start < 0 || start >= len(l.Data) {
+		return nil
+	}
+	return l.Data[start:min(start+32, len(l.Data))]
+}
+
+func checkTopicIndex(index int) error {
+	// Only 4 total topics are allowed. topicIndex 0 is the event sig, except for anonymous events
+	if index < 0 || index > 3 {
+		return fmt.Errorf("invalid index for topic: %d", index)
+	}
+	return nil
+}
+
+// topicMatches returns true if topics[index] of the log compares to value as accepted by cmp.
+func topicMatches(l *Log, index int, value common.Hash, accept func(c int) bool) bool {
+	topic, ok := logTopic(l, index)
+	return ok && accept(bytes.Compare(topic, value.Bytes()))
+}
+
+func topicIn(l *Log, index int, values []common.Hash) bool {
+	topic, ok := logTopic(l, index)
+	if !ok {
+		return false
+	}
+	for _, v := range values {
+		if bytes.Equal(topic, v.Bytes()) {
+			return true
+		}
+	}
+	return false
+}
+
+func isGte(c int) bool { return c >= 0 }
+func isLte(c int) bool { return c <= 0 }
+
+func (o *MemoryORM) SelectLogsDataWordRange(_ context.Context, address common.Address, eventSig common.Hash, wordIndex int, wordValueMin, wordValueMax common.Hash, confs evmtypes.Confirmations) ([]Log, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.selectConfirmedLogs(confs, func(l *Log) bool {
+		word := logDataWord(l, wordIndex)
+		return l.Address == address && l.EventSig == eventSig &&
+			bytes.Compare(word, wordValueMin.Bytes()) >= 0 && bytes.Compare(word, wordValueMax.Bytes()) <= 0
+	}), nil
+}
+
+func (o *MemoryORM) SelectLogsDataWordGreaterThan(_ context.Context, address common.Address, eventSig common.Hash, wordIndex int, wordValueMin common.Hash, confs evmtypes.Confirmations) ([]Log, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.selectConfirmedLogs(confs, func(l *Log) bool {
+		return l.Address == address && l.EventSig == eventSig &&
+			bytes.Compare(logDataWord(l, wordIndex), wordValueMin.Bytes()) >= 0
+	}), nil
+}
+
+func (o *MemoryORM) SelectLogsDataWordBetween(_ context.Context, address common.Address, eventSig common.Hash, wordIndexMin int, wordIndexMax int, wordValue common.Hash, confs evmtypes.Confirmations) ([]Log, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.selectConfirmedLogs(confs, func(l *Log) bool {
+		return l.Address == address && l.EventSig == eventSig &&
+			bytes.Compare(logDataWord(l, wordIndexMin), wordValue.Bytes()) <= 0 &&
+			bytes.Compare(logDataWord(l, wordIndexMax), wordValue.Bytes()) >= 0
+	}), nil
+}
+
+func (o *MemoryORM) SelectIndexedLogsTopicGreaterThan(_ context.Context, address common.Address, eventSig common.Hash, topicIndex int, topicValueMin common.Hash, confs evmtypes.Confirmations) ([]Log, error) {
+	if err := checkTopicIndex(topicIndex); err != nil {
+		return nil, err
+	}
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.selectConfirmedLogs(confs, func(l *Log) bool {
+		return l.Address == address && l.EventSig == eventSig && topicMatches(l, topicIndex, topicValueMin, isGte)
+	}), nil
+}
+
+func (o *MemoryORM) SelectIndexedLogsTopicRange(_ context.Context, address common.Address, eventSig common.Hash, topicIndex int, topicValueMin, topicValueMax common.Hash, confs evmtypes.Confirmations) ([]Log, error) {
+	if err := checkTopicIndex(topicIndex); err != nil {
+		return nil, err
+	}
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.selectConfirmedLogs(confs, func(l *Log) bool {
+		return l.Address == address && l.EventSig == eventSig &&
+			topicMatches(l, topicIndex, topicValueMin, isGte) && topicMatches(l, topicIndex, topicValueMax, isLte)
+	}), nil
+}
+
+func (o *MemoryORM) SelectIndexedLogs(_ context.Context, address common.Address, eventSig common.Hash, topicIndex int, topicValues []common.Hash, confs evmtypes.Confirmations) ([]Log, error) {
+	if err := checkTopicIndex(topicIndex); err != nil {
+		return nil, err
+	}
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.selectConfirmedLogs(confs, func(l *Log) bool {
+		return l.Address == address && l.EventSig == eventSig && topicIn(l, topicIndex, topicValues)
+	}), nil
+}
+
+// SelectIndexedLogsByBlockRange finds the indexed logs in a given block range.
+func (o *MemoryORM) SelectIndexedLogsByBlockRange(_ context.Context, start, end int64, address common.Address, eventSig common.Hash, topicIndex int, topicValues []common.Hash) ([]Log, error) {
+	if err := checkTopicIndex(topicIndex); err != nil {
+		return nil, err
+	}
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.selectLogs(start, end, func(l *Log) bool {
+		return l.Address == address && l.EventSig == eventSig && topicIn(l, topicIndex, topicValues)
+	}), nil
+}
+
+func (o *MemoryORM) SelectIndexedLogsCreatedAfter(_ context.Context, address common.Address, eventSig common.Hash, topicIndex int, topicValues []common.Hash, after time.Time, confs evmtypes.Confirmations) ([]Log, error) {
+	if err := checkTopicIndex(topicIndex); err != nil {
+		return nil, err
+	}
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.selectConfirmedLogs(confs, func(l *Log) bool {
+		return l.Address == address && l.EventSig == eventSig && topicIn(l, topicIndex, topicValues) &&
+			l.BlockTimestamp.After(after)
+	}), nil
+}
+
+func (o *MemoryORM) SelectIndexedLogsByTxHash(_ context.Context, address common.Address, eventSig common.Hash, txHash common.Hash) ([]Log, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return matchLogs(o.logs, func(l *Log) bool {
+		return l.Address == address && l.EventSig == eventSig && l.TxHash == txHash
+	}), nil
+}
+
+// SelectIndexedLogsWithSigsExcluding query's for logs that have signature A and exclude logs that have a corresponding signature B, matching is done based on the topic index both logs should be inside the block range and have the minimum number of evmtypes.Confirmations
+func (o *MemoryORM) SelectIndexedLogsWithSigsExcluding(_ context.Context, sigA, sigB common.Hash, topicIndex int, address common.Address, startBlock, endBlock int64, confs evmtypes.Confirmations) ([]Log, error) {
+	if err := checkTopicIndex(topicIndex); err != nil {
+		return nil, err
+	}
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	confirmed, ok := o.confirmedBlock(confs)
+	if !ok {
+		return nil, nil
+	}
+
+	var excluding [][]byte
+	for _, l := range o.selectLogs(startBlock, min(endBlock, confirmed), func(l *Log) bool {
+		return l.Address == address && l.EventSig == sigB
+	}) {
+		if topic, ok := logTopic(&l, topicIndex); ok {
+			excluding = append(excluding, topic)
+		}
+	}
+
+	return o.selectLogs(startBlock, min(endBlock, confirmed), func(l *Log) bool {
+		if l.Address != address || l.EventSig != sigA {
+			return false
+		}
+		topic, ok := logTopic(l, topicIndex)
+		if !ok {
+			return true
+		}
+		for _, excluded := range excluding {
+			if bytes.Equal(topic, excluded) {
+				return false
+			}
+		}
+		return true
+	}), nil
+}
+
+func (o *MemoryORM) FilteredLogs(_ context.Context, filter []query.Expression, limitAndSort query.LimitAndSort, _ string) ([]Log, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	var latest *Block
+	if b, ok := o.latestBlock(); ok {
+		latest = &b
+	}
+	return (&memoryDSLParser{}).filterLogs(latest, o.logs, filter, limitAndSort)
+}
@@ -0,0 +1,114 @@
+package logpoller_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+	"github.com/smartcontractkit/chainlink-evm/pkg/types"
+)
+
+// The ORM test suite runs against MemoryORM with CL_LOGPOLLER_ORM=memory, these tests cover it without a database.
+func TestMemoryORM(t *testing.T) {
+	ctx := testutils.Context(t)
+	chainID := testutils.NewRandomEVMChainID()
+	o := logpoller.NewMemoryORM(chainID)
+
+	addr := common.HexToAddress("0x1234")
+	eventSig := common.HexToHash("0x1599")
+	for i := int64(1); i <= 10; i++ {
+		blockHash := common.BigToHash(big.NewInt(i)).Hex()
+		logs := []logpoller.Log{
+			GenLog(chainID, 0, i, blockHash, eventSig.Bytes(), addr),
+			GenLog(chainID, 1, i, blockHash, eventSig.Bytes(), addr),
+		}
+		require.NoError(t, o.InsertLogsWithBlock(ctx, logs, logpoller.Block{BlockHash: common.HexToHash(blockHash), BlockNumber: i, BlockTimestamp: time.Now(), FinalizedBlockNumber: i - 2}))
+	}
+
+	_, err := o.SelectLatestLogByEventSigWithConfs(ctx, common.HexToHash("0x1600"), addr, 0)
+	require.ErrorContains(t, err, "no rows")
+
+	t.Run("filtered logs with cursor", func(t *testing.T) {
+		filter := []query.Expression{
+			logpoller.NewAddressFilter(addr),
+			logpoller.NewEventSigFilter(eventSig),
+			query.Confidence(primitives.Finalized),
+		}
+
+		logs, err := o.FilteredLogs(ctx, filter, query.NewLimitAndSort(query.CursorLimit("2-0-0x00", query.CursorFollowing, 3)), "")
+		require.NoError(t, err)
+		require.Len(t, logs, 3)
+		assert.Equal(t, []int64{2, 3, 3}, []int64{logs[0].BlockNumber, logs[1].BlockNumber, logs[2].BlockNumber})
+		assert.Equal(t, int64(1), logs[0].LogIndex)
+
+		_, err = o.FilteredLogs(ctx, filter[:2], query.NewLimitAndSort(query.CursorLimit("2-0-0x00", query.CursorFollowing, 3)), "")
+		require.ErrorContains(t, err, "cursor-base queries limited to only finalized blocks")
+	})
+
+	t.Run("filtered logs by block", func(t *testing.T) {
+		logs, err := o.FilteredLogs(ctx, []query.Expression{
+			query.Or(query.Block("2", primitives.Lte), query.Block("9", primitives.Gt)),
+		}, query.LimitAndSort{}, "")
+		require.NoError(t, err)
+		require.Len(t, logs, 6)
+		// sorted by block number and log index descending by default
+		assert.Equal(t, int64(10), logs[0].BlockNumber)
+		assert.Equal(t, int64(1), logs[0].LogIndex)
+		assert.Equal(t, int64(1), logs[5].BlockNumber)
+	})
+
+	t.Run("pruning", func(t *testing.T) {
+		ids, err := o.SelectUnmatchedLogIDs(ctx, 0)
+		require.NoError(t, err)
+		assert.Len(t, ids, 16, "logs up to the finalized block do not match any filter")
+
+		require.NoError(t, o.InsertFilter(ctx, logpoller.Filter{
+			Name:        "filter",
+			Addresses:   types.AddressArray{addr},
+			EventSigs:   types.HashArray{eventSig},
+			MaxLogsKept: 4,
+		}))
+		ids, err = o.SelectUnmatchedLogIDs(ctx, 0)
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+
+		ids, err = o.SelectExcessLogIDs(ctx, 0)
+		require.NoError(t, err)
+		assert.Len(t, ids, 12)
+
+		deleted, err := o.DeleteLogsByRowID(ctx, ids)
+		require.NoError(t, err)
+		assert.Equal(t, int64(12), deleted)
+
+		logs, err := o.SelectLogsByBlockRange(ctx, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, logs, 8)
+
+		deleted, err = o.DeleteBlocksBefore(ctx, 5, 2)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), deleted)
+		oldest, err := o.SelectOldestBlock(ctx, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), oldest.BlockNumber)
+	})
+
+	t.Run("filter storage stats", func(t *testing.T) {
+		stats, err := o.SelectFilterStorageStats(ctx, 8)
+		require.NoError(t, err)
+		assert.Equal(t, []logpoller.FilterStorageStats{{Name: "filter", LogCount: 4, OldestBlock: 1, NewestBlock: 2}}, stats)
+
+		require.NoError(t, o.InsertFilter(ctx, logpoller.Filter{Name: "empty", Addresses: types.AddressArray{addr}, EventSigs: types.HashArray{common.HexToHash("0x1600")}}))
+		stats, err = o.SelectFilterStorageStats(ctx, 100)
+		require.NoError(t, err)
+		assert.Equal(t, []logpoller.FilterStorageStats{{Name: "empty"}, {Name: "filter", LogCount: 8, OldestBlock: 1, NewestBlock: 10}}, stats)
+	})
+}
@@ -0,0 +1,435 @@
+package logpoller
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+	evmprimitives "github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives/evm"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+)
+
+// logPredicate reports whether a log matches an expression, nil matches every log.
+type logPredicate func(l *Log) bool
+
+// The memoryDSLParser evaluates the same expressions as pgDSLParser against the logs of a MemoryORM. Each Accept
+// function call builds a predicate instead of a SQL expression, the predicate and error are reset after every call.
+type memoryDSLParser struct {
+	// latest is the latest block, confirmations match nothing when it is nil like the nested query of pgDSLParser.
+	latest *Block
+
+	// transient properties expected to be set and reset with every expression
+	predicate logPredicate
+	err       error
+}
+
+var _ primitives.Visitor = (*memoryDSLParser)(nil)
+var _ evmprimitives.Visitor = (*memoryDSLParser)(nil)
+
+func (v *memoryDSLParser) Comparator(_ primitives.Comparator) {}
+
+func (v *memoryDSLParser) Block(p primitives.Block) {
+	accept, err := cmpOpToFunc(p.Operator)
+	if err != nil {
+		v.err = err
+
+		return
+	}
+
+	block, err := strconv.ParseInt(p.Block, 10, 64)
+	if err != nil {
+		v.err = err
+
+		return
+	}
+
+	v.predicate = func(l *Log) bool { return accept(cmp.Compare(l.BlockNumber, block)) }
+}
+
+func (v *memoryDSLParser) Confidence(p primitives.Confidence) {
+	switch p.ConfidenceLevel {
+	case primitives.Finalized:
+		// the highest level of confidence maps to finalized
+		v.predicate = v.confirmedPredicate(true, 0)
+	case primitives.Unconfirmed:
+		v.predicate = v.confirmedPredicate(false, 0)
+	default:
+		v.err = errors.New("unrecognized confidence level; use confidence to confirmations mappings instead")
+
+		return
+	}
+}
+
+func (v *memoryDSLParser) Timestamp(p primitives.Timestamp) {
+	accept, err := cmpOpToFunc(p.Operator)
+	if err != nil {
+		v.err = err
+
+		return
+	}
+
+	timestamp := time.Unix(int64(p.Timestamp), 0)
+	v.predicate = func(l *Log) bool { return accept(l.BlockTimestamp.Compare(timestamp)) }
+}
+
+func (v *memoryDSLParser) TxHash(p primitives.TxHash) {
+	bts, err := hexutil.Decode(p.TxHash)
+	if errors.Is(err, hexutil.ErrMissingPrefix) {
+		bts, err = hexutil.Decode("0x" + p.TxHash)
+	}
+
+	if err != nil {
+		v.err = err
+
+		return
+	}
+
+	txHash := common.BytesToHash(bts)
+	v.predicate = func(l *Log) bool { return l.TxHash == txHash }
+}
+
+func (v *memoryDSLParser) visitAddressFilter(p *addressFilter) {
+	v.predicate = func(l *Log) bool { return l.Address == p.address }
+}
+
+func (v *memoryDSLParser) visitEventSigFilter(p *eventSigFilter) {
+	v.predicate = func(l *Log) bool { return l.EventSig == p.eventSig }
+}
+
+func (v *memoryDSLParser) confirmedPredicate(finalized bool, confs uint64) logPredicate {
+	if v.latest == nil {
+		return func(*Log) bool { return false }
+	}
+
+	upper := v.latest.FinalizedBlockNumber
+	if !finalized {
+		upper = max(v.latest.BlockNumber-int64(confs), 0)
+	}
+
+	return func(l *Log) bool { return l.BlockNumber <= upper }
+}
+
+func (v *memoryDSLParser) visitEventByWordFilter(p *eventByWordFilter) {
+	if len(p.HashedValueComparers) > 0 {
+		word := func(l *Log) ([]byte, bool) { return logDataWord(l, p.WordIndex), true }
+		v.predicate, v.err = hashedValueCmpsToPredicate(p.HashedValueComparers, word)
+	}
+}
+
+func (v *memoryDSLParser) visitEventTopicsByValueFilter(p *eventByTopicFilter) {
+	if len(p.ValueComparers) == 0 {
+		return
+	}
+
+	if p.Topic > 3 {
+		v.err = fmt.Errorf("invalid index for topic: %d", p.Topic)
+
+		return
+	}
+
+	index := int(p.Topic) //nolint:gosec // G115
+	topic := func(l *Log) ([]byte, bool) { return logTopic(l, index) }
+	v.predicate, v.err = hashedValueCmpsToPredicate(p.ValueComparers, topic)
+}
+
+func (v *memoryDSLParser) Address(f *evmprimitives.Address) {
+	v.visitAddressFilter(toAddress(f))
+}
+
+func (v *memoryDSLParser) EventSig(f *evmprimitives.EventSig) {
+	v.visitEventSigFilter(toEventSig(f))
+}
+
+func (v *memoryDSLParser) EventTopicsByValue(f *evmprimitives.EventByTopic) {
+	v.visitEventTopicsByValueFilter(toEventTopicsByValue(f))
+}
+
+func (v *memoryDSLParser) EventByWord(f *evmprimitives.EventByWord) {
+	v.visitEventByWordFilter(toEventByWord(f))
+}
+
+func (v *memoryDSLParser) VisitConfirmationsFilter(p *confirmationsFilter) {
+	switch p.Confirmations {
+	case evmtypes.Finalized:
+		// the highest level of confidence maps to finalized
+		v.predicate = v.confirmedPredicate(true, 0)
+	default:
+		v.predicate = v.confirmedPredicate(false, uint64(p.Confirmations)) //nolint:gosec // G115
+	}
+}
+
+// hashedValueCmpsToPredicate matches logs whose column satisfies all the comparators. A comparator with several
+// values is satisfied by any of them, like the ANY operator of pgDSLParser.
+func hashedValueCmpsToPredicate(comps []HashedValueComparator, column func(l *Log) ([]byte, bool)) (logPredicate, error) {
+	accepts := make([]func(int) bool, len(comps))
+	for idx, comp := range comps {
+		accept, err := cmpOpToFunc(comp.Operator)
+		if err != nil {
+			return nil, err
+		}
+		accepts[idx] = accept
+	}
+
+	return func(l *Log) bool {
+		value, ok := column(l)
+		if !ok {
+			return false
+		}
+
+		for idx, comp := range comps {
+			if !slices.ContainsFunc(comp.Values, func(h common.Hash) bool {
+				return accepts[idx](bytes.Compare(value, h.Bytes()))
+			}) {
+				return false
+			}
+		}
+
+		return true
+	}, nil
+}
+
+func (v *memoryDSLParser) filterLogs(latest *Block, logs []memoryLog, expressions []query.Expression, limiter query.LimitAndSort) ([]Log, error) {
+	// reset transient properties
+	v.latest = latest
+	v.predicate = nil
+	v.err = nil
+
+	where, err := v.wherePredicate(expressions, limiter)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := v.orderFunc(limiter)
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []Log
+	for i := range logs {
+		if where(&logs[i].log) {
+			filtered = append(filtered, logs[i].log)
+		}
+	}
+
+	slices.SortStableFunc(filtered, order)
+
+	if limiter.HasCursorLimit() || limiter.Limit.Count > 0 {
+		if uint64(len(filtered)) > limiter.Limit.Count {
+			filtered = filtered[:limiter.Limit.Count]
+		}
+	}
+
+	return filtered, nil
+}
+
+func (v *memoryDSLParser) wherePredicate(expressions []query.Expression, limiter query.LimitAndSort) (logPredicate, error) {
+	var predicates []logPredicate
+
+	if len(expressions) > 0 {
+		exp, hasFinalized, err := v.combineExpressions(expressions, query.AND)
+		if err != nil {
+			return nil, err
+		}
+
+		if limiter.HasCursorLimit() && !hasFinalized {
+			return nil, errors.New("cursor-base queries limited to only finalized blocks")
+		}
+
+		predicates = append(predicates, exp)
+	}
+
+	if limiter.HasCursorLimit() {
+		var accept func(int) bool
+		switch limiter.Limit.CursorDirection {
+		case query.CursorFollowing:
+			accept = func(c int) bool { return c > 0 }
+		case query.CursorPrevious:
+			accept = func(c int) bool { return c < 0 }
+		default:
+			return nil, errors.New("invalid cursor direction")
+		}
+
+		block, logIdx, _, err := valuesFromCursor(limiter.Limit.Cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		predicates = append(predicates, func(l *Log) bool {
+			if c := cmp.Compare(l.BlockNumber, block); c != 0 {
+				return accept(c)
+			}
+			return accept(cmp.Compare(l.LogIndex, int64(logIdx)))
+		})
+	}
+
+	return andPredicates(predicates), nil
+}
+
+func (v *memoryDSLParser) orderFunc(limiter query.LimitAndSort) (func(a, b Log) int, error) {
+	sorting := limiter.SortBy
+
+	if limiter.HasCursorLimit() && !limiter.HasSequenceSort() {
+		var dir query.SortDirection
+
+		switch limiter.Limit.CursorDirection {
+		case query.CursorFollowing:
+			dir = query.Asc
+		case query.CursorPrevious:
+			dir = query.Desc
+		default:
+			return nil, errors.New("unexpected cursor direction")
+		}
+
+		sorting = append(sorting, query.NewSortBySequence(dir))
+	}
+
+	if len(sorting) == 0 {
+		sorting = []query.SortBy{query.NewSortBySequence(query.Desc)}
+	}
+
+	orders := make([]func(a, b Log) int, len(sorting))
+
+	for idx, sorted := range sorting {
+		var order func(a, b Log) int
+
+		switch sorted.(type) {
+		case query.SortByBlock:
+			order = func(a, b Log) int { return cmp.Compare(a.BlockNumber, b.BlockNumber) }
+		case query.SortBySequence:
+			order = func(a, b Log) int {
+				if c := cmp.Compare(a.BlockNumber, b.BlockNumber); c != 0 {
+					return c
+				}
+				if c := cmp.Compare(a.LogIndex, b.LogIndex); c != 0 {
+					return c
+				}
+				return bytes.Compare(a.TxHash[:], b.TxHash[:])
+			}
+		case query.SortByTimestamp:
+			order = func(a, b Log) int { return a.BlockTimestamp.Compare(b.BlockTimestamp) }
+		default:
+			return nil, errors.New("unexpected sort by")
+		}
+
+		switch sorted.GetDirection() {
+		case query.Asc:
+			orders[idx] = order
+		case query.Desc:
+			orders[idx] = func(a, b Log) int { return order(b, a) }
+		default:
+			return nil, errors.New("invalid sort direction")
+		}
+	}
+
+	return func(a, b Log) int {
+		for _, order := range orders {
+			if c := order(a, b); c != 0 {
+				return c
+			}
+		}
+		return 0
+	}, nil
+}
+
+func (v *memoryDSLParser) getLastPredicate() (logPredicate, error) {
+	predicate := v.predicate
+	err := v.err
+
+	v.predicate = nil
+	v.err = nil
+
+	return predicate, err
+}
+
+func (v *memoryDSLParser) combineExpressions(expressions []query.Expression, op query.BoolOperator) (logPredicate, bool, error) {
+	predicates := make([]logPredicate, len(expressions))
+
+	var isFinalized bool
+
+	for idx, exp := range expressions {
+		if exp.IsPrimitive() {
+			exp.Primitive.Accept(v)
+
+			switch prim := exp.Primitive.(type) {
+			case *primitives.Confidence:
+				isFinalized = prim.ConfidenceLevel == primitives.Finalized
+			case *confirmationsFilter:
+				isFinalized = prim.Confirmations == evmtypes.Finalized
+			}
+
+			predicate, err := v.getLastPredicate()
+			if err != nil {
+				return nil, isFinalized, err
+			}
+
+			predicates[idx] = predicate
+		} else {
+			predicate, fin, err := v.combineExpressions(exp.BoolExpression.Expressions, exp.BoolExpression.BoolOperator)
+			if err != nil {
+				return nil, isFinalized, err
+			}
+
+			if fin {
+				isFinalized = fin
+			}
+
+			predicates[idx] = predicate
+		}
+	}
+
+	if op == query.OR {
+		return orPredicates(predicates), isFinalized, nil
+	}
+
+	return andPredicates(predicates), isFinalized, nil
+}
+
+func andPredicates(predicates []logPredicate) logPredicate {
+	return func(l *Log) bool {
+		for _, p := range predicates {
+			if p != nil && !p(l) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func orPredicates(predicates []logPredicate) logPredicate {
+	return func(l *Log) bool {
+		for _, p := range predicates {
+			if p == nil || p(l) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func cmpOpToFunc(op primitives.ComparisonOperator) (func(c int) bool, error) {
+	switch op {
+	case primitives.Eq:
+		return func(c int) bool { return c == 0 }, nil
+	case primitives.Neq:
+		return func(c int) bool { return c != 0 }, nil
+	case primitives.Gt:
+		return func(c int) bool { return c > 0 }, nil
+	case primitives.Gte:
+		return func(c int) bool { return c >= 0 }, nil
+	case primitives.Lt:
+		return func(c int) bool { return c < 0 }, nil
+	case primitives.Lte:
+		return func(c int) bool { return c <= 0 }, nil
+	default:
+		return nil, errors.New("invalid comparison operator")
+	}
+}
@@ -22,6 +22,39 @@ type Block struct {
 	CreatedAt            time.Time
 }
 
+// BackfillCheckpoint records the progress of a replay backfill, so an interrupted replay can resume where it left off.
+// The logs of blocks [FromBlock, NextBlock) have been saved for the filters identified by FiltersHash.
+type BackfillCheckpoint struct {
+	EVMChainID  *big.Big
+	FromBlock   int64
+	NextBlock   int64
+	FiltersHash common.Hash
+	UpdatedAt   time.Time
+}
+
+// PrunedLogs is the number of logs of an address and event sig deleted by DeleteExpiredLogs or DeleteExcessLogs.
+type PrunedLogs struct {
+	Address  common.Address
+	EventSig common.Hash
+	Count    int64
+}
+
+// countPrunedLogs returns the total number of deleted logs.
+func countPrunedLogs(pruned []PrunedLogs) (n int64) {
+	for _, e := range pruned {
+		n += e.Count
+	}
+	return n
+}
+
+// FilterStorageStats is the number and block range of the stored logs matching the addresses and event sigs of a filter.
+type FilterStorageStats struct {
+	Name        string
+	LogCount    int64
+	OldestBlock int64 // 0 if there are no logs
+	NewestBlock int64 // 0 if there are no logs
+}
+
 // Log represents an EVM log.
 type Log struct {
 	EVMChainID     *big.Big
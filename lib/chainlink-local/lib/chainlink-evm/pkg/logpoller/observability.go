@@ -80,6 +80,30 @@ func (o *ObservedORM) DeleteFilter(ctx context.Context, name string) error {
 	})
 }
 
+func (o *ObservedORM) SelectBackfillCheckpoint(ctx context.Context) (*BackfillCheckpoint, error) {
+	return withObservedQuery(o, "SelectBackfillCheckpoint", func() (*BackfillCheckpoint, error) {
+		return o.ORM.SelectBackfillCheckpoint(ctx)
+	})
+}
+
+func (o *ObservedORM) SelectFilterStorageStats(ctx context.Context, toBlock int64) ([]FilterStorageStats, error) {
+	return withObservedQueryAndResults(o, "SelectFilterStorageStats", func() ([]FilterStorageStats, error) {
+		return o.ORM.SelectFilterStorageStats(ctx, toBlock)
+	})
+}
+
+func (o *ObservedORM) UpsertBackfillCheckpoint(ctx context.Context, checkpoint BackfillCheckpoint) error {
+	return withObservedExec(o, "UpsertBackfillCheckpoint", metrics.Create, func() error {
+		return o.ORM.UpsertBackfillCheckpoint(ctx, checkpoint)
+	})
+}
+
+func (o *ObservedORM) DeleteBackfillCheckpoint(ctx context.Context) error {
+	return withObservedExec(o, "DeleteBackfillCheckpoint", metrics.Del, func() error {
+		return o.ORM.DeleteBackfillCheckpoint(ctx)
+	})
+}
+
 func (o *ObservedORM) DeleteBlocksBefore(ctx context.Context, end int64, limit int64) (int64, error) {
 	return withObservedExecAndRowsAffected(o, "DeleteBlocksBefore", metrics.Del, func() (int64, error) {
 		return o.ORM.DeleteBlocksBefore(ctx, end, limit)
@@ -92,10 +116,14 @@ func (o *ObservedORM) DeleteLogsAndBlocksAfter(ctx context.Context, start int64)
 	})
 }
 
-func (o *ObservedORM) DeleteExpiredLogs(ctx context.Context, limit int64) (int64, error) {
-	return withObservedExecAndRowsAffected(o, "DeleteExpiredLogs", metrics.Del, func() (int64, error) {
-		return o.ORM.DeleteExpiredLogs(ctx, limit)
+func (o *ObservedORM) DeleteExpiredLogs(ctx context.Context, limit int64) ([]PrunedLogs, error) {
+	var expired []PrunedLogs
+	_, err := withObservedExecAndRowsAffected(o, "DeleteExpiredLogs", metrics.Del, func() (int64, error) {
+		var err error
+		expired, err = o.ORM.DeleteExpiredLogs(ctx, limit)
+		return countPrunedLogs(expired), err
 	})
+	return expired, err
 }
 
 func (o *ObservedORM) SelectUnmatchedLogIDs(ctx context.Context, limit int64) (ids []uint64, err error) {
@@ -110,6 +138,16 @@ func (o *ObservedORM) SelectExcessLogIDs(ctx context.Context, limit int64) ([]ui
 	})
 }
 
+func (o *ObservedORM) DeleteExcessLogs(ctx context.Context, rowIDs []uint64) ([]PrunedLogs, error) {
+	var pruned []PrunedLogs
+	_, err := withObservedExecAndRowsAffected(o, "DeleteExcessLogs", metrics.Del, func() (int64, error) {
+		var err error
+		pruned, err = o.ORM.DeleteExcessLogs(ctx, rowIDs)
+		return countPrunedLogs(pruned), err
+	})
+	return pruned, err
+}
+
 func (o *ObservedORM) DeleteLogsByRowID(ctx context.Context, rowIDs []uint64) (int64, error) {
 	return withObservedExecAndRowsAffected(o, "DeleteLogsByRowID", metrics.Del, func() (int64, error) {
 		return o.ORM.DeleteLogsByRowID(ctx, rowIDs)
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -36,8 +37,15 @@ type ORM interface {
 	DeleteBlocksBefore(ctx context.Context, end int64, limit int64) (int64, error)
 	DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error
 	SelectUnmatchedLogIDs(ctx context.Context, limit int64) (ids []uint64, err error)
-	DeleteExpiredLogs(ctx context.Context, limit int64) (int64, error)
+	DeleteExpiredLogs(ctx context.Context, limit int64) ([]PrunedLogs, error)
 	SelectExcessLogIDs(ctx context.Context, limit int64) (rowIDs []uint64, err error)
+	DeleteExcessLogs(ctx context.Context, rowIDs []uint64) ([]PrunedLogs, error)
+
+	SelectBackfillCheckpoint(ctx context.Context) (*BackfillCheckpoint, error)
+	UpsertBackfillCheckpoint(ctx context.Context, checkpoint BackfillCheckpoint) error
+	DeleteBackfillCheckpoint(ctx context.Context) error
+
+	SelectFilterStorageStats(ctx context.Context, toBlock int64) ([]FilterStorageStats, error)
 
 	GetBlocksRange(ctx context.Context, start int64, end int64) ([]Block, error)
 	SelectBlockByNumber(ctx context.Context, blockNumber int64) (*Block, error)
@@ -70,9 +78,10 @@ type ORM interface {
 }
 
 type DSORM struct {
-	chainID *big.Int
-	ds      sqlutil.DataSource
-	lggr    logger.Logger
+	chainID     *big.Int
+	ds          sqlutil.DataSource
+	lggr        logger.Logger
+	checkpoints *backfillCheckpoints
 }
 
 var _ ORM = &DSORM{}
@@ -80,9 +89,10 @@ var _ ORM = &DSORM{}
 // NewORM creates an DSORM scoped to chainID.
 func NewORM(chainID *big.Int, ds sqlutil.DataSource, lggr logger.Logger) *DSORM {
 	return &DSORM{
-		chainID: chainID,
-		ds:      ds,
-		lggr:    lggr,
+		chainID:     chainID,
+		ds:          ds,
+		lggr:        lggr,
+		checkpoints: &backfillCheckpoints{},
 	}
 }
 
@@ -91,7 +101,11 @@ func (o *DSORM) Transact(ctx context.Context, fn func(*DSORM) error) (err error)
 }
 
 // new returns a NewORM like o, but backed by ds.
-func (o *DSORM) new(ds sqlutil.DataSource) *DSORM { return NewORM(o.chainID, ds, o.lggr) }
+func (o *DSORM) new(ds sqlutil.DataSource) *DSORM {
+	orm := NewORM(o.chainID, ds, o.lggr)
+	orm.checkpoints = o.checkpoints
+	return orm
+}
 
 // InsertBlock is idempotent to support replays.
 func (o *DSORM) InsertBlock(ctx context.Context, blockHash common.Hash, blockNumber int64, blockTimestamp time.Time, finalizedBlock int64) error {
@@ -187,6 +201,43 @@ func (o *DSORM) LoadFilters(ctx context.Context) (map[string]Filter, error) {
 	return filters, err
 }
 
+// backfillCheckpoints holds the checkpoint of the replay in progress. It is kept in memory and shared by the DSORM and
+// the copies made for its transactions, so a replay interrupted by an RPC error or a cancelled context resumes from
+// it, while a replay interrupted by a restart starts over.
+type backfillCheckpoints struct {
+	mu         sync.Mutex
+	checkpoint *BackfillCheckpoint
+}
+
+// SelectBackfillCheckpoint returns the checkpoint of the last interrupted replay, or sql.ErrNoRows if there is none.
+func (o *DSORM) SelectBackfillCheckpoint(_ context.Context) (*BackfillCheckpoint, error) {
+	o.checkpoints.mu.Lock()
+	defer o.checkpoints.mu.Unlock()
+	if o.checkpoints.checkpoint == nil {
+		return nil, sql.ErrNoRows
+	}
+	checkpoint := *o.checkpoints.checkpoint
+	return &checkpoint, nil
+}
+
+// UpsertBackfillCheckpoint saves the checkpoint of the replay in progress, there is at most one per chain.
+func (o *DSORM) UpsertBackfillCheckpoint(_ context.Context, checkpoint BackfillCheckpoint) error {
+	o.checkpoints.mu.Lock()
+	defer o.checkpoints.mu.Unlock()
+	checkpoint.EVMChainID = ubig.New(o.chainID)
+	checkpoint.UpdatedAt = time.Now()
+	o.checkpoints.checkpoint = &checkpoint
+	return nil
+}
+
+// DeleteBackfillCheckpoint removes the checkpoint once the replay is complete.
+func (o *DSORM) DeleteBackfillCheckpoint(_ context.Context) error {
+	o.checkpoints.mu.Lock()
+	defer o.checkpoints.mu.Unlock()
+	o.checkpoints.checkpoint = nil
+	return nil
+}
+
 func blocksQuery(clause string) string {
 	return fmt.Sprintf(`SELECT %s FROM evm.log_poller_blocks %s`, strings.Join(blocksFields[:], ", "), clause)
 }
@@ -419,6 +470,22 @@ func (o *DSORM) DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error
 	})
 }
 
+// SelectFilterStorageStats returns the storage stats of every filter, counting the logs up to and including toBlock.
+// Like pruning, logs are matched to filters by address and event sig only.
+func (o *DSORM) SelectFilterStorageStats(ctx context.Context, toBlock int64) ([]FilterStorageStats, error) {
+	var stats []FilterStorageStats
+	err := o.ds.SelectContext(ctx, &stats, `
+		SELECT f.name, COUNT(l.id) AS log_count,
+				COALESCE(MIN(l.block_number), 0) AS oldest_block, COALESCE(MAX(l.block_number), 0) AS newest_block
+			FROM (
+				SELECT DISTINCT name, address, event FROM evm.log_poller_filters WHERE evm_chain_id = $1
+			) f LEFT JOIN evm.logs l ON
+				l.evm_chain_id = $1 AND l.address = f.address AND l.event_sig = f.event AND l.block_number <= $2
+			GROUP BY f.name
+			ORDER BY f.name`, ubig.New(o.chainID), toBlock)
+	return stats, err
+}
+
 type Exp struct {
 	Address      common.Address
 	EventSig     common.Hash
@@ -503,11 +570,24 @@ func (o *DSORM) SelectExcessLogIDs(ctx context.Context, limit int64) (results []
 	return r.AllResults(), err
 }
 
+// DeleteExcessLogs deletes the logs found by SelectExcessLogIDs and returns the number of deleted logs of each address
+// and event sig.
+func (o *DSORM) DeleteExcessLogs(ctx context.Context, rowIDs []uint64) ([]PrunedLogs, error) {
+	var pruned []PrunedLogs
+	err := o.ds.SelectContext(ctx, &pruned, `
+		WITH deleted AS (
+			DELETE FROM evm.logs WHERE id = ANY($1) RETURNING address, event_sig
+		) SELECT address, event_sig, COUNT(*) AS count FROM deleted GROUP BY address, event_sig`, rowIDs)
+	return pruned, err
+}
+
 // DeleteExpiredLogs removes any logs which either:
 //   - don't match any currently registered filters, or
 //   - have a timestamp older than any matching filter's retention, UNLESS there is at
 //     least one matching filter with retention=0
-func (o *DSORM) DeleteExpiredLogs(ctx context.Context, limit int64) (int64, error) {
+//
+// It returns the number of deleted logs of each address and event sig.
+func (o *DSORM) DeleteExpiredLogs(ctx context.Context, limit int64) ([]PrunedLogs, error) {
 	limitClause := ""
 	if limit > 0 {
 		limitClause = fmt.Sprintf("LIMIT %d", limit)
@@ -524,12 +604,12 @@ func (o *DSORM) DeleteExpiredLogs(ctx context.Context, limit int64) (int64, erro
 				HAVING MIN(retention) > 0
 			) r ON l.evm_chain_id = r.evm_chain_id AND l.address = r.address AND l.event_sig = r.event AND
 				l.block_timestamp <= STATEMENT_TIMESTAMP() - (r.retention / 10^9 * interval '1 second') %s
-		) DELETE FROM evm.logs WHERE id IN (SELECT id FROM rows_to_delete)`, limitClause)
-	result, err := o.ds.ExecContext(ctx, query, ubig.New(o.chainID))
-	if err != nil {
-		return 0, err
-	}
-	return result.RowsAffected()
+		), deleted AS (
+			DELETE FROM evm.logs WHERE id IN (SELECT id FROM rows_to_delete) RETURNING address, event_sig
+		) SELECT address, event_sig, COUNT(*) AS count FROM deleted GROUP BY address, event_sig`, limitClause)
+	var expired []PrunedLogs
+	err := o.ds.SelectContext(ctx, &expired, query, ubig.New(o.chainID))
+	return expired, err
 }
 
 // InsertLogs is idempotent to support replays.
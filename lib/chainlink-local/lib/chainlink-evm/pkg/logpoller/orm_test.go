@@ -495,14 +495,17 @@ func TestORM(t *testing.T) {
 
 	// Delete expired logs with page limit
 	time.Sleep(2 * time.Millisecond) // just in case we haven't reached the end of the 1ms retention period
-	deleted, err := o1.DeleteExpiredLogs(ctx, 1)
+	expired, err := o1.DeleteExpiredLogs(ctx, 1)
 	require.NoError(t, err)
-	assert.Equal(t, int64(1), deleted)
+	require.Len(t, expired, 1)
+	assert.Equal(t, common.HexToAddress("0x1236"), expired[0].Address)
+	assert.Equal(t, int64(1), expired[0].Count)
 
 	// Delete expired logs without page limit
-	deleted, err = o1.DeleteExpiredLogs(ctx, 0)
+	expired, err = o1.DeleteExpiredLogs(ctx, 0)
 	require.NoError(t, err)
-	assert.Equal(t, int64(1), deleted)
+	require.Len(t, expired, 1)
+	assert.Equal(t, logpoller.PrunedLogs{Address: common.HexToAddress("0x1236"), EventSig: topic, Count: 1}, expired[0])
 
 	// Select unmatched logs with page limit
 	ids, err := o1.SelectUnmatchedLogIDs(ctx, 2)
@@ -515,7 +518,7 @@ func TestORM(t *testing.T) {
 	assert.Len(t, ids, 3)
 
 	// Delete logs by row id
-	deleted, err = o1.DeleteLogsByRowID(ctx, ids)
+	deleted, err := o1.DeleteLogsByRowID(ctx, ids)
 	require.NoError(t, err)
 	assert.Equal(t, int64(3), deleted)
 
@@ -889,13 +892,18 @@ func TestORM_IndexedLogs(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, lgs, 1)
 
-	_, err = o1.SelectIndexedLogsByBlockRange(ctx, 1, 2, addr, eventSig, 0, []common.Hash{logpoller.EvmWord(1)})
-	require.Error(t, err)
-	assert.Contains(t, err.Error(), "invalid index for topic: 0")
+	// topic 0 is the event sig, unless the event is anonymous
+	lgs, err = o1.SelectIndexedLogsByBlockRange(ctx, 1, 2, addr, eventSig, 0, []common.Hash{eventSig})
+	require.NoError(t, err)
+	assert.Len(t, lgs, 4)
+
+	lgs, err = o1.FilteredLogs(ctx, blockRangeFilter("1", "2", 0, []uint64{1}), limiter, "")
+	require.NoError(t, err)
+	assert.Empty(t, lgs)
 
-	_, err = o1.FilteredLogs(ctx, blockRangeFilter("1", "2", 0, []uint64{1}), limiter, "")
+	_, err = o1.SelectIndexedLogsByBlockRange(ctx, 1, 2, addr, eventSig, -1, []common.Hash{logpoller.EvmWord(1)})
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "invalid index for topic: 0")
+	assert.Contains(t, err.Error(), "invalid index for topic: -1")
 
 	_, err = o1.SelectIndexedLogsByBlockRange(ctx, 1, 2, addr, eventSig, 4, []common.Hash{logpoller.EvmWord(1)})
 	require.Error(t, err)
@@ -174,7 +174,7 @@ func (v *pgDSLParser) visitEventTopicsByValueFilter(p *eventByTopicFilter) {
 		return
 	}
 
-	if !(p.Topic == 1 || p.Topic == 2 || p.Topic == 3) {
+	if p.Topic > 3 {
 		v.err = fmt.Errorf("invalid index for topic: %d", p.Topic)
 
 		return
@@ -499,6 +499,8 @@ func (f *addressFilter) Accept(visitor primitives.Visitor) {
 	switch v := visitor.(type) {
 	case *pgDSLParser:
 		v.visitAddressFilter(f)
+	case *memoryDSLParser:
+		v.visitAddressFilter(f)
 	}
 }
 
@@ -516,6 +518,8 @@ func (f *eventSigFilter) Accept(visitor primitives.Visitor) {
 	switch v := visitor.(type) {
 	case *pgDSLParser:
 		v.visitEventSigFilter(f)
+	case *memoryDSLParser:
+		v.visitEventSigFilter(f)
 	}
 }
 
@@ -540,6 +544,8 @@ func (f *eventByWordFilter) Accept(visitor primitives.Visitor) {
 	switch v := visitor.(type) {
 	case *pgDSLParser:
 		v.visitEventByWordFilter(f)
+	case *memoryDSLParser:
+		v.visitEventByWordFilter(f)
 	}
 }
 
@@ -559,6 +565,8 @@ func (f *eventByTopicFilter) Accept(visitor primitives.Visitor) {
 	switch v := visitor.(type) {
 	case *pgDSLParser:
 		v.visitEventTopicsByValueFilter(f)
+	case *memoryDSLParser:
+		v.visitEventTopicsByValueFilter(f)
 	}
 }
 
@@ -576,6 +584,8 @@ func (f *confirmationsFilter) Accept(visitor primitives.Visitor) {
 	switch v := visitor.(type) {
 	case *pgDSLParser:
 		v.VisitConfirmationsFilter(f)
+	case *memoryDSLParser:
+		v.VisitConfirmationsFilter(f)
 	}
 }
 
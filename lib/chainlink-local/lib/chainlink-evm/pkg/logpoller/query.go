@@ -157,8 +157,8 @@ func (q *queryArgs) withConfs(confs types.Confirmations) *queryArgs {
 }
 
 func (q *queryArgs) withTopicIndex(index int) *queryArgs {
-	// Only topicIndex 1 through 3 is valid. 0 is the event sig and only 4 total topics are allowed
-	if !(index == 1 || index == 2 || index == 3) {
+	// Only 4 total topics are allowed. topicIndex 0 is the event sig, except for anonymous events
+	if index < 0 || index > 3 {
 		q.err = append(q.err, fmt.Errorf("invalid index for topic: %d", index))
 	}
 	// Add 1 since postgresql arrays are 1-indexed.
@@ -28,7 +28,7 @@ func Test_QueryArgs(t *testing.T) {
 		},
 		{
 			name:      "invalid topic index",
-			queryArgs: newQueryArgs(big.NewInt(20)).withTopicIndex(0),
+			queryArgs: newQueryArgs(big.NewInt(20)).withTopicIndex(4),
 			wantErr:   true,
 		},
 		{
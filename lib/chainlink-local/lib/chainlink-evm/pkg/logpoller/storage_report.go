@@ -0,0 +1,213 @@
+package logpoller
+
+import (
+	"context"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	promLpFilterStoredLogs = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "log_poller_filter_stored_logs",
+		Help: "Number of stored logs matching the addresses and event sigs of a filter",
+	}, []string{"chainID", "filterName"})
+	promLpFilterOldestBlock = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "log_poller_filter_oldest_block",
+		Help: "Block number of the oldest stored log matching a filter, 0 if there are none",
+	}, []string{"chainID", "filterName"})
+	promLpFilterNewestBlock = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "log_poller_filter_newest_block",
+		Help: "Block number of the newest stored log matching a filter, 0 if there are none",
+	}, []string{"chainID", "filterName"})
+	promLpFilterPrunedLogs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "log_poller_filter_pruned_logs",
+		Help: "Number of logs matching a filter removed by log pruning because of their retention or MaxLogsKept",
+	}, []string{"chainID", "filterName"})
+	promLpFilterLogsPerBlockExceeded = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "log_poller_filter_logs_per_block_exceeded",
+		Help: "Number of saved blocks in which a filter matched more logs than its LogsPerBlock",
+	}, []string{"chainID", "filterName"})
+)
+
+// FilterStorageReport is the storage used by the logs of a filter, along with its retention settings and how
+// they were applied.
+type FilterStorageReport struct {
+	// FilterStorageStats are refreshed by every log pruning cycle, they are empty until the first one.
+	FilterStorageStats
+	// StatsUpdatedAt is when FilterStorageStats were refreshed, zero until the first log pruning cycle.
+	StatsUpdatedAt time.Time
+	Retention      time.Duration
+	MaxLogsKept    uint64
+	LogsPerBlock   uint64
+	// PrunedLogs is the number of logs matching the filter removed by log pruning since the log poller started,
+	// because of their retention or MaxLogsKept. Logs matching several filters are counted for each of them.
+	PrunedLogs int64
+	// LogsPerBlockExceeded is the number of blocks saved since the log poller started in which the filter matched
+	// more than LogsPerBlock logs.
+	LogsPerBlockExceeded uint64
+}
+
+// storageAccounting keeps the per filter counts of FilterStorageReport which are not stored in the db, and the
+// storage stats of the last log pruning cycle.
+type storageAccounting struct {
+	mu                   sync.Mutex
+	stats                map[string]FilterStorageStats
+	statsUpdatedAt       time.Time
+	prunedLogs           map[string]int64
+	logsPerBlockExceeded map[string]uint64
+}
+
+// FilterStorageReport returns the storage report of every registered filter, sorted by filter name.
+// Logs are matched to filters by address and event sig only, like pruning does.
+func (lp *logPoller) FilterStorageReport(_ context.Context) ([]FilterStorageReport, error) {
+	filters := lp.GetFilters()
+	lp.storage.mu.Lock()
+	defer lp.storage.mu.Unlock()
+	reports := make([]FilterStorageReport, 0, len(filters))
+	for name, filter := range filters {
+		stats, ok := lp.storage.stats[name]
+		if !ok {
+			stats = FilterStorageStats{Name: name}
+		}
+		reports = append(reports, FilterStorageReport{
+			FilterStorageStats:   stats,
+			StatsUpdatedAt:       lp.storage.statsUpdatedAt,
+			Retention:            filter.Retention,
+			MaxLogsKept:          filter.MaxLogsKept,
+			LogsPerBlock:         filter.LogsPerBlock,
+			PrunedLogs:           lp.storage.prunedLogs[name],
+			LogsPerBlockExceeded: lp.storage.logsPerBlockExceeded[name],
+		})
+	}
+	slices.SortFunc(reports, func(a, b FilterStorageReport) int { return strings.Compare(a.Name, b.Name) })
+	return reports, nil
+}
+
+// refreshFilterStorageStats counts the stored logs of every filter, it is run by the log pruning cycles as it scans
+// the logs table.
+func (lp *logPoller) refreshFilterStorageStats(ctx context.Context) error {
+	stats, err := lp.orm.SelectFilterStorageStats(ctx, math.MaxInt64)
+	if err != nil {
+		return err
+	}
+	lp.reportFilterStorageStats(stats)
+
+	byName := make(map[string]FilterStorageStats, len(stats))
+	for _, s := range stats {
+		byName[s.Name] = s
+	}
+	lp.storage.mu.Lock()
+	defer lp.storage.mu.Unlock()
+	lp.storage.stats = byName
+	lp.storage.statsUpdatedAt = time.Now()
+	return nil
+}
+
+// recordPrunedLogs adds the logs pruned by a log pruning cycle to the counts of each filter. Logs are matched to
+// filters by address and event sig, logs matching several filters are counted for each of them.
+func (lp *logPoller) recordPrunedLogs(pruned []PrunedLogs) {
+	if countPrunedLogs(pruned) == 0 {
+		return
+	}
+	type addressEvent struct {
+		address common.Address
+		event   common.Hash
+	}
+	counts := make(map[addressEvent]int64, len(pruned))
+	for _, p := range pruned {
+		counts[addressEvent{address: p.Address, event: p.EventSig}] += p.Count
+	}
+
+	filters := lp.GetFilters()
+	chainID := lp.ec.ConfiguredChainID().String()
+	lp.storage.mu.Lock()
+	defer lp.storage.mu.Unlock()
+	if lp.storage.prunedLogs == nil {
+		lp.storage.prunedLogs = make(map[string]int64)
+	}
+	for name, filter := range filters {
+		var n int64
+		for _, address := range filter.Addresses {
+			for _, event := range filter.EventSigs {
+				n += counts[addressEvent{address: address, event: event}]
+			}
+		}
+		if n == 0 {
+			continue
+		}
+		promLpFilterPrunedLogs.WithLabelValues(chainID, name).Add(float64(n))
+		lp.storage.prunedLogs[name] += n
+	}
+}
+
+func (lp *logPoller) reportFilterStorageStats(stats []FilterStorageStats) {
+	chainID := lp.ec.ConfiguredChainID().String()
+	for _, s := range stats {
+		promLpFilterStoredLogs.WithLabelValues(chainID, s.Name).Set(float64(s.LogCount))
+		promLpFilterOldestBlock.WithLabelValues(chainID, s.Name).Set(float64(s.OldestBlock))
+		promLpFilterNewestBlock.WithLabelValues(chainID, s.Name).Set(float64(s.NewestBlock))
+	}
+}
+
+// recordLogsPerBlock counts the blocks in which a filter with a LogsPerBlock limit matched more logs than the limit.
+// The logs are still saved, the counts are only reported.
+func (lp *logPoller) recordLogsPerBlock(logs []Log) {
+	if len(logs) == 0 {
+		return
+	}
+	lp.filterMu.RLock()
+	var limited []Filter
+	for _, filter := range lp.filters {
+		if filter.LogsPerBlock > 0 {
+			limited = append(limited, filter)
+		}
+	}
+	lp.filterMu.RUnlock()
+
+	for _, filter := range limited {
+		perBlock := make(map[int64]uint64)
+		for _, l := range logs {
+			if filterMatches(filter, l) {
+				perBlock[l.BlockNumber]++
+			}
+		}
+		var exceeded uint64
+		for block, n := range perBlock {
+			if n > filter.LogsPerBlock {
+				lp.lggr.Debugw("Filter matched more logs than its LogsPerBlock", "filterName", filter.Name, "block", block, "logs", n, "logsPerBlock", filter.LogsPerBlock)
+				exceeded++
+			}
+		}
+		if exceeded == 0 {
+			continue
+		}
+		promLpFilterLogsPerBlockExceeded.WithLabelValues(lp.ec.ConfiguredChainID().String(), filter.Name).Add(float64(exceeded))
+		lp.storage.mu.Lock()
+		if lp.storage.logsPerBlockExceeded == nil {
+			lp.storage.logsPerBlockExceeded = make(map[string]uint64)
+		}
+		lp.storage.logsPerBlockExceeded[filter.Name] += exceeded
+		lp.storage.mu.Unlock()
+	}
+}
+
+// forgetFilter drops the accounting and the metrics of an unregistered filter.
+func (s *storageAccounting) forgetFilter(chainID string, name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.stats, name)
+	delete(s.prunedLogs, name)
+	delete(s.logsPerBlockExceeded, name)
+	promLpFilterStoredLogs.DeleteLabelValues(chainID, name)
+	promLpFilterOldestBlock.DeleteLabelValues(chainID, name)
+	promLpFilterNewestBlock.DeleteLabelValues(chainID, name)
+	promLpFilterPrunedLogs.DeleteLabelValues(chainID, name)
+	promLpFilterLogsPerBlockExceeded.DeleteLabelValues(chainID, name)
+}
@@ -0,0 +1,450 @@
+package logpoller
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+)
+
+const (
+	// subscriptionMaxPending is the number of undelivered events kept in memory per subscription. When a consumer
+	// falls further behind, the pending events are dropped and the subscription catches up from the db instead.
+	subscriptionMaxPending = 100
+	// subscriptionCatchUpBlocks is the maximum block range read from the db in a single catch up query.
+	subscriptionCatchUpBlocks = 1000
+)
+
+var ErrFilterNotFound = pkgerrors.New("filter not found")
+
+// SubscriptionEvent is delivered to the consumer of a Subscription. Exactly one of Logs and Removed is set.
+type SubscriptionEvent struct {
+	// Logs are newly saved logs matching the filter, sorted by block number and log index.
+	Logs []Log
+	// Removed is set when a reorg removed blocks that logs were already delivered from.
+	Removed *RemovedLogs
+}
+
+// RemovedLogs notifies the consumer that all the logs delivered from FromBlock onwards are no longer canonical.
+// The logs of the new canonical chain are delivered in the following events.
+type RemovedLogs struct {
+	FromBlock int64
+}
+
+// subscriptionStore is the part of the ORM subscriptions use to catch up on the logs they missed.
+type subscriptionStore interface {
+	SelectLatestBlock(ctx context.Context) (*Block, error)
+	SelectLogsWithSigs(ctx context.Context, start, end int64, address common.Address, eventSigs []common.Hash) ([]Log, error)
+}
+
+type pendingEvent struct {
+	event   SubscriptionEvent
+	toBlock int64
+}
+
+// Subscription delivers the logs of a single filter as soon as the log poller saved them.
+//
+// Consumers that do not keep up never block the log poller: the undelivered events are buffered up to
+// subscriptionMaxPending, after that they are dropped and the subscription reads the missed logs from the
+// db once the consumer is ready again. Every log is delivered at least once per canonical chain, logs of
+// reorged blocks are followed by a RemovedLogs event.
+type Subscription struct {
+	filterName string
+	lookup     func(name string) (Filter, bool)
+	store      subscriptionStore
+	lggr       logger.SugaredLogger
+	events     chan SubscriptionEvent
+	wake       chan struct{}
+	stopCh     services.StopChan
+	wg         sync.WaitGroup
+	onClose    func()
+	closeOnce  sync.Once
+
+	mu sync.Mutex
+	// delivered is the last block queued for the consumer.
+	delivered int64
+	// sent is the last block handed to the consumer, it is behind delivered while there are pending events.
+	sent int64
+	// head is the last block published by the log poller.
+	head    int64
+	pending []pendingEvent
+	// lagging is set when the pending events overflowed or a resume was requested, the subscription
+	// then reads the logs after delivered from the db.
+	lagging bool
+	// removedFrom is the first block of the pending RemovedLogs event, 0 if there is none.
+	removedFrom int64
+	// reorgs counts the reorgs, it is used to discard the results of a catch up query that raced with a reorg.
+	reorgs uint64
+}
+
+// Events returns the channel the events are delivered on. It is closed when the subscription is closed.
+func (s *Subscription) Events() <-chan SubscriptionEvent {
+	return s.events
+}
+
+// FilterName returns the name of the filter the subscription delivers logs for.
+func (s *Subscription) FilterName() string {
+	return s.filterName
+}
+
+// Close stops the delivery and closes the events channel.
+func (s *Subscription) Close() {
+	s.closeOnce.Do(func() {
+		close(s.stopCh)
+		s.wg.Wait()
+		close(s.events)
+		if s.onClose != nil {
+			s.onClose()
+		}
+	})
+}
+
+func (s *Subscription) start() {
+	s.wg.Add(1)
+	go s.run()
+}
+
+func (s *Subscription) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// publishLogs hands the logs saved by the log poller up to and including toBlock to the subscription.
+func (s *Subscription) publishLogs(logs []Log, toBlock int64) {
+	filter, ok := s.lookup(s.filterName)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.head = max(s.head, toBlock)
+	if s.lagging || toBlock <= s.delivered {
+		// Either the catch up reads these logs from the db, or they were already delivered during a replay.
+		return
+	}
+
+	var matched []Log
+	for _, l := range logs {
+		if l.BlockNumber > s.delivered && filterMatches(filter, l) {
+			matched = append(matched, l)
+		}
+	}
+	if len(matched) > 0 {
+		if len(s.pending) >= subscriptionMaxPending {
+			s.lggr.Warnw("Subscriber is not keeping up, catching up from the db once it does",
+				"filter", s.filterName, "sentBlock", s.sent)
+			s.pending = nil
+			s.delivered = s.sent
+			s.lagging = true
+			s.notify()
+			return
+		}
+		sortLogs(matched)
+		s.pending = append(s.pending, pendingEvent{event: SubscriptionEvent{Logs: matched}, toBlock: toBlock})
+	}
+	s.delivered = toBlock
+	s.notify()
+}
+
+// publishReorg notifies the subscription that all the blocks starting at fromBlock were removed.
+func (s *Subscription) publishReorg(fromBlock int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.reorgs++
+	s.head = min(s.head, fromBlock-1)
+
+	// Events that were not handed to the consumer yet are simply dropped.
+	kept := s.pending[:0]
+	for _, p := range s.pending {
+		var logs []Log
+		for _, l := range p.event.Logs {
+			if l.BlockNumber < fromBlock {
+				logs = append(logs, l)
+			}
+		}
+		if len(logs) > 0 {
+			kept = append(kept, pendingEvent{event: SubscriptionEvent{Logs: logs}, toBlock: min(p.toBlock, fromBlock-1)})
+		}
+	}
+	s.pending = kept
+
+	s.delivered = min(s.delivered, fromBlock-1)
+	if fromBlock <= s.sent {
+		if s.removedFrom == 0 || fromBlock < s.removedFrom {
+			s.removedFrom = fromBlock
+		}
+		s.sent = fromBlock - 1
+		s.notify()
+	}
+}
+
+func (s *Subscription) run() {
+	defer s.wg.Done()
+	ctx, cancel := s.stopCh.NewCtx()
+	defer cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.wake:
+		}
+
+		for {
+			ev, ok := s.next(ctx)
+			if !ok {
+				break
+			}
+			select {
+			case s.events <- ev:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// next returns the next event to deliver, reorg notifications are delivered first.
+func (s *Subscription) next(ctx context.Context) (SubscriptionEvent, bool) {
+	for {
+		s.mu.Lock()
+		if s.removedFrom != 0 {
+			ev := SubscriptionEvent{Removed: &RemovedLogs{FromBlock: s.removedFrom}}
+			s.removedFrom = 0
+			s.mu.Unlock()
+			return ev, true
+		}
+		if !s.lagging {
+			if len(s.pending) == 0 {
+				s.sent = s.delivered
+				s.mu.Unlock()
+				return SubscriptionEvent{}, false
+			}
+			p := s.pending[0]
+			s.pending = s.pending[1:]
+			s.sent = p.toBlock
+			s.mu.Unlock()
+			return p.event, true
+		}
+		from, reorgs := s.delivered+1, s.reorgs
+		s.mu.Unlock()
+
+		logs, to, err := s.catchUp(ctx, from)
+		if err != nil {
+			if ctx.Err() == nil {
+				s.lggr.Warnw("Failed to catch up subscription from the db, retrying on the next poll",
+					"filter", s.filterName, "fromBlock", from, "err", err)
+			}
+			return SubscriptionEvent{}, false
+		}
+
+		s.mu.Lock()
+		if s.reorgs != reorgs || s.delivered+1 != from {
+			// A reorg removed some of the blocks that were read, read them again.
+			s.mu.Unlock()
+			continue
+		}
+		s.delivered = to
+		s.sent = to
+		if to >= s.head {
+			s.lagging = false
+		}
+		s.mu.Unlock()
+
+		if len(logs) > 0 {
+			return SubscriptionEvent{Logs: logs}, true
+		}
+		if to < from {
+			return SubscriptionEvent{}, false
+		}
+	}
+}
+
+// catchUp reads the logs of the filter saved after fromBlock, up to subscriptionCatchUpBlocks blocks at once.
+// It returns the logs and the last block that was read.
+func (s *Subscription) catchUp(ctx context.Context, fromBlock int64) ([]Log, int64, error) {
+	filter, ok := s.lookup(s.filterName)
+	if !ok {
+		return nil, 0, ErrFilterNotFound
+	}
+
+	latest, err := s.store.SelectLatestBlock(ctx)
+	if err != nil {
+		if pkgerrors.Is(err, sql.ErrNoRows) {
+			return nil, fromBlock - 1, nil
+		}
+		return nil, 0, err
+	}
+	toBlock := min(latest.BlockNumber, fromBlock+subscriptionCatchUpBlocks-1)
+	if toBlock < fromBlock {
+		return nil, fromBlock - 1, nil
+	}
+
+	var logs []Log
+	for _, address := range filter.Addresses {
+		addressLogs, err := s.store.SelectLogsWithSigs(ctx, fromBlock, toBlock, address, filter.EventSigs)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, l := range addressLogs {
+			if filterMatches(filter, l) {
+				logs = append(logs, l)
+			}
+		}
+	}
+	sortLogs(logs)
+	return logs, toBlock, nil
+}
+
+// filterMatches returns true if the log matches the addresses, event signatures and topics of the filter.
+func filterMatches(filter Filter, l Log) bool {
+	if !slices.Contains(filter.Addresses, l.Address) || !slices.Contains(filter.EventSigs, l.EventSig) {
+		return false
+	}
+	topics := l.GetTopics()
+	for i, values := range [][]common.Hash{filter.Topic2, filter.Topic3, filter.Topic4} {
+		if len(values) == 0 {
+			continue
+		}
+		if len(topics) <= i+1 || !slices.Contains(values, topics[i+1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func sortLogs(logs []Log) {
+	slices.SortFunc(logs, func(a, b Log) int {
+		if a.BlockNumber != b.BlockNumber {
+			return cmp.Compare(a.BlockNumber, b.BlockNumber)
+		}
+		return cmp.Compare(a.LogIndex, b.LogIndex)
+	})
+}
+
+// subscriptions is the set of subscriptions of a log poller.
+type subscriptions struct {
+	mu   sync.RWMutex
+	subs map[*Subscription]struct{}
+}
+
+// add runs init and adds the subscription, both while holding the lock.
+func (ss *subscriptions) add(s *Subscription, init func() error) error {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	if err := init(); err != nil {
+		return err
+	}
+	if ss.subs == nil {
+		ss.subs = make(map[*Subscription]struct{})
+	}
+	ss.subs[s] = struct{}{}
+	return nil
+}
+
+func (ss *subscriptions) remove(s *Subscription) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	delete(ss.subs, s)
+}
+
+func (ss *subscriptions) publishLogs(logs []Log, toBlock int64) {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+	for s := range ss.subs {
+		s.publishLogs(logs, toBlock)
+	}
+}
+
+func (ss *subscriptions) publishReorg(fromBlock int64) {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+	for s := range ss.subs {
+		s.publishReorg(fromBlock)
+	}
+}
+
+func (ss *subscriptions) closeAll() {
+	ss.mu.Lock()
+	subs := make([]*Subscription, 0, len(ss.subs))
+	for s := range ss.subs {
+		subs = append(subs, s)
+	}
+	ss.mu.Unlock()
+
+	for _, s := range subs {
+		s.Close()
+	}
+}
+
+// Subscribe returns a Subscription delivering the logs of the named filter right after they are saved, along with
+// reorg notifications. If fromBlock is positive, the logs of the filter already saved from fromBlock onwards are
+// delivered first, which allows consumers to resume from the last block they processed. Otherwise, only the logs
+// saved after the call are delivered.
+// Logs recovered by the backup poller for blocks that were already delivered are not pushed to the subscribers.
+func (lp *logPoller) Subscribe(ctx context.Context, filterName string, fromBlock int64) (*Subscription, error) {
+	if !lp.HasFilter(filterName) {
+		return nil, pkgerrors.Wrapf(ErrFilterNotFound, "cannot subscribe to %s", filterName)
+	}
+
+	s := &Subscription{
+		filterName: filterName,
+		lookup:     lp.lookupFilter,
+		store:      lp.orm,
+		lggr:       logger.Sugared(logger.Named(lp.lggr, "Subscription")),
+		events:     make(chan SubscriptionEvent),
+		wake:       make(chan struct{}, 1),
+		stopCh:     make(chan struct{}),
+	}
+	s.onClose = func() { lp.subs.remove(s) }
+
+	err := lp.subs.add(s, func() error {
+		if fromBlock > 0 {
+			s.delivered = fromBlock - 1
+			s.sent = fromBlock - 1
+			s.lagging = true
+			return nil
+		}
+		// This runs while holding the subscriptions lock, so no block can be published in between.
+		latest, err := lp.orm.SelectLatestBlock(ctx)
+		if err != nil {
+			if pkgerrors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return pkgerrors.Wrap(err, "unable to get latest block")
+		}
+		s.delivered = latest.BlockNumber
+		s.sent = latest.BlockNumber
+		s.head = latest.BlockNumber
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.start()
+	if s.lagging {
+		s.notify()
+	}
+	return s, nil
+}
+
+// lookupFilter returns the filter with the given name, the returned filter must not be modified.
+func (lp *logPoller) lookupFilter(name string) (Filter, bool) {
+	lp.filterMu.RLock()
+	defer lp.filterMu.RUnlock()
+	filter, ok := lp.filters[name]
+	return filter, ok
+}
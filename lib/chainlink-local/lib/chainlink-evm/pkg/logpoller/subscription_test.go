@@ -0,0 +1,220 @@
+package logpoller
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+)
+
+// subscriptionORM keeps the saved logs in memory, only the queries used by subscriptions are implemented.
+type subscriptionORM struct {
+	ORM
+	mu     sync.Mutex
+	logs   []Log
+	latest int64
+}
+
+func (o *subscriptionORM) save(logs []Log, block int64) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.logs = append(o.logs, logs...)
+	o.latest = block
+}
+
+func (o *subscriptionORM) SelectLatestBlock(context.Context) (*Block, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.latest == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &Block{BlockNumber: o.latest}, nil
+}
+
+func (o *subscriptionORM) SelectLogsWithSigs(_ context.Context, start, end int64, address common.Address, eventSigs []common.Hash) ([]Log, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var logs []Log
+	for _, l := range o.logs {
+		if l.BlockNumber >= start && l.BlockNumber <= end && l.Address == address {
+			for _, sig := range eventSigs {
+				if l.EventSig == sig {
+					logs = append(logs, l)
+				}
+			}
+		}
+	}
+	return logs, nil
+}
+
+var (
+	subAddress  = common.HexToAddress("0x1234")
+	subEventSig = common.HexToHash("0x1111")
+)
+
+func newSubscriptionTestPoller(t *testing.T) (*logPoller, *subscriptionORM) {
+	orm := &subscriptionORM{}
+	lp := NewLogPoller(orm, nil, logger.Test(t), nil, Opts{PollPeriod: time.Second})
+	lp.filters["filter"] = Filter{
+		Name:      "filter",
+		Addresses: []common.Address{subAddress},
+		EventSigs: []common.Hash{subEventSig},
+	}
+	return lp, orm
+}
+
+func subLog(block, index int64) Log {
+	return Log{
+		BlockNumber: block,
+		LogIndex:    index,
+		Address:     subAddress,
+		EventSig:    subEventSig,
+		Topics:      [][]byte{subEventSig.Bytes()},
+	}
+}
+
+// saveAndPublish saves the logs of the block and publishes them, like pollAndSaveLogs does.
+func saveAndPublish(lp *logPoller, orm *subscriptionORM, block int64, logs ...Log) {
+	orm.save(logs, block)
+	lp.subs.publishLogs(logs, block)
+}
+
+func receive(t *testing.T, sub *Subscription) SubscriptionEvent {
+	select {
+	case ev, ok := <-sub.Events():
+		require.True(t, ok)
+		return ev
+	case <-time.After(testutils.WaitTimeout(t)):
+		require.FailNow(t, "timed out waiting for subscription event")
+	}
+	return SubscriptionEvent{}
+}
+
+func blockNumbers(logs []Log) []int64 {
+	numbers := make([]int64, len(logs))
+	for i, l := range logs {
+		numbers[i] = l.BlockNumber
+	}
+	return numbers
+}
+
+func TestLogPoller_Subscribe(t *testing.T) {
+	ctx := testutils.Context(t)
+
+	t.Run("unknown filter", func(t *testing.T) {
+		lp, _ := newSubscriptionTestPoller(t)
+		_, err := lp.Subscribe(ctx, "unknown", 0)
+		require.ErrorIs(t, err, ErrFilterNotFound)
+	})
+
+	t.Run("delivers new matching logs", func(t *testing.T) {
+		lp, orm := newSubscriptionTestPoller(t)
+		saveAndPublish(lp, orm, 1, subLog(1, 0))
+
+		sub, err := lp.Subscribe(ctx, "filter", 0)
+		require.NoError(t, err)
+		defer sub.Close()
+
+		other := subLog(2, 0)
+		other.Address = common.HexToAddress("0x5678")
+		saveAndPublish(lp, orm, 2, subLog(2, 1), other)
+
+		ev := receive(t, sub)
+		require.Nil(t, ev.Removed)
+		require.Len(t, ev.Logs, 1)
+		assert.Equal(t, int64(1), ev.Logs[0].LogIndex)
+	})
+
+	t.Run("notifies about reorged logs", func(t *testing.T) {
+		lp, orm := newSubscriptionTestPoller(t)
+		sub, err := lp.Subscribe(ctx, "filter", 0)
+		require.NoError(t, err)
+		defer sub.Close()
+
+		saveAndPublish(lp, orm, 1, subLog(1, 0))
+		saveAndPublish(lp, orm, 2, subLog(2, 0))
+		assert.Equal(t, []int64{1}, blockNumbers(receive(t, sub).Logs))
+		assert.Equal(t, []int64{2}, blockNumbers(receive(t, sub).Logs))
+
+		lp.subs.publishReorg(2)
+		saveAndPublish(lp, orm, 2, subLog(2, 5))
+
+		ev := receive(t, sub)
+		require.NotNil(t, ev.Removed)
+		assert.Equal(t, int64(2), ev.Removed.FromBlock)
+		ev = receive(t, sub)
+		require.Len(t, ev.Logs, 1)
+		assert.Equal(t, int64(5), ev.Logs[0].LogIndex)
+	})
+
+	t.Run("resumes from block", func(t *testing.T) {
+		lp, orm := newSubscriptionTestPoller(t)
+		for block := int64(1); block <= 5; block++ {
+			saveAndPublish(lp, orm, block, subLog(block, 0))
+		}
+
+		sub, err := lp.Subscribe(ctx, "filter", 3)
+		require.NoError(t, err)
+		defer sub.Close()
+
+		assert.Equal(t, []int64{3, 4, 5}, blockNumbers(receive(t, sub).Logs))
+		saveAndPublish(lp, orm, 6, subLog(6, 0))
+		assert.Equal(t, []int64{6}, blockNumbers(receive(t, sub).Logs))
+	})
+
+	t.Run("slow consumer catches up from the db", func(t *testing.T) {
+		lp, orm := newSubscriptionTestPoller(t)
+		sub, err := lp.Subscribe(ctx, "filter", 0)
+		require.NoError(t, err)
+		defer sub.Close()
+
+		blocks := int64(subscriptionMaxPending + 50)
+		for block := int64(1); block <= blocks; block++ {
+			saveAndPublish(lp, orm, block, subLog(block, 0))
+		}
+
+		var received []int64
+		for int64(len(received)) < blocks {
+			received = append(received, blockNumbers(receive(t, sub).Logs)...)
+		}
+		for i, block := range received {
+			require.Equal(t, int64(i+1), block)
+		}
+	})
+
+	t.Run("closed with the log poller", func(t *testing.T) {
+		lp, _ := newSubscriptionTestPoller(t)
+		sub, err := lp.Subscribe(ctx, "filter", 0)
+		require.NoError(t, err)
+
+		lp.subs.closeAll()
+		_, ok := <-sub.Events()
+		assert.False(t, ok)
+		assert.Empty(t, lp.subs.subs)
+	})
+}
+
+func TestFilterMatches(t *testing.T) {
+	topic := common.HexToHash("0x2222")
+	filter := Filter{
+		Addresses: []common.Address{subAddress},
+		EventSigs: []common.Hash{subEventSig},
+		Topic2:    []common.Hash{topic},
+	}
+
+	l := subLog(1, 0)
+	assert.False(t, filterMatches(filter, l))
+	l.Topics = [][]byte{subEventSig.Bytes(), topic.Bytes()}
+	assert.True(t, filterMatches(filter, l))
+	l.EventSig = common.HexToHash("0x3333")
+	assert.False(t, filterMatches(filter, l))
+}
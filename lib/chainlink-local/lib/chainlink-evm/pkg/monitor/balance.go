@@ -6,6 +6,7 @@ import (
 	"math"
 	"math/big"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	pkgerrors "github.com/pkg/errors"
@@ -21,6 +22,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
 	evmclient "github.com/smartcontractkit/chainlink-evm/pkg/client"
+	"github.com/smartcontractkit/chainlink-evm/pkg/config"
 	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
 )
 
@@ -30,6 +32,13 @@ type (
 	BalanceMonitor interface {
 		HeadTrackable
 		GetEthBalance(common.Address) *assets.Eth
+		// GetTokenBalance returns the balance of the ERC-20 token for the key, in the smallest token unit, or nil if
+		// the token is not monitored.
+		GetTokenBalance(address common.Address, token common.Address) *big.Int
+		// Runway returns the projected time until the key runs out of the asset at its recent spend rate.
+		Runway(address common.Address, asset common.Address) (time.Duration, bool)
+		// SubscribeBalanceEvents returns a channel receiving the balance threshold crossings, and a function to unsubscribe.
+		SubscribeBalanceEvents() (<-chan BalanceEvent, func())
 		services.Service
 	}
 
@@ -40,9 +49,13 @@ type (
 		ethClient      evmclient.Client
 		chainIDStr     string
 		ethKeyStore    keys.AddressLister
+		cfg            config.BalanceMonitor
 		ethBalances    map[common.Address]*assets.Eth
+		tokenBalances  map[balanceKey]*big.Int
 		ethBalancesMtx sync.RWMutex
+		balances       balanceTracker
 		sleeperTask    *utils.SleeperTask
+		now            func() time.Time
 	}
 
 	NullBalanceMonitor struct{}
@@ -50,13 +63,22 @@ type (
 
 var _ BalanceMonitor = (*balanceMonitor)(nil)
 
-// NewBalanceMonitor returns a new balanceMonitor
+// NewBalanceMonitor returns a new balanceMonitor which only monitors the native balance of the keys, without thresholds
 func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keys.AddressLister, lggr logger.Logger) *balanceMonitor {
+	return NewBalanceMonitorWithConfig(ethClient, ethKeyStore, nil, lggr)
+}
+
+// NewBalanceMonitorWithConfig returns a new balanceMonitor which also monitors the balances of the configured ERC-20
+// tokens, and reports the balances below the configured thresholds. cfg may be nil.
+func NewBalanceMonitorWithConfig(ethClient evmclient.Client, ethKeyStore keys.AddressLister, cfg config.BalanceMonitor, lggr logger.Logger) *balanceMonitor {
 	bm := &balanceMonitor{
-		ethClient:   ethClient,
-		chainIDStr:  ethClient.ConfiguredChainID().String(),
-		ethKeyStore: ethKeyStore,
-		ethBalances: make(map[common.Address]*assets.Eth),
+		ethClient:     ethClient,
+		chainIDStr:    ethClient.ConfiguredChainID().String(),
+		ethKeyStore:   ethKeyStore,
+		cfg:           cfg,
+		ethBalances:   make(map[common.Address]*assets.Eth),
+		tokenBalances: make(map[balanceKey]*big.Int),
+		now:           time.Now,
 	}
 	bm.Service, bm.eng = services.Config{
 		Name:  "BalanceMonitor",
@@ -111,12 +133,35 @@ func (bm *balanceMonitor) updateBalance(ethBal assets.Eth, address common.Addres
 	}
 }
 
+func (bm *balanceMonitor) updateTokenBalance(bal *big.Int, address common.Address, token config.BalanceMonitorToken) {
+	balanceFloat, _ := new(big.Float).SetInt(bal).Float64()
+	promTokenBalance.WithLabelValues(address.Hex(), bm.chainIDStr, token.Address.Hex(), token.Symbol).Set(balanceFloat)
+
+	key := balanceKey{address: address, asset: token.Address}
+	bm.ethBalancesMtx.Lock()
+	oldBal := bm.tokenBalances[key]
+	bm.tokenBalances[key] = bal
+	bm.ethBalancesMtx.Unlock()
+
+	if oldBal == nil || bal.Cmp(oldBal) != 0 {
+		logger.Named(bm.eng, "BalanceLog").Infow(fmt.Sprintf("%s balance for %s: %s", token.Symbol, address.Hex(), bal),
+			"address", address.Hex(), "token", token.Address.Hex(), "balance", bal)
+	}
+	bm.checkThresholds(address, token.Address, token.Symbol, bal, token.LowBalance, token.CriticalBalance)
+}
+
 func (bm *balanceMonitor) GetEthBalance(address common.Address) *assets.Eth {
 	bm.ethBalancesMtx.RLock()
 	defer bm.ethBalancesMtx.RUnlock()
 	return bm.ethBalances[address]
 }
 
+func (bm *balanceMonitor) GetTokenBalance(address common.Address, token common.Address) *big.Int {
+	bm.ethBalancesMtx.RLock()
+	defer bm.ethBalancesMtx.RUnlock()
+	return bm.tokenBalances[balanceKey{address: address, asset: token}]
+}
+
 // Deprecated: use github.com/smartcontractkit/chainlink-framework/metrics.AccountBalance instead.
 var promETHBalance = promauto.NewGaugeVec(
 	prometheus.GaugeOpts{
@@ -126,6 +171,14 @@ var promETHBalance = promauto.NewGaugeVec(
 	[]string{"account", "evmChainID"},
 )
 
+var promTokenBalance = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "token_balance",
+		Help: "Each Ethereum account's balance of the monitored ERC-20 tokens, in the smallest token unit",
+	},
+	[]string{"account", "evmChainID", "token", "symbol"},
+)
+
 func (bm *balanceMonitor) promUpdateEthBalance(balance *assets.Eth, from common.Address) {
 	balanceFloat, err := ApproximateFloat64(balance)
 
@@ -180,13 +233,54 @@ func (w *worker) checkAccountBalance(ctx context.Context, address common.Address
 	} else {
 		ethBal := assets.Eth(*bal)
 		w.bm.updateBalance(ethBal, address)
+		if w.bm.cfg != nil {
+			w.bm.checkThresholds(address, NativeAsset, "ETH", bal, w.bm.cfg.LowBalance(address).ToInt(), w.bm.cfg.CriticalBalance(address).ToInt())
+		}
+	}
+	if w.bm.cfg == nil {
+		return
+	}
+	for _, token := range w.bm.cfg.Tokens() {
+		w.checkTokenBalance(ctx, address, token)
 	}
 }
 
+func (w *worker) checkTokenBalance(ctx context.Context, address common.Address, token config.BalanceMonitorToken) {
+	bal, err := w.bm.ethClient.TokenBalance(ctx, address, token.Address)
+	if err != nil {
+		w.bm.eng.Errorw(fmt.Sprintf("BalanceMonitor: error getting %s balance for key %s", token.Symbol, address.Hex()),
+			"err", err,
+			"address", address,
+			"token", token.Address,
+		)
+		return
+	} else if bal == nil {
+		w.bm.eng.Errorw(fmt.Sprintf("BalanceMonitor: error getting %s balance for key %s: invariant violation, bal may not be nil", token.Symbol, address.Hex()),
+			"address", address,
+			"token", token.Address,
+		)
+		return
+	}
+	w.bm.updateTokenBalance(bal, address, token)
+}
+
 func (*NullBalanceMonitor) GetEthBalance(common.Address) *assets.Eth {
 	return nil
 }
 
+func (*NullBalanceMonitor) GetTokenBalance(common.Address, common.Address) *big.Int {
+	return nil
+}
+
+func (*NullBalanceMonitor) Runway(common.Address, common.Address) (time.Duration, bool) {
+	return 0, false
+}
+
+// SubscribeBalanceEvents returns a channel which never receives events for NullBalanceMonitor.
+func (*NullBalanceMonitor) SubscribeBalanceEvents() (<-chan BalanceEvent, func()) {
+	return make(chan BalanceEvent), func() {}
+}
+
 // Start does noop for NullBalanceMonitor.
 func (*NullBalanceMonitor) Start(context.Context) error                                { return nil }
 func (*NullBalanceMonitor) Close() error                                               { return nil }
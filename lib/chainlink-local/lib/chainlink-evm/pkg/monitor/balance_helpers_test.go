@@ -1,5 +1,22 @@
 package monitor
 
+import (
+	"context"
+	"time"
+)
+
 func (bm *balanceMonitor) WorkDone() <-chan struct{} {
 	return bm.sleeperTask.WorkDone()
 }
+
+func (bm *balanceMonitor) SetNow(now func() time.Time) {
+	bm.now = now
+}
+
+func (s *topUpService) CheckTopUps(ctx context.Context) {
+	s.checkTopUps(ctx)
+}
+
+func (s *topUpService) SetNow(now func() time.Time) {
+	s.now = now
+}
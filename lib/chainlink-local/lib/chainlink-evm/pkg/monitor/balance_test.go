@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/onsi/gomega"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -19,8 +20,10 @@ import (
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
 	"github.com/smartcontractkit/chainlink-evm/pkg/client/clienttest"
+	"github.com/smartcontractkit/chainlink-evm/pkg/config"
 	"github.com/smartcontractkit/chainlink-evm/pkg/keys/keystest"
 	"github.com/smartcontractkit/chainlink-evm/pkg/monitor"
+	"github.com/smartcontractkit/chainlink-evm/pkg/monitor/mocks"
 	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
 )
 
@@ -214,6 +217,150 @@ func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 	assert.LessOrEqual(t, callCount.Load(), int32(1))
 }
 
+type balanceMonitorConfig struct {
+	low, critical *assets.Wei
+	tokens        []config.BalanceMonitorToken
+	topUp         topUpConfig
+}
+
+func (c *balanceMonitorConfig) Enabled() bool                              { return true }
+func (c *balanceMonitorConfig) LowBalance(common.Address) *assets.Wei      { return c.low }
+func (c *balanceMonitorConfig) CriticalBalance(common.Address) *assets.Wei { return c.critical }
+func (c *balanceMonitorConfig) Tokens() []config.BalanceMonitorToken       { return c.tokens }
+func (c *balanceMonitorConfig) TopUp() config.BalanceMonitorTopUp          { return &c.topUp }
+
+func TestBalanceMonitor_Thresholds(t *testing.T) {
+	t.Parallel()
+
+	k0Addr := testutils.NewAddress()
+	linkAddr := testutils.NewAddress()
+	ethKeyStore := keystest.Addresses{k0Addr}
+	ethClient := newEthClientMock(t)
+	cfg := &balanceMonitorConfig{
+		low:      assets.NewWeiI(10),
+		critical: assets.NewWeiI(2),
+		tokens:   []config.BalanceMonitorToken{{Address: linkAddr, Symbol: "LINK", LowBalance: big.NewInt(50), CriticalBalance: big.NewInt(20)}},
+	}
+
+	bm := monitor.NewBalanceMonitorWithConfig(ethClient, ethKeyStore, cfg, logger.Test(t))
+	events, unsubscribe := bm.SubscribeBalanceEvents()
+	t.Cleanup(unsubscribe)
+
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(5), nil)
+	ethClient.On("TokenBalance", mock.Anything, k0Addr, linkAddr).Once().Return(big.NewInt(100), nil)
+	servicetest.Run(t, bm)
+
+	assert.Equal(t, big.NewInt(100), bm.GetTokenBalance(k0Addr, linkAddr))
+	assert.Nil(t, bm.GetTokenBalance(k0Addr, testutils.NewAddress()))
+	e := <-events
+	assert.Equal(t, monitor.NativeAsset, e.Asset)
+	assert.Equal(t, k0Addr, e.Address)
+	assert.Equal(t, monitor.BalanceLevelLow, e.Level)
+	assert.Equal(t, monitor.BalanceLevelOK, e.PreviousLevel)
+	assert.Empty(t, events)
+	require.NoError(t, bm.Ready())
+	require.ErrorIs(t, bm.HealthReport()[bm.Name()], monitor.ErrBalanceLow)
+
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(1), nil)
+	ethClient.On("TokenBalance", mock.Anything, k0Addr, linkAddr).Once().Return(big.NewInt(30), nil)
+	bm.OnNewLongestChain(tests.Context(t), testutils.Head(0))
+	<-bm.WorkDone()
+
+	e = <-events
+	assert.Equal(t, monitor.NativeAsset, e.Asset)
+	assert.Equal(t, monitor.BalanceLevelCritical, e.Level)
+	assert.Equal(t, monitor.BalanceLevelLow, e.PreviousLevel)
+	assert.Equal(t, big.NewInt(1), e.Balance)
+	e = <-events
+	assert.Equal(t, linkAddr, e.Asset)
+	assert.Equal(t, "LINK", e.Symbol)
+	assert.Equal(t, monitor.BalanceLevelLow, e.Level)
+	require.ErrorIs(t, bm.HealthReport()[bm.Name()], monitor.ErrBalanceCritical)
+
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(20), nil)
+	ethClient.On("TokenBalance", mock.Anything, k0Addr, linkAddr).Once().Return(big.NewInt(30), nil)
+	bm.OnNewLongestChain(tests.Context(t), testutils.Head(1))
+	<-bm.WorkDone()
+
+	e = <-events
+	assert.Equal(t, monitor.NativeAsset, e.Asset)
+	assert.Equal(t, monitor.BalanceLevelOK, e.Level)
+	assert.Equal(t, monitor.BalanceLevelCritical, e.PreviousLevel)
+	assert.Empty(t, events)
+	// the LINK balance is still low
+	require.ErrorIs(t, bm.HealthReport()[bm.Name()], monitor.ErrBalanceLow)
+	require.NotErrorIs(t, bm.HealthReport()[bm.Name()], monitor.ErrBalanceCritical)
+
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(20), nil)
+	ethClient.On("TokenBalance", mock.Anything, k0Addr, linkAddr).Once().Return(big.NewInt(60), nil)
+	bm.OnNewLongestChain(tests.Context(t), testutils.Head(2))
+	<-bm.WorkDone()
+
+	e = <-events
+	assert.Equal(t, linkAddr, e.Asset)
+	assert.Equal(t, monitor.BalanceLevelOK, e.Level)
+	require.NoError(t, bm.HealthReport()[bm.Name()])
+}
+
+func TestBalanceMonitor_Runway(t *testing.T) {
+	t.Parallel()
+
+	k0Addr := testutils.NewAddress()
+	ethKeyStore := keystest.Addresses{k0Addr}
+	ethClient := newEthClientMock(t)
+
+	bm := monitor.NewBalanceMonitorWithConfig(ethClient, ethKeyStore, &balanceMonitorConfig{}, logger.Test(t))
+	now := time.Now()
+	bm.SetNow(func() time.Time { return now })
+
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(100), nil)
+	servicetest.RunHealthy(t, bm)
+	_, ok := bm.Runway(k0Addr, monitor.NativeAsset)
+	assert.False(t, ok, "a single sample has no spend rate")
+
+	checkBalance := func(bal int64, after time.Duration) {
+		now = now.Add(after)
+		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(bal), nil)
+		bm.OnNewLongestChain(tests.Context(t), testutils.Head(0))
+		<-bm.WorkDone()
+	}
+
+	checkBalance(90, 10*time.Minute)
+	runway, ok := bm.Runway(k0Addr, monitor.NativeAsset)
+	require.True(t, ok)
+	assert.Equal(t, 90*time.Minute, runway)
+
+	// Top-ups are not counted as spend
+	checkBalance(120, 10*time.Minute)
+	runway, ok = bm.Runway(k0Addr, monitor.NativeAsset)
+	require.True(t, ok)
+	assert.Equal(t, 240*time.Minute, runway)
+
+	// Samples older than an hour are dropped
+	checkBalance(120, 2*time.Hour)
+	_, ok = bm.Runway(k0Addr, monitor.NativeAsset)
+	assert.False(t, ok)
+}
+
+func TestLowBalancePolicy(t *testing.T) {
+	t.Parallel()
+
+	k0Addr, k1Addr, k2Addr := testutils.NewAddress(), testutils.NewAddress(), testutils.NewAddress()
+	bm := mocks.NewBalanceMonitor(t)
+	bm.EXPECT().GetEthBalance(k0Addr).Return(assets.NewEth(10))
+	bm.EXPECT().GetEthBalance(k1Addr).Return(assets.NewEth(1000))
+	bm.EXPECT().GetEthBalance(k2Addr).Return(nil)
+
+	ctx := tests.Context(t)
+	p := monitor.NewLowBalancePolicy(bm, &balanceMonitorConfig{low: assets.NewWeiI(100)})
+	assert.Zero(t, p.Weight(ctx, k0Addr))
+	assert.Equal(t, uint32(1), p.Weight(ctx, k1Addr))
+	assert.Equal(t, uint32(1), p.Weight(ctx, k2Addr), "unknown balances are not skipped")
+
+	p = monitor.NewLowBalancePolicy(bm, &balanceMonitorConfig{})
+	assert.Equal(t, uint32(1), p.Weight(ctx, k0Addr), "keys are not skipped without a threshold")
+}
+
 func Test_ApproximateFloat64(t *testing.T) {
 	t.Parallel()
 
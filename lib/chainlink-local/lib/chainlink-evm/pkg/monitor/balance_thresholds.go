@@ -0,0 +1,262 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/config"
+	"github.com/smartcontractkit/chainlink-evm/pkg/keys"
+)
+
+var (
+	promBalanceLevel = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "balance_monitor_level",
+		Help: "Balance level of each account and asset: 0 ok, 1 low, 2 critical",
+	}, []string{"account", "evmChainID", "asset"})
+	promBalanceRunway = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "balance_monitor_runway_seconds",
+		Help: "Projected time until each account runs out of an asset at its recent spend rate",
+	}, []string{"account", "evmChainID", "asset"})
+)
+
+// NativeAsset is the asset address of the native balance of the keys in BalanceEvent and Runway.
+var NativeAsset = common.Address{}
+
+var (
+	// ErrBalanceLow is wrapped by the health errors of the keys whose balance is below the low threshold.
+	ErrBalanceLow = errors.New("balance below low threshold")
+	// ErrBalanceCritical is wrapped by the health errors of the keys whose balance is below the critical threshold.
+	ErrBalanceCritical = errors.New("balance below critical threshold")
+)
+
+// runwayWindow is the period of the balance samples used to compute the spend rate of Runway.
+const runwayWindow = time.Hour
+
+// balanceEventsBufferSize is the number of events buffered for each subscriber.
+const balanceEventsBufferSize = 10
+
+// BalanceLevel is the level of a balance relative to its thresholds.
+type BalanceLevel int
+
+const (
+	BalanceLevelOK BalanceLevel = iota
+	BalanceLevelLow
+	BalanceLevelCritical
+)
+
+func (l BalanceLevel) String() string {
+	switch l {
+	case BalanceLevelOK:
+		return "ok"
+	case BalanceLevelLow:
+		return "low"
+	case BalanceLevelCritical:
+		return "critical"
+	default:
+		return fmt.Sprintf("BalanceLevel(%d)", int(l))
+	}
+}
+
+// balanceLevel returns the level of the balance, nil thresholds are ignored.
+func balanceLevel(balance, low, critical *big.Int) BalanceLevel {
+	if critical != nil && balance.Cmp(critical) < 0 {
+		return BalanceLevelCritical
+	}
+	if low != nil && balance.Cmp(low) < 0 {
+		return BalanceLevelLow
+	}
+	return BalanceLevelOK
+}
+
+// BalanceEvent is emitted when the balance of a key crosses one of its thresholds, in either direction.
+type BalanceEvent struct {
+	ChainID string
+	Address common.Address
+	// Asset is the ERC-20 token address, or NativeAsset
+	Asset  common.Address
+	Symbol string
+	// Balance is in wei for the native asset, or in the smallest token unit
+	Balance       *big.Int
+	Level         BalanceLevel
+	PreviousLevel BalanceLevel
+	DetectedAt    time.Time
+}
+
+type balanceKey struct {
+	address common.Address
+	asset   common.Address
+}
+
+type balanceSample struct {
+	at      time.Time
+	balance *big.Int
+}
+
+// balanceTracker keeps the level and recent samples of each balance, and publishes BalanceEvents to the subscribers.
+type balanceTracker struct {
+	mu      sync.RWMutex
+	levels  map[balanceKey]BalanceLevel
+	samples map[balanceKey][]balanceSample
+	subs    map[chan BalanceEvent]struct{}
+}
+
+func (t *balanceTracker) subscribe() (<-chan BalanceEvent, func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.subs == nil {
+		t.subs = make(map[chan BalanceEvent]struct{})
+	}
+	ch := make(chan BalanceEvent, balanceEventsBufferSize)
+	t.subs[ch] = struct{}{}
+	return ch, func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		if _, ok := t.subs[ch]; ok {
+			delete(t.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+// record adds a balance sample and returns the previous level, BalanceLevelOK if the balance was not seen before.
+func (t *balanceTracker) record(key balanceKey, balance *big.Int, level BalanceLevel, now time.Time) BalanceLevel {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.levels == nil {
+		t.levels = make(map[balanceKey]BalanceLevel)
+		t.samples = make(map[balanceKey][]balanceSample)
+	}
+	prev := t.levels[key]
+	t.levels[key] = level
+
+	samples := append(t.samples[key], balanceSample{at: now, balance: new(big.Int).Set(balance)})
+	var i int
+	for i < len(samples)-1 && now.Sub(samples[i].at) > runwayWindow {
+		i++
+	}
+	t.samples[key] = samples[i:]
+	return prev
+}
+
+func (t *balanceTracker) publish(e BalanceEvent) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	for ch := range t.subs {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
+
+// runway returns the time until the balance runs out at the spend rate of the samples in runwayWindow. Only decreases
+// of the balance are counted as spend, so top-ups don't extend the runway beyond the current balance.
+func (t *balanceTracker) runway(key balanceKey) (time.Duration, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	samples := t.samples[key]
+	if len(samples) < 2 {
+		return 0, false
+	}
+	spent := new(big.Int)
+	for i := 1; i < len(samples); i++ {
+		if d := new(big.Int).Sub(samples[i-1].balance, samples[i].balance); d.Sign() > 0 {
+			spent.Add(spent, d)
+		}
+	}
+	elapsed := samples[len(samples)-1].at.Sub(samples[0].at)
+	if spent.Sign() == 0 || elapsed <= 0 {
+		return 0, false
+	}
+	// runway = balance * elapsed / spent
+	r := new(big.Int).Mul(samples[len(samples)-1].balance, big.NewInt(int64(elapsed)))
+	r.Quo(r, spent)
+	if !r.IsInt64() {
+		return time.Duration(1<<63 - 1), true
+	}
+	return time.Duration(r.Int64()), true
+}
+
+// checkThresholds records the balance of the asset for the key, updates the health of the monitor and emits a
+// BalanceEvent if the balance crossed one of the thresholds. A key is unhealthy while its balance is low or critical.
+func (bm *balanceMonitor) checkThresholds(address, asset common.Address, symbol string, balance, low, critical *big.Int) {
+	key := balanceKey{address: address, asset: asset}
+	level := balanceLevel(balance, low, critical)
+	now := bm.now()
+	prev := bm.balances.record(key, balance, level, now)
+
+	promBalanceLevel.WithLabelValues(address.Hex(), bm.chainIDStr, asset.Hex()).Set(float64(level))
+	if runway, ok := bm.balances.runway(key); ok {
+		promBalanceRunway.WithLabelValues(address.Hex(), bm.chainIDStr, asset.Hex()).Set(runway.Seconds())
+	}
+
+	cond := fmt.Sprintf("%s balance of %s", symbol, address.Hex())
+	switch level {
+	case BalanceLevelCritical:
+		bm.eng.SetHealthCond(cond, fmt.Errorf("%w: %s < %s", ErrBalanceCritical, balance, critical))
+	case BalanceLevelLow:
+		bm.eng.SetHealthCond(cond, fmt.Errorf("%w: %s < %s", ErrBalanceLow, balance, low))
+	default:
+		bm.eng.ClearHealthCond(cond)
+	}
+
+	if level == prev {
+		return
+	}
+	lggr := bm.eng.With("address", address.Hex(), "asset", asset.Hex(), "symbol", symbol, "balance", balance,
+		"lowBalance", low, "criticalBalance", critical, "level", level, "previousLevel", prev)
+	switch level {
+	case BalanceLevelCritical:
+		lggr.Errorf("BalanceMonitor: %s balance of %s is critical", symbol, address.Hex())
+	case BalanceLevelLow:
+		lggr.Warnf("BalanceMonitor: %s balance of %s is low", symbol, address.Hex())
+	default:
+		lggr.Infof("BalanceMonitor: %s balance of %s is back above its thresholds", symbol, address.Hex())
+	}
+	bm.balances.publish(BalanceEvent{
+		ChainID:       bm.chainIDStr,
+		Address:       address,
+		Asset:         asset,
+		Symbol:        symbol,
+		Balance:       new(big.Int).Set(balance),
+		Level:         level,
+		PreviousLevel: prev,
+		DetectedAt:    now,
+	})
+}
+
+// SubscribeBalanceEvents returns a channel receiving the BalanceEvents emitted from now on, and a function to
+// unsubscribe. Events are dropped if the channel is full.
+func (bm *balanceMonitor) SubscribeBalanceEvents() (<-chan BalanceEvent, func()) {
+	return bm.balances.subscribe()
+}
+
+// Runway returns the projected time until the key runs out of the asset, ERC-20 token or NativeAsset, at the spend
+// rate of the last hour. It returns false if the balance was not spent, or not checked at least twice.
+func (bm *balanceMonitor) Runway(address, asset common.Address) (time.Duration, bool) {
+	return bm.balances.runway(balanceKey{address: address, asset: asset})
+}
+
+// NewLowBalancePolicy returns a keys.SelectionPolicy skipping the keys whose native balance is below their low balance
+// threshold in cfg. Keys whose balance has not been checked yet are not skipped.
+func NewLowBalancePolicy(bm BalanceMonitor, cfg config.BalanceMonitor) keys.SelectionPolicy {
+	return keys.SelectionPolicyFunc(func(_ context.Context, address common.Address) uint32 {
+		low := cfg.LowBalance(address)
+		if low == nil {
+			return 1
+		}
+		balance := bm.GetEthBalance(address)
+		if balance == nil || balance.ToInt().Cmp(low.ToInt()) >= 0 {
+			return 1
+		}
+		return 0
+	})
+}
@@ -6,10 +6,16 @@ import (
 	common "github.com/ethereum/go-ethereum/common"
 	assets "github.com/smartcontractkit/chainlink-evm/pkg/assets"
 
+	big "math/big"
+
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
 
+	monitor "github.com/smartcontractkit/chainlink-evm/pkg/monitor"
+
+	time "time"
+
 	types "github.com/smartcontractkit/chainlink-evm/pkg/types"
 )
 
@@ -119,6 +125,55 @@ func (_c *BalanceMonitor_GetEthBalance_Call) RunAndReturn(run func(common.Addres
 	return _c
 }
 
+// GetTokenBalance provides a mock function with given fields: address, token
+func (_m *BalanceMonitor) GetTokenBalance(address common.Address, token common.Address) *big.Int {
+	ret := _m.Called(address, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokenBalance")
+	}
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(common.Address, common.Address) *big.Int); ok {
+		r0 = rf(address, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// BalanceMonitor_GetTokenBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTokenBalance'
+type BalanceMonitor_GetTokenBalance_Call struct {
+	*mock.Call
+}
+
+// GetTokenBalance is a helper method to define mock.On call
+//   - address common.Address
+//   - token common.Address
+func (_e *BalanceMonitor_Expecter) GetTokenBalance(address interface{}, token interface{}) *BalanceMonitor_GetTokenBalance_Call {
+	return &BalanceMonitor_GetTokenBalance_Call{Call: _e.mock.On("GetTokenBalance", address, token)}
+}
+
+func (_c *BalanceMonitor_GetTokenBalance_Call) Run(run func(address common.Address, token common.Address)) *BalanceMonitor_GetTokenBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(common.Address), args[1].(common.Address))
+	})
+	return _c
+}
+
+func (_c *BalanceMonitor_GetTokenBalance_Call) Return(_a0 *big.Int) *BalanceMonitor_GetTokenBalance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalanceMonitor_GetTokenBalance_Call) RunAndReturn(run func(common.Address, common.Address) *big.Int) *BalanceMonitor_GetTokenBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HealthReport provides a mock function with no fields
 func (_m *BalanceMonitor) HealthReport() map[string]error {
 	ret := _m.Called()
@@ -290,6 +345,63 @@ func (_c *BalanceMonitor_Ready_Call) RunAndReturn(run func() error) *BalanceMoni
 	return _c
 }
 
+// Runway provides a mock function with given fields: address, asset
+func (_m *BalanceMonitor) Runway(address common.Address, asset common.Address) (time.Duration, bool) {
+	ret := _m.Called(address, asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Runway")
+	}
+
+	var r0 time.Duration
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(common.Address, common.Address) (time.Duration, bool)); ok {
+		return rf(address, asset)
+	}
+	if rf, ok := ret.Get(0).(func(common.Address, common.Address) time.Duration); ok {
+		r0 = rf(address, asset)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	if rf, ok := ret.Get(1).(func(common.Address, common.Address) bool); ok {
+		r1 = rf(address, asset)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// BalanceMonitor_Runway_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Runway'
+type BalanceMonitor_Runway_Call struct {
+	*mock.Call
+}
+
+// Runway is a helper method to define mock.On call
+//   - address common.Address
+//   - asset common.Address
+func (_e *BalanceMonitor_Expecter) Runway(address interface{}, asset interface{}) *BalanceMonitor_Runway_Call {
+	return &BalanceMonitor_Runway_Call{Call: _e.mock.On("Runway", address, asset)}
+}
+
+func (_c *BalanceMonitor_Runway_Call) Run(run func(address common.Address, asset common.Address)) *BalanceMonitor_Runway_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(common.Address), args[1].(common.Address))
+	})
+	return _c
+}
+
+func (_c *BalanceMonitor_Runway_Call) Return(_a0 time.Duration, _a1 bool) *BalanceMonitor_Runway_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BalanceMonitor_Runway_Call) RunAndReturn(run func(common.Address, common.Address) (time.Duration, bool)) *BalanceMonitor_Runway_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *BalanceMonitor) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -336,6 +448,65 @@ func (_c *BalanceMonitor_Start_Call) RunAndReturn(run func(context.Context) erro
 	return _c
 }
 
+// SubscribeBalanceEvents provides a mock function with no fields
+func (_m *BalanceMonitor) SubscribeBalanceEvents() (<-chan monitor.BalanceEvent, func()) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeBalanceEvents")
+	}
+
+	var r0 <-chan monitor.BalanceEvent
+	var r1 func()
+	if rf, ok := ret.Get(0).(func() (<-chan monitor.BalanceEvent, func())); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() <-chan monitor.BalanceEvent); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan monitor.BalanceEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() func()); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
+// BalanceMonitor_SubscribeBalanceEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeBalanceEvents'
+type BalanceMonitor_SubscribeBalanceEvents_Call struct {
+	*mock.Call
+}
+
+// SubscribeBalanceEvents is a helper method to define mock.On call
+func (_e *BalanceMonitor_Expecter) SubscribeBalanceEvents() *BalanceMonitor_SubscribeBalanceEvents_Call {
+	return &BalanceMonitor_SubscribeBalanceEvents_Call{Call: _e.mock.On("SubscribeBalanceEvents")}
+}
+
+func (_c *BalanceMonitor_SubscribeBalanceEvents_Call) Run(run func()) *BalanceMonitor_SubscribeBalanceEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *BalanceMonitor_SubscribeBalanceEvents_Call) Return(_a0 <-chan monitor.BalanceEvent, _a1 func()) *BalanceMonitor_SubscribeBalanceEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BalanceMonitor_SubscribeBalanceEvents_Call) RunAndReturn(run func() (<-chan monitor.BalanceEvent, func())) *BalanceMonitor_SubscribeBalanceEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewBalanceMonitor creates a new instance of BalanceMonitor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewBalanceMonitor(t interface {
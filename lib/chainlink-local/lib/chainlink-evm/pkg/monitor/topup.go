@@ -0,0 +1,460 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/config"
+	"github.com/smartcontractkit/chainlink-evm/pkg/keys"
+	evmtxmgr "github.com/smartcontractkit/chainlink-evm/pkg/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
+)
+
+var promTopUps = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "balance_monitor_top_ups",
+	Help: "Number of top-ups of the keys by status",
+}, []string{"evmChainID", "asset", "status"})
+
+const (
+	// topUpCheckInterval is the interval at which the balances of the keys are compared to the top-up floors.
+	topUpCheckInterval = time.Minute
+	// maxTopUpRecords is the number of records kept in memory by the top-up audit log, including the records restored from
+	// the top-up transactions.
+	maxTopUpRecords = 1000
+	// transferFunctionSelector is the selector of the ERC-20 transfer(address,uint256) function
+	transferFunctionSelector = "0xa9059cbb"
+)
+
+// TopUpTxManager is the subset of txmgr.TxManager used to send the top-ups.
+type TopUpTxManager interface {
+	CreateTransaction(ctx context.Context, txRequest evmtxmgr.TxRequest) (evmtxmgr.Tx, error)
+	GetTransactionStatus(ctx context.Context, transactionID string) (commontypes.TransactionStatus, error)
+}
+
+// TopUpTxStore is the subset of txmgr.EvmTxStore used to restore the top-ups sent before a restart.
+type TopUpTxStore interface {
+	FindTxesWithIdempotencyKeyPrefix(ctx context.Context, prefix string, createdAfter time.Time, chainID *big.Int) ([]*evmtxmgr.Tx, error)
+}
+
+// TopUpStatus is the outcome of a top-up recorded in the audit log.
+type TopUpStatus string
+
+const (
+	// TopUpSent is a top-up transaction created by the service.
+	TopUpSent TopUpStatus = "sent"
+	// TopUpRecovered is a top-up transaction created before the service was restarted, found by its idempotency key.
+	// The audit log is restored with the top-ups of the current and the previous periods when the service starts.
+	TopUpRecovered TopUpStatus = "recovered"
+	// TopUpFailed is a top-up transaction which could not be created, or failed on chain.
+	TopUpFailed TopUpStatus = "failed"
+	// TopUpCapped is a top-up skipped because it would exceed the spend cap of the period.
+	TopUpCapped TopUpStatus = "capped"
+)
+
+// TopUpRecord is an entry of the top-up audit log.
+type TopUpRecord struct {
+	Time     time.Time
+	Status   TopUpStatus
+	Treasury common.Address
+	Key      common.Address
+	// Asset is the ERC-20 token address, or NativeAsset
+	Asset  common.Address
+	Symbol string
+	// Balance is the balance of the key which was below Floor
+	Balance        *big.Int
+	Floor          *big.Int
+	Amount         *big.Int
+	IdempotencyKey string
+	// TxID is the id of the top-up transaction, 0 if it was not created
+	TxID  int64
+	Error string
+}
+
+// TopUpService sends funds from the treasury key to the keys whose balance falls below the configured floors.
+type TopUpService interface {
+	services.Service
+	// TopUps returns the audit log of the top-ups since the previous period before the service started, from the oldest
+	// to the newest. The records restored from the top-up transactions only have the fields saved in the transactions.
+	TopUps() []TopUpRecord
+}
+
+// topUpState is the state of the top-ups of an asset to a key.
+type topUpState struct {
+	// period is the start of the period of sent
+	period time.Time
+	// sent is the number of top-ups sent during period
+	sent int
+	// capped is set once a capped top-up is recorded during period, to record it only once
+	capped bool
+	// inFlight is the idempotency key of the last top-up until it is finalized or failed
+	inFlight       string
+	inFlightAmount *big.Int
+	inFlightPeriod time.Time
+}
+
+// topUpSpend is the amount of an asset sent by the top-ups of a period.
+type topUpSpend struct {
+	period time.Time
+	amount *big.Int
+}
+
+type topUpService struct {
+	services.Service
+	eng *services.Engine
+
+	txm         TopUpTxManager
+	txStore     TopUpTxStore
+	bm          BalanceMonitor
+	ethKeyStore keys.AddressLister
+	chainID     *big.Int
+	chainIDStr  string
+	cfg         config.BalanceMonitor
+	now         func() time.Time
+	startedAt   time.Time
+
+	checkMu  sync.Mutex
+	restored bool
+	states   map[balanceKey]*topUpState
+	spends   map[common.Address]*topUpSpend
+
+	recordsMu sync.RWMutex
+	records   []TopUpRecord
+}
+
+var _ TopUpService = (*topUpService)(nil)
+
+// NewTopUpService returns a TopUpService sending the top-ups configured by cfg.TopUp() through txm, based on the
+// balances of bm. The top-ups sent before a restart are restored from txStore. It does nothing if top-ups are disabled.
+func NewTopUpService(txm TopUpTxManager, txStore TopUpTxStore, bm BalanceMonitor, ethKeyStore keys.AddressLister, chainID *big.Int, cfg config.BalanceMonitor, lggr logger.Logger) *topUpService {
+	s := &topUpService{
+		txm:         txm,
+		txStore:     txStore,
+		bm:          bm,
+		ethKeyStore: ethKeyStore,
+		chainID:     chainID,
+		chainIDStr:  chainID.String(),
+		cfg:         cfg,
+		now:         time.Now,
+		states:      make(map[balanceKey]*topUpState),
+		spends:      make(map[common.Address]*topUpSpend),
+	}
+	s.Service, s.eng = services.Config{
+		Name:  "TopUpService",
+		Start: s.start,
+	}.NewServiceEngine(lggr)
+	return s
+}
+
+func (s *topUpService) start(context.Context) error {
+	s.startedAt = s.now()
+	if !s.cfg.TopUp().Enabled() {
+		s.eng.Info("Top-ups are disabled")
+		return nil
+	}
+	s.eng.GoTick(services.NewTicker(topUpCheckInterval), s.checkTopUps)
+	return nil
+}
+
+func (s *topUpService) TopUps() []TopUpRecord {
+	s.recordsMu.RLock()
+	defer s.recordsMu.RUnlock()
+	return append([]TopUpRecord(nil), s.records...)
+}
+
+// checkTopUps tops up the native balance and the balances of the tokens with a TopUpFloor of each enabled key, except
+// the treasury.
+func (s *topUpService) checkTopUps(ctx context.Context) {
+	s.checkMu.Lock()
+	defer s.checkMu.Unlock()
+	cfg := s.cfg.TopUp()
+	addresses, err := s.ethKeyStore.EnabledAddresses(ctx)
+	if err != nil {
+		s.eng.Errorw("TopUpService: error getting keys", "err", err)
+		return
+	}
+	period := s.now().Truncate(cfg.Period())
+	if !s.restored {
+		// Top-ups are not sent until the state of the top-ups sent before a restart is known
+		if err := s.restore(ctx, period); err != nil {
+			s.eng.Errorw("TopUpService: error restoring the top-ups sent before the restart", "err", err)
+			return
+		}
+		s.restored = true
+	}
+	for _, address := range addresses {
+		if address == cfg.Treasury() {
+			continue
+		}
+		if floor := cfg.Floor(); floor != nil {
+			s.topUp(ctx, period, address, NativeAsset, "ETH", s.bm.GetEthBalance(address).ToInt(), floor.ToInt(), cfg.Amount().ToInt(), cfg.PeriodCap().ToInt())
+		}
+		for _, token := range s.cfg.Tokens() {
+			if token.TopUpFloor == nil {
+				continue
+			}
+			s.topUp(ctx, period, address, token.Address, token.Symbol, s.bm.GetTokenBalance(address, token.Address), token.TopUpFloor, token.TopUpAmount, token.TopUpPeriodCap)
+		}
+	}
+}
+
+// topUp sends amount of the asset to the key if its balance is below floor, unless its previous top-up is not
+// finalized yet, or the top-up would exceed the spend cap of the period. periodCap may be nil.
+func (s *topUpService) topUp(ctx context.Context, period time.Time, address, asset common.Address, symbol string, balance, floor, amount, periodCap *big.Int) {
+	if balance == nil || balance.Cmp(floor) >= 0 {
+		return
+	}
+	state := s.state(balanceKey{address: address, asset: asset}, period)
+	record := TopUpRecord{
+		Treasury: s.cfg.TopUp().Treasury(),
+		Key:      address,
+		Asset:    asset,
+		Symbol:   symbol,
+		Balance:  balance,
+		Floor:    floor,
+		Amount:   amount,
+	}
+
+	if state.inFlight != "" {
+		status, err := s.txm.GetTransactionStatus(ctx, state.inFlight)
+		if err != nil && status != commontypes.Failed && status != commontypes.Fatal {
+			s.eng.Warnw("TopUpService: unable to get the status of the last top-up, waiting for it", "idempotencyKey", state.inFlight, "key", address, "asset", asset, "err", err)
+			return
+		}
+		switch status {
+		case commontypes.Finalized:
+		case commontypes.Failed, commontypes.Fatal:
+			s.spend(asset, state.inFlightPeriod).Sub(s.spend(asset, state.inFlightPeriod), state.inFlightAmount)
+			failed := record
+			failed.Amount, failed.IdempotencyKey = state.inFlightAmount, state.inFlight
+			if err != nil {
+				failed.Error = err.Error()
+			}
+			s.record(failed, TopUpFailed)
+		default:
+			// The balance may not reflect the top-up yet
+			return
+		}
+		state.inFlight, state.inFlightAmount = "", nil
+	}
+
+	spent := s.spend(asset, period)
+	if periodCap != nil && new(big.Int).Add(spent, amount).Cmp(periodCap) > 0 {
+		if !state.capped {
+			state.capped = true
+			record.Error = fmt.Sprintf("%s top-ups already sent %s of the %s cap of the period", symbol, spent, periodCap)
+			s.record(record, TopUpCapped)
+		}
+		return
+	}
+
+	// The idempotency key is derived from the key, the asset, the period and the number of top-ups of the period, so a
+	// restarted service finds the top-ups it already sent instead of sending them again.
+	record.IdempotencyKey = s.idempotencyKey(address, asset, period, state.sent)
+	txRequest := evmtxmgr.TxRequest{
+		IdempotencyKey: &record.IdempotencyKey,
+		FromAddress:    record.Treasury,
+		ToAddress:      address,
+		Value:          *amount,
+		FeeLimit:       s.cfg.TopUp().GasLimit(),
+		Strategy:       txmgr.NewSendEveryStrategy(),
+	}
+	if asset != NativeAsset {
+		txRequest.ToAddress = asset
+		txRequest.Value = big.Int{}
+		txRequest.EncodedPayload = utils.ConcatBytes(
+			evmtypes.HexToFunctionSelector(transferFunctionSelector).Bytes(),
+			common.LeftPadBytes(address.Bytes(), utils.EVMWordByteLen),
+			common.LeftPadBytes(amount.Bytes(), utils.EVMWordByteLen),
+		)
+	}
+	tx, err := s.txm.CreateTransaction(ctx, txRequest)
+	if err != nil {
+		record.Error = err.Error()
+		s.record(record, TopUpFailed)
+		return
+	}
+	state.sent++
+	state.inFlight, state.inFlightAmount, state.inFlightPeriod = record.IdempotencyKey, amount, period
+	spent.Add(spent, amount)
+	record.TxID = tx.ID
+	if tx.CreatedAt.Before(s.startedAt) {
+		s.record(record, TopUpRecovered)
+		return
+	}
+	s.record(record, TopUpSent)
+}
+
+// state returns the top-up state of the asset of the key, reset if it is from a previous period.
+func (s *topUpService) state(key balanceKey, period time.Time) *topUpState {
+	state := s.states[key]
+	if state == nil {
+		state = &topUpState{}
+		s.states[key] = state
+	}
+	if !state.period.Equal(period) {
+		state.period, state.sent, state.capped = period, 0, false
+	}
+	return state
+}
+
+// restore rebuilds the top-up states, the spends of the period and the audit log from the top-up transactions of the
+// current and the previous periods, so that restarting the service neither resets the spend caps nor sends a top-up
+// while the previous one is not finalized. Failed transactions don't count towards the caps.
+func (s *topUpService) restore(ctx context.Context, period time.Time) error {
+	txes, err := s.txStore.FindTxesWithIdempotencyKeyPrefix(ctx, s.idempotencyKeyPrefix(), period.Add(-s.cfg.TopUp().Period()), s.chainID)
+	if err != nil {
+		return err
+	}
+	for _, tx := range txes {
+		address, asset, txPeriod, n, err := s.parseIdempotencyKey(*tx.IdempotencyKey)
+		if err != nil {
+			s.eng.Warnw("TopUpService: ignoring transaction with an invalid top-up idempotency key", "txID", tx.ID, "err", err)
+			continue
+		}
+		amount := &tx.Value
+		if asset != NativeAsset && len(tx.EncodedPayload) == 4+2*utils.EVMWordByteLen {
+			amount = new(big.Int).SetBytes(tx.EncodedPayload[4+utils.EVMWordByteLen:])
+		}
+		record := TopUpRecord{
+			Time:           tx.CreatedAt,
+			Status:         TopUpRecovered,
+			Treasury:       tx.FromAddress,
+			Key:            address,
+			Asset:          asset,
+			Symbol:         s.symbol(asset),
+			Amount:         amount,
+			IdempotencyKey: *tx.IdempotencyKey,
+			TxID:           tx.ID,
+		}
+		if tx.State == txmgr.TxFatalError {
+			record.Status = TopUpFailed
+			if tx.Error.Valid {
+				record.Error = tx.Error.String
+			}
+		}
+		s.appendRecord(record)
+
+		key := balanceKey{address: address, asset: asset}
+		state := s.states[key]
+		if txPeriod.Equal(period) {
+			// the idempotency keys of the failed top-ups can't be used again either
+			state = s.state(key, period)
+			state.sent = max(state.sent, n+1)
+		} else if state == nil {
+			state = &topUpState{}
+			s.states[key] = state
+		}
+		if record.Status == TopUpFailed {
+			continue
+		}
+		if txPeriod.Equal(period) {
+			spent := s.spend(asset, period)
+			spent.Add(spent, amount)
+		}
+		// the transactions are sorted by creation, a top-up is only sent once the previous one is finalized or failed
+		state.inFlight, state.inFlightAmount, state.inFlightPeriod = *tx.IdempotencyKey, amount, txPeriod
+	}
+	if len(txes) > 0 {
+		s.eng.Infow("TopUpService: restored the top-ups sent before the restart", "count", len(txes))
+	}
+	return nil
+}
+
+// symbol returns the configured symbol of the asset.
+func (s *topUpService) symbol(asset common.Address) string {
+	if asset == NativeAsset {
+		return "ETH"
+	}
+	for _, token := range s.cfg.Tokens() {
+		if token.Address == asset {
+			return token.Symbol
+		}
+	}
+	return ""
+}
+
+// idempotencyKeyPrefix is the prefix of the idempotency keys of the top-ups of the chain.
+func (s *topUpService) idempotencyKeyPrefix() string {
+	return fmt.Sprintf("balance-topup-%s-", s.chainIDStr)
+}
+
+// idempotencyKey returns the idempotency key of the n-th top-up of the asset to the key during the period.
+func (s *topUpService) idempotencyKey(address, asset common.Address, period time.Time, n int) string {
+	return fmt.Sprintf("%s%s-%s-%d-%d", s.idempotencyKeyPrefix(), address.Hex(), asset.Hex(), period.Unix(), n)
+}
+
+// parseIdempotencyKey is the inverse of idempotencyKey.
+func (s *topUpService) parseIdempotencyKey(idempotencyKey string) (address, asset common.Address, period time.Time, n int, err error) {
+	parts := strings.Split(strings.TrimPrefix(idempotencyKey, s.idempotencyKeyPrefix()), "-")
+	if len(parts) != 4 || !common.IsHexAddress(parts[0]) || !common.IsHexAddress(parts[1]) {
+		err = fmt.Errorf("invalid top-up idempotency key %s", idempotencyKey)
+		return
+	}
+	unix, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		err = fmt.Errorf("invalid period of top-up idempotency key %s: %w", idempotencyKey, err)
+		return
+	}
+	if n, err = strconv.Atoi(parts[3]); err != nil {
+		err = fmt.Errorf("invalid number of top-up idempotency key %s: %w", idempotencyKey, err)
+		return
+	}
+	return common.HexToAddress(parts[0]), common.HexToAddress(parts[1]), time.Unix(unix, 0), n, nil
+}
+
+// spend returns the amount of the asset sent during the period. The amounts of the previous periods are dropped.
+func (s *topUpService) spend(asset common.Address, period time.Time) *big.Int {
+	spend := s.spends[asset]
+	if spend == nil || spend.period.Before(period) {
+		spend = &topUpSpend{period: period, amount: new(big.Int)}
+		s.spends[asset] = spend
+	} else if spend.period.After(period) {
+		// A top-up of a previous period failed, it doesn't count towards the current period
+		return new(big.Int)
+	}
+	return spend.amount
+}
+
+// record adds the top-up to the audit log.
+func (s *topUpService) record(r TopUpRecord, status TopUpStatus) {
+	r.Time, r.Status = s.now(), status
+	promTopUps.WithLabelValues(s.chainIDStr, r.Asset.Hex(), string(status)).Inc()
+
+	lggr := logger.With(logger.Named(s.eng, "TopUpAudit"), "status", status, "treasury", r.Treasury, "key", r.Key,
+		"asset", r.Asset, "symbol", r.Symbol, "balance", r.Balance, "floor", r.Floor, "amount", r.Amount,
+		"idempotencyKey", r.IdempotencyKey, "txID", r.TxID)
+	switch status {
+	case TopUpFailed:
+		lggr.Errorw(fmt.Sprintf("Failed to top up %s balance of %s", r.Symbol, r.Key.Hex()), "err", r.Error)
+	case TopUpCapped:
+		lggr.Warnw(fmt.Sprintf("Skipped top-up of %s balance of %s", r.Symbol, r.Key.Hex()), "err", r.Error)
+	default:
+		lggr.Infof("Topped up %s balance of %s", r.Symbol, r.Key.Hex())
+	}
+	s.appendRecord(r)
+}
+
+// appendRecord adds r to the audit log, dropping the oldest records beyond maxTopUpRecords.
+func (s *topUpService) appendRecord(r TopUpRecord) {
+	s.recordsMu.Lock()
+	defer s.recordsMu.Unlock()
+	s.records = append(s.records, r)
+	if len(s.records) > maxTopUpRecords {
+		s.records = s.records[len(s.records)-maxTopUpRecords:]
+	}
+}
@@ -0,0 +1,337 @@
+package monitor_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	txmgrcommon "github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink-evm/pkg/config"
+	"github.com/smartcontractkit/chainlink-evm/pkg/keys/keystest"
+	"github.com/smartcontractkit/chainlink-evm/pkg/monitor"
+	"github.com/smartcontractkit/chainlink-evm/pkg/monitor/mocks"
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr"
+)
+
+type topUpConfig struct {
+	enabled                  bool
+	treasury                 common.Address
+	floor, amount, periodCap *assets.Wei
+}
+
+func (c *topUpConfig) Enabled() bool            { return c.enabled }
+func (c *topUpConfig) Treasury() common.Address { return c.treasury }
+func (c *topUpConfig) Floor() *assets.Wei       { return c.floor }
+func (c *topUpConfig) Amount() *assets.Wei      { return c.amount }
+func (c *topUpConfig) PeriodCap() *assets.Wei   { return c.periodCap }
+func (c *topUpConfig) Period() time.Duration    { return 24 * time.Hour }
+func (c *topUpConfig) GasLimit() uint64         { return 100_000 }
+
+// topUpTxManager creates a tx per idempotency key, like the TXM.
+type topUpTxManager struct {
+	mu       sync.Mutex
+	txs      map[string]txmgr.Tx
+	requests []txmgr.TxRequest
+	statuses map[string]commontypes.TransactionStatus
+}
+
+func newTopUpTxManager() *topUpTxManager {
+	return &topUpTxManager{txs: map[string]txmgr.Tx{}, statuses: map[string]commontypes.TransactionStatus{}}
+}
+
+func (m *topUpTxManager) CreateTransaction(_ context.Context, txRequest txmgr.TxRequest) (txmgr.Tx, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if tx, ok := m.txs[*txRequest.IdempotencyKey]; ok {
+		return tx, nil
+	}
+	m.requests = append(m.requests, txRequest)
+	tx := txmgr.Tx{
+		ID:             int64(len(m.txs) + 1),
+		CreatedAt:      time.Now(),
+		IdempotencyKey: txRequest.IdempotencyKey,
+		FromAddress:    txRequest.FromAddress,
+		Value:          txRequest.Value,
+		EncodedPayload: txRequest.EncodedPayload,
+	}
+	m.txs[*txRequest.IdempotencyKey] = tx
+	m.statuses[*txRequest.IdempotencyKey] = commontypes.Pending
+	return tx, nil
+}
+
+func (m *topUpTxManager) GetTransactionStatus(_ context.Context, transactionID string) (commontypes.TransactionStatus, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	status, ok := m.statuses[transactionID]
+	if !ok {
+		return commontypes.Unknown, errors.New("not found")
+	}
+	if status == commontypes.Failed {
+		return status, errors.New("reverted")
+	}
+	return status, nil
+}
+
+func (m *topUpTxManager) FindTxesWithIdempotencyKeyPrefix(_ context.Context, prefix string, createdAfter time.Time, _ *big.Int) ([]*txmgr.Tx, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var txes []*txmgr.Tx
+	for key, tx := range m.txs {
+		if !strings.HasPrefix(key, prefix) || tx.CreatedAt.Before(createdAfter) {
+			continue
+		}
+		if m.statuses[key] == commontypes.Fatal {
+			tx.State = txmgrcommon.TxFatalError
+		}
+		txes = append(txes, &tx)
+	}
+	slices.SortFunc(txes, func(a, b *txmgr.Tx) int { return int(a.ID - b.ID) })
+	return txes, nil
+}
+
+func (m *topUpTxManager) setStatus(idempotencyKey string, status commontypes.TransactionStatus) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.statuses[idempotencyKey] = status
+}
+
+func (m *topUpTxManager) sent() []txmgr.TxRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]txmgr.TxRequest(nil), m.requests...)
+}
+
+func TestTopUpService(t *testing.T) {
+	t.Parallel()
+
+	treasury := testutils.NewAddress()
+	k0Addr := testutils.NewAddress()
+	linkAddr := testutils.NewAddress()
+	newConfig := func() *balanceMonitorConfig {
+		return &balanceMonitorConfig{
+			topUp: topUpConfig{
+				enabled:   true,
+				treasury:  treasury,
+				floor:     assets.NewWeiI(100),
+				amount:    assets.NewWeiI(500),
+				periodCap: assets.NewWeiI(1000),
+			},
+		}
+	}
+
+	t.Run("tops up native balances below the floor up to the period cap", func(t *testing.T) {
+		bm := mocks.NewBalanceMonitor(t)
+		bm.EXPECT().GetEthBalance(k0Addr).Return(assets.NewEth(10))
+		txm := newTopUpTxManager()
+		s := monitor.NewTopUpService(txm, txm, bm, keystest.Addresses{treasury, k0Addr}, testutils.FixtureChainID, newConfig(), logger.Test(t))
+		servicetest.Run(t, s)
+		ctx := tests.Context(t)
+
+		s.CheckTopUps(ctx)
+		sent := txm.sent()
+		require.Len(t, sent, 1)
+		assert.Equal(t, treasury, sent[0].FromAddress)
+		assert.Equal(t, k0Addr, sent[0].ToAddress)
+		assert.Equal(t, big.NewInt(500), &sent[0].Value)
+		assert.Empty(t, sent[0].EncodedPayload)
+		assert.Equal(t, uint64(100_000), sent[0].FeeLimit)
+
+		// Not topped up again until the top-up is finalized
+		s.CheckTopUps(ctx)
+		txm.setStatus(*sent[0].IdempotencyKey, commontypes.Unconfirmed)
+		s.CheckTopUps(ctx)
+		require.Len(t, txm.sent(), 1)
+
+		txm.setStatus(*sent[0].IdempotencyKey, commontypes.Finalized)
+		s.CheckTopUps(ctx)
+		sent = txm.sent()
+		require.Len(t, sent, 2)
+		assert.NotEqual(t, *sent[0].IdempotencyKey, *sent[1].IdempotencyKey)
+
+		// A third top-up would exceed the cap
+		txm.setStatus(*sent[1].IdempotencyKey, commontypes.Finalized)
+		s.CheckTopUps(ctx)
+		s.CheckTopUps(ctx)
+		require.Len(t, txm.sent(), 2)
+
+		records := s.TopUps()
+		require.Len(t, records, 3)
+		assert.Equal(t, monitor.TopUpSent, records[0].Status)
+		assert.Equal(t, monitor.NativeAsset, records[0].Asset)
+		assert.Equal(t, big.NewInt(10), records[0].Balance)
+		assert.Equal(t, int64(1), records[0].TxID)
+		assert.Equal(t, monitor.TopUpSent, records[1].Status)
+		assert.Equal(t, monitor.TopUpCapped, records[2].Status)
+	})
+
+	t.Run("failed top-ups don't count towards the cap", func(t *testing.T) {
+		bm := mocks.NewBalanceMonitor(t)
+		bm.EXPECT().GetEthBalance(k0Addr).Return(assets.NewEth(10))
+		txm := newTopUpTxManager()
+		cfg := newConfig()
+		cfg.topUp.periodCap = assets.NewWeiI(500)
+		s := monitor.NewTopUpService(txm, txm, bm, keystest.Addresses{treasury, k0Addr}, testutils.FixtureChainID, cfg, logger.Test(t))
+		servicetest.Run(t, s)
+		ctx := tests.Context(t)
+
+		s.CheckTopUps(ctx)
+		sent := txm.sent()
+		require.Len(t, sent, 1)
+		txm.setStatus(*sent[0].IdempotencyKey, commontypes.Failed)
+
+		s.CheckTopUps(ctx)
+		require.Len(t, txm.sent(), 2)
+		records := s.TopUps()
+		require.Len(t, records, 3)
+		assert.Equal(t, monitor.TopUpFailed, records[1].Status)
+		assert.Equal(t, "reverted", records[1].Error)
+		assert.Equal(t, monitor.TopUpSent, records[2].Status)
+	})
+
+	t.Run("tops up tokens with a transfer", func(t *testing.T) {
+		bm := mocks.NewBalanceMonitor(t)
+		bm.EXPECT().GetTokenBalance(k0Addr, linkAddr).Return(big.NewInt(1))
+		txm := newTopUpTxManager()
+		cfg := newConfig()
+		cfg.topUp.floor = nil
+		cfg.tokens = []config.BalanceMonitorToken{{Address: linkAddr, Symbol: "LINK", TopUpFloor: big.NewInt(5), TopUpAmount: big.NewInt(0x42)}}
+		s := monitor.NewTopUpService(txm, txm, bm, keystest.Addresses{treasury, k0Addr}, testutils.FixtureChainID, cfg, logger.Test(t))
+		servicetest.Run(t, s)
+
+		s.CheckTopUps(tests.Context(t))
+		sent := txm.sent()
+		require.Len(t, sent, 1)
+		assert.Equal(t, treasury, sent[0].FromAddress)
+		assert.Equal(t, linkAddr, sent[0].ToAddress)
+		assert.Zero(t, sent[0].Value.Sign())
+		require.Len(t, sent[0].EncodedPayload, 4+32+32)
+		assert.Equal(t, []byte{0xa9, 0x05, 0x9c, 0xbb}, sent[0].EncodedPayload[:4])
+		assert.Equal(t, common.LeftPadBytes(k0Addr.Bytes(), 32), sent[0].EncodedPayload[4:36])
+		assert.Equal(t, common.LeftPadBytes([]byte{0x42}, 32), sent[0].EncodedPayload[36:])
+	})
+
+	t.Run("recovers the top-ups sent before a restart", func(t *testing.T) {
+		bm := mocks.NewBalanceMonitor(t)
+		bm.EXPECT().GetEthBalance(k0Addr).Return(assets.NewEth(10))
+		txm := newTopUpTxManager()
+		cfg := newConfig()
+		now := time.Now()
+
+		s := monitor.NewTopUpService(txm, txm, bm, keystest.Addresses{treasury, k0Addr}, testutils.FixtureChainID, cfg, logger.Test(t))
+		s.SetNow(func() time.Time { return now })
+		servicetest.Run(t, s)
+		s.CheckTopUps(tests.Context(t))
+		require.Len(t, txm.sent(), 1)
+
+		restarted := monitor.NewTopUpService(txm, txm, bm, keystest.Addresses{treasury, k0Addr}, testutils.FixtureChainID, cfg, logger.Test(t))
+		restarted.SetNow(func() time.Time { return now.Add(time.Second) })
+		servicetest.Run(t, restarted)
+		restarted.CheckTopUps(tests.Context(t))
+		require.Len(t, txm.sent(), 1, "the top-up must not be sent twice")
+		records := restarted.TopUps()
+		require.Len(t, records, 1)
+		assert.Equal(t, monitor.TopUpRecovered, records[0].Status)
+	})
+
+	t.Run("restores the sent top-ups and the spend of the period after a restart", func(t *testing.T) {
+		bm := mocks.NewBalanceMonitor(t)
+		bm.EXPECT().GetEthBalance(k0Addr).Return(assets.NewEth(10))
+		txm := newTopUpTxManager()
+		cfg := newConfig()
+		now := time.Now()
+
+		s := monitor.NewTopUpService(txm, txm, bm, keystest.Addresses{treasury, k0Addr}, testutils.FixtureChainID, cfg, logger.Test(t))
+		s.SetNow(func() time.Time { return now })
+		servicetest.Run(t, s)
+		s.CheckTopUps(tests.Context(t))
+		sent := txm.sent()
+		require.Len(t, sent, 1)
+		txm.setStatus(*sent[0].IdempotencyKey, commontypes.Finalized)
+
+		restarted := monitor.NewTopUpService(txm, txm, bm, keystest.Addresses{treasury, k0Addr}, testutils.FixtureChainID, cfg, logger.Test(t))
+		servicetest.Run(t, restarted)
+		records := restarted.TopUps()
+		require.Empty(t, records, "the top-ups are restored by the first check")
+
+		// the next top-up of the period is sent, and the cap still accounts for the first one
+		restarted.CheckTopUps(tests.Context(t))
+		sent = txm.sent()
+		require.Len(t, sent, 2)
+		assert.NotEqual(t, *sent[0].IdempotencyKey, *sent[1].IdempotencyKey)
+		txm.setStatus(*sent[1].IdempotencyKey, commontypes.Finalized)
+		restarted.CheckTopUps(tests.Context(t))
+		require.Len(t, txm.sent(), 2)
+
+		records = restarted.TopUps()
+		require.Len(t, records, 3)
+		assert.Equal(t, monitor.TopUpRecovered, records[0].Status)
+		assert.Equal(t, *sent[0].IdempotencyKey, records[0].IdempotencyKey)
+		assert.Equal(t, treasury, records[0].Treasury)
+		assert.Equal(t, k0Addr, records[0].Key)
+		assert.Equal(t, "ETH", records[0].Symbol)
+		assert.Equal(t, big.NewInt(500), records[0].Amount)
+		assert.Equal(t, int64(1), records[0].TxID)
+		assert.Equal(t, monitor.TopUpSent, records[1].Status)
+		assert.Equal(t, monitor.TopUpCapped, records[2].Status)
+	})
+
+	t.Run("restores failed token top-ups without counting them towards the cap", func(t *testing.T) {
+		bm := mocks.NewBalanceMonitor(t)
+		bm.EXPECT().GetTokenBalance(k0Addr, linkAddr).Return(big.NewInt(1))
+		txm := newTopUpTxManager()
+		cfg := newConfig()
+		cfg.topUp.floor = nil
+		cfg.tokens = []config.BalanceMonitorToken{{Address: linkAddr, Symbol: "LINK", TopUpFloor: big.NewInt(5), TopUpAmount: big.NewInt(0x42), TopUpPeriodCap: big.NewInt(0x42)}}
+		now := time.Now()
+
+		s := monitor.NewTopUpService(txm, txm, bm, keystest.Addresses{treasury, k0Addr}, testutils.FixtureChainID, cfg, logger.Test(t))
+		s.SetNow(func() time.Time { return now })
+		servicetest.Run(t, s)
+		s.CheckTopUps(tests.Context(t))
+		sent := txm.sent()
+		require.Len(t, sent, 1)
+		txm.setStatus(*sent[0].IdempotencyKey, commontypes.Fatal)
+
+		restarted := monitor.NewTopUpService(txm, txm, bm, keystest.Addresses{treasury, k0Addr}, testutils.FixtureChainID, cfg, logger.Test(t))
+		servicetest.Run(t, restarted)
+		restarted.CheckTopUps(tests.Context(t))
+		sent = txm.sent()
+		require.Len(t, sent, 2)
+		records := restarted.TopUps()
+		require.Len(t, records, 2)
+		assert.Equal(t, monitor.TopUpFailed, records[0].Status)
+		assert.Equal(t, "LINK", records[0].Symbol)
+		assert.Equal(t, big.NewInt(0x42), records[0].Amount)
+		assert.Equal(t, monitor.TopUpSent, records[1].Status)
+		assert.Equal(t, *sent[1].IdempotencyKey, records[1].IdempotencyKey)
+	})
+
+	t.Run("does nothing if disabled", func(t *testing.T) {
+		bm := mocks.NewBalanceMonitor(t)
+		txm := newTopUpTxManager()
+		cfg := newConfig()
+		cfg.topUp.enabled = false
+		s := monitor.NewTopUpService(txm, txm, bm, keystest.Addresses{treasury, k0Addr}, testutils.FixtureChainID, cfg, logger.Test(t))
+		servicetest.Run(t, s)
+		require.NoError(t, s.Ready())
+		assert.Empty(t, txm.sent())
+		bm.AssertNotCalled(t, "GetEthBalance", mock.Anything)
+	})
+}
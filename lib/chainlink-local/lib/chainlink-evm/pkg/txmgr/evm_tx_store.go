@@ -49,6 +49,7 @@ type EvmTxStore interface {
 	FindConfirmedTxesReceipts(ctx context.Context, finalizedBlockNum int64, chainID *big.Int) (receipts []*types.Receipt, err error)
 	FindTxesPendingCallback(ctx context.Context, latest, finalized int64, chainID *big.Int) (receiptsPlus []ReceiptPlus, err error)
 	FindTxesByIDs(ctx context.Context, etxIDs []int64, chainID *big.Int) (etxs []*Tx, err error)
+	FindTxesWithIdempotencyKeyPrefix(ctx context.Context, prefix string, createdAfter time.Time, chainID *big.Int) (etxs []*Tx, err error)
 	SaveFetchedReceipts(ctx context.Context, r []*types.Receipt) (err error)
 	UpdateTxStatesToFinalizedUsingTxHashes(ctx context.Context, txHashes []common.Hash, chainID *big.Int) error
 }
@@ -2073,6 +2074,22 @@ func (o *evmTxStore) UpdateTxFatalError(ctx context.Context, etxIDs []int64, err
 	return err
 }
 
+// FindTxesWithIdempotencyKeyPrefix returns the txes created after createdAfter whose idempotency key starts with prefix,
+// from the oldest to the newest, without their attempts.
+func (o *evmTxStore) FindTxesWithIdempotencyKeyPrefix(ctx context.Context, prefix string, createdAfter time.Time, chainID *big.Int) (etxs []*Tx, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	var dbEtxs []DbEthTx
+	sql := `SELECT * FROM evm.txes WHERE starts_with(idempotency_key, $1) AND created_at >= $2 AND evm_chain_id = $3 ORDER BY created_at ASC, id ASC`
+	if err = o.q.SelectContext(ctx, &dbEtxs, sql, prefix, createdAfter, chainID.String()); err != nil {
+		return nil, fmt.Errorf("failed to find evm.txes with idempotency key prefix %s: %w", prefix, err)
+	}
+	etxs = make([]*Tx, len(dbEtxs))
+	dbEthTxsToEvmEthTxPtrs(dbEtxs, etxs)
+	return
+}
+
 func (o *evmTxStore) FindTxesByIDs(ctx context.Context, etxIDs []int64, chainID *big.Int) (etxs []*Tx, err error) {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
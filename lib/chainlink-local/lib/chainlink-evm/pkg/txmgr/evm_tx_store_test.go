@@ -669,6 +669,35 @@ func Test_FindTxWithIdempotencyKey(t *testing.T) {
 	})
 }
 
+func Test_FindTxesWithIdempotencyKeyPrefix(t *testing.T) {
+	t.Parallel()
+	db := testutils.NewSqlxDB(t)
+	txStore := txmgrtest.NewTestTxStore(t, db)
+	fromAddress := testutils.NewAddress()
+	ctx := tests.Context(t)
+	chainID := big.NewInt(0)
+	start := time.Now().Add(-time.Minute)
+
+	etx1 := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, chainID, txRequestWithIdempotencyKey("prefix-test-1"))
+	etx2 := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, chainID, txRequestWithIdempotencyKey("prefix-test-2"))
+	mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, chainID, txRequestWithIdempotencyKey("other-prefix-test-3"))
+	mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, chainID)
+
+	etxs, err := txStore.FindTxesWithIdempotencyKeyPrefix(ctx, "prefix-test-", start, chainID)
+	require.NoError(t, err)
+	require.Len(t, etxs, 2)
+	assert.Equal(t, etx1.ID, etxs[0].ID)
+	assert.Equal(t, etx2.ID, etxs[1].ID)
+
+	etxs, err = txStore.FindTxesWithIdempotencyKeyPrefix(ctx, "prefix-test-", time.Now().Add(time.Minute), chainID)
+	require.NoError(t, err)
+	assert.Empty(t, etxs)
+
+	etxs, err = txStore.FindTxesWithIdempotencyKeyPrefix(ctx, "prefix-test-", start, big.NewInt(1))
+	require.NoError(t, err)
+	assert.Empty(t, etxs)
+}
+
 func Test_FindReceiptWithIdempotencyKey(t *testing.T) {
 	t.Parallel()
 
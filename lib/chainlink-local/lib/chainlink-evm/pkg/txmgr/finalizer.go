@@ -19,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox"
 
+	"github.com/smartcontractkit/chainlink-evm/pkg/heads"
 	"github.com/smartcontractkit/chainlink-evm/pkg/types"
 	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
 	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
@@ -57,6 +58,8 @@ type finalizerChainClient interface {
 	CallContract(ctx context.Context, a TxAttempt, blockNumber *big.Int) (rpcErr fmt.Stringer, extractErr error)
 }
 
+// finalizerHeadTracker may also implement heads.FinalityViolationDetector, in which case no transaction is marked as
+// finalized once a finality violation was detected, until it is acknowledged.
 type finalizerHeadTracker interface {
 	LatestAndFinalizedBlock(ctx context.Context) (latest, finalized *types.Head, err error)
 }
@@ -214,6 +217,12 @@ func (f *evmFinalizer) processFinalizedHead(ctx context.Context, latestFinalized
 	if latestFinalizedHead == nil || !latestFinalizedHead.IsValid() {
 		return fmt.Errorf("invalid latestFinalizedHead")
 	}
+	// Finalized heads cannot be trusted once a finalized block was reorged
+	if detector, ok := f.headTracker.(heads.FinalityViolationDetector); ok {
+		if violation := detector.FinalityViolation(); violation != nil {
+			return fmt.Errorf("not marking transactions as finalized: %w", *violation)
+		}
+	}
 	// Only continue processing if the latestFinalizedHead has not already been processed
 	// Helps avoid unnecessary processing on every head if blocks are finalized in batches
 	if latestFinalizedHead.BlockNumber() == f.lastProcessedFinalizedBlockNum {
@@ -19,6 +19,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr/txmgrtest"
 
@@ -28,6 +29,7 @@ import (
 	"github.com/smartcontractkit/chainlink-evm/pkg/client"
 	"github.com/smartcontractkit/chainlink-evm/pkg/client/clienttest"
 	"github.com/smartcontractkit/chainlink-evm/pkg/config/configtest"
+	"github.com/smartcontractkit/chainlink-evm/pkg/heads"
 	"github.com/smartcontractkit/chainlink-evm/pkg/heads/headstest"
 	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
 	"github.com/smartcontractkit/chainlink-evm/pkg/types"
@@ -153,6 +155,40 @@ func TestFinalizer_MarkTxFinalized(t *testing.T) {
 		require.Equal(t, txmgrcommon.TxFinalized, tx.State)
 	})
 
+	t.Run("returns not finalized for tx with receipt in a finalized block after a finality violation", func(t *testing.T) {
+		violatedHT := &finalityViolationHeadTracker{
+			finalizerHeadTracker: ht,
+			violation:            &heads.FinalityViolation{BlockNumber: 98, FinalizedHash: utils.NewHash(), CanonicalHash: utils.NewHash()},
+		}
+		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, rpcBatchSize, false, txStore, txmClient, violatedHT, metrics)
+		servicetest.Run(t, finalizer)
+
+		idempotencyKey := uuid.New().String()
+		fromAddress := testutils.NewAddress()
+		nonce := types.Nonce(0)
+		broadcast := time.Now()
+		tx := &txmgr.Tx{
+			Sequence:           &nonce,
+			IdempotencyKey:     &idempotencyKey,
+			FromAddress:        fromAddress,
+			EncodedPayload:     []byte{1, 2, 3},
+			FeeLimit:           feeLimit,
+			State:              txmgrcommon.TxConfirmed,
+			BroadcastAt:        &broadcast,
+			InitialBroadcastAt: &broadcast,
+		}
+		attemptHash := insertTxAndAttemptWithIdempotencyKey(t, txStore, tx, idempotencyKey)
+		// Insert receipt for finalized block num
+		mustInsertEthReceipt(t, txStore, head.Parent.Load().Number, head.Parent.Load().Hash, attemptHash)
+		ethClient.On("HeadByNumber", mock.Anything, mock.Anything).Return(head, nil).Once()
+		ethClient.On("LatestFinalizedBlock", mock.Anything).Return(head.Parent.Load(), nil).Once()
+		err := finalizer.ProcessHead(ctx, head)
+		require.ErrorIs(t, err, commontypes.ErrFinalityViolated)
+		tx, err = txStore.FindTxWithIdempotencyKey(ctx, idempotencyKey, testutils.FixtureChainID)
+		require.NoError(t, err)
+		require.Equal(t, txmgrcommon.TxConfirmed, tx.State)
+	})
+
 	t.Run("returns finalized for tx with receipt older than block history depth", func(t *testing.T) {
 		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, rpcBatchSize, false, txStore, txmClient, ht, metrics)
 		servicetest.Run(t, finalizer)
@@ -253,6 +289,32 @@ func insertTxAndAttemptWithIdempotencyKey(tb testing.TB, txStore txmgr.TestEvmTx
 	return attempt.Hash
 }
 
+type finalizerHeadTracker interface {
+	LatestAndFinalizedBlock(ctx context.Context) (latest, finalized *types.Head, err error)
+}
+
+// finalityViolationHeadTracker is a head tracker which detected a finality violation.
+type finalityViolationHeadTracker struct {
+	finalizerHeadTracker
+	violation *heads.FinalityViolation
+}
+
+func (f *finalityViolationHeadTracker) FinalityViolation() *heads.FinalityViolation {
+	return f.violation
+}
+
+func (f *finalityViolationHeadTracker) SubscribeFinalityViolations() (<-chan heads.FinalityViolation, func()) {
+	return make(chan heads.FinalityViolation), func() {}
+}
+
+func (f *finalityViolationHeadTracker) AcknowledgeFinalityViolation(blockNumber int64) bool {
+	if f.violation == nil || f.violation.BlockNumber != blockNumber {
+		return false
+	}
+	f.violation = nil
+	return true
+}
+
 func TestFinalizer_ResumePendingRuns(t *testing.T) {
 	t.Parallel()
 	ctx := t.Context()
@@ -0,0 +1,50 @@
+package txmgr
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/config"
+	"github.com/smartcontractkit/chainlink-evm/pkg/keys"
+)
+
+type unconfirmedCounter interface {
+	CountUnconfirmedTransactions(ctx context.Context, fromAddress common.Address, chainID *big.Int) (count uint32, err error)
+}
+
+// NewKeySelectionPolicy returns the keys.SelectionPolicy configured by cfg, to be passed to keys.WithSelectionPolicy.
+// Keys are weighted by their priority, and skipped if they have too many unconfirmed transactions or, if
+// SkipLowBalance is enabled, if they are skipped by lowBalance, e.g. monitor.NewLowBalancePolicy.
+func NewKeySelectionPolicy(cfg config.KeySelection, txStore unconfirmedCounter, chainID *big.Int, lowBalance keys.SelectionPolicy, lggr logger.Logger) keys.SelectionPolicy {
+	policies := []keys.SelectionPolicy{keys.NewPriorityPolicy(cfg.Priority)}
+	if cfg.MaxUnconfirmed() > 0 {
+		policies = append(policies, NewUnconfirmedPolicy(txStore, chainID, cfg.MaxUnconfirmed(), lggr))
+	}
+	if cfg.SkipLowBalance() {
+		policies = append(policies, lowBalance)
+	}
+	return keys.CombinePolicies(policies...)
+}
+
+// NewUnconfirmedPolicy returns a keys.SelectionPolicy skipping the keys with at least maxUnconfirmed unconfirmed
+// transactions. Keys are not skipped if their transactions can't be counted.
+func NewUnconfirmedPolicy(txStore unconfirmedCounter, chainID *big.Int, maxUnconfirmed uint32, lggr logger.Logger) keys.SelectionPolicy {
+	lggr = logger.Named(lggr, "UnconfirmedPolicy")
+	return keys.SelectionPolicyFunc(func(ctx context.Context, address common.Address) uint32 {
+		count, err := txStore.CountUnconfirmedTransactions(ctx, address, chainID)
+		if err != nil {
+			lggr.Warnw("Failed to count unconfirmed transactions, not skipping key", "address", address, "err", err)
+			return 1
+		}
+		if count >= maxUnconfirmed {
+			lggr.Debugw("Skipping key with too many unconfirmed transactions", "address", address,
+				"unconfirmed", count, "maxUnconfirmed", maxUnconfirmed)
+			return 0
+		}
+		return 1
+	})
+}
@@ -0,0 +1,70 @@
+package txmgr_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/keys"
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr"
+)
+
+type unconfirmedCounts map[common.Address]uint32
+
+func (c unconfirmedCounts) CountUnconfirmedTransactions(_ context.Context, fromAddress common.Address, _ *big.Int) (uint32, error) {
+	count, ok := c[fromAddress]
+	if !ok {
+		return 0, errors.New("not found")
+	}
+	return count, nil
+}
+
+type keySelectionConfig struct {
+	skipLowBalance bool
+	maxUnconfirmed uint32
+	priorities     map[common.Address]uint32
+}
+
+func (c *keySelectionConfig) SkipLowBalance() bool   { return c.skipLowBalance }
+func (c *keySelectionConfig) MaxUnconfirmed() uint32 { return c.maxUnconfirmed }
+func (c *keySelectionConfig) Priority(key common.Address) uint32 {
+	if p, ok := c.priorities[key]; ok {
+		return p
+	}
+	return 1
+}
+
+func TestNewKeySelectionPolicy(t *testing.T) {
+	t.Parallel()
+
+	k0, k1, k2 := testutils.NewAddress(), testutils.NewAddress(), testutils.NewAddress()
+	counts := unconfirmedCounts{k0: 1, k1: 5}
+	lowBalance := keys.SelectionPolicyFunc(func(_ context.Context, a common.Address) uint32 {
+		if a == k0 {
+			return 0
+		}
+		return 1
+	})
+	cfg := &keySelectionConfig{priorities: map[common.Address]uint32{k0: 2, k1: 3}}
+	ctx := tests.Context(t)
+
+	weights := func() []uint32 {
+		p := txmgr.NewKeySelectionPolicy(cfg, counts, testutils.FixtureChainID, lowBalance, logger.Test(t))
+		return []uint32{p.Weight(ctx, k0), p.Weight(ctx, k1), p.Weight(ctx, k2)}
+	}
+	assert.Equal(t, []uint32{2, 3, 1}, weights())
+
+	cfg.maxUnconfirmed = 5
+	assert.Equal(t, []uint32{2, 0, 1}, weights(), "keys are not skipped if their transactions can't be counted")
+
+	cfg.skipLowBalance = true
+	assert.Equal(t, []uint32{0, 0, 1}, weights())
+}
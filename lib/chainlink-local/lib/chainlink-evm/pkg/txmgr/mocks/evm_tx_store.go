@@ -1691,6 +1691,67 @@ func (_c *EvmTxStore_FindTxesWithAttemptsAndReceiptsByIdsAndState_Call) RunAndRe
 	return _c
 }
 
+// FindTxesWithIdempotencyKeyPrefix provides a mock function with given fields: ctx, prefix, createdAfter, chainID
+func (_m *EvmTxStore) FindTxesWithIdempotencyKeyPrefix(ctx context.Context, prefix string, createdAfter time.Time, chainID *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, pkgtypes.Nonce, gas.EvmFee], error) {
+	ret := _m.Called(ctx, prefix, createdAfter, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTxesWithIdempotencyKeyPrefix")
+	}
+
+	var r0 []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, pkgtypes.Nonce, gas.EvmFee]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, pkgtypes.Nonce, gas.EvmFee], error)); ok {
+		return rf(ctx, prefix, createdAfter, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, *big.Int) []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, pkgtypes.Nonce, gas.EvmFee]); ok {
+		r0 = rf(ctx, prefix, createdAfter, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, pkgtypes.Nonce, gas.EvmFee])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, *big.Int) error); ok {
+		r1 = rf(ctx, prefix, createdAfter, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindTxesWithIdempotencyKeyPrefix_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTxesWithIdempotencyKeyPrefix'
+type EvmTxStore_FindTxesWithIdempotencyKeyPrefix_Call struct {
+	*mock.Call
+}
+
+// FindTxesWithIdempotencyKeyPrefix is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix string
+//   - createdAfter time.Time
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) FindTxesWithIdempotencyKeyPrefix(ctx interface{}, prefix interface{}, createdAfter interface{}, chainID interface{}) *EvmTxStore_FindTxesWithIdempotencyKeyPrefix_Call {
+	return &EvmTxStore_FindTxesWithIdempotencyKeyPrefix_Call{Call: _e.mock.On("FindTxesWithIdempotencyKeyPrefix", ctx, prefix, createdAfter, chainID)}
+}
+
+func (_c *EvmTxStore_FindTxesWithIdempotencyKeyPrefix_Call) Run(run func(ctx context.Context, prefix string, createdAfter time.Time, chainID *big.Int)) *EvmTxStore_FindTxesWithIdempotencyKeyPrefix_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindTxesWithIdempotencyKeyPrefix_Call) Return(etxs []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, pkgtypes.Nonce, gas.EvmFee], err error) *EvmTxStore_FindTxesWithIdempotencyKeyPrefix_Call {
+	_c.Call.Return(etxs, err)
+	return _c
+}
+
+func (_c *EvmTxStore_FindTxesWithIdempotencyKeyPrefix_Call) RunAndReturn(run func(context.Context, string, time.Time, *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, pkgtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_FindTxesWithIdempotencyKeyPrefix_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindTxesWithMetaFieldByReceiptBlockNum provides a mock function with given fields: ctx, metaField, blockNum, chainID
 func (_m *EvmTxStore) FindTxesWithMetaFieldByReceiptBlockNum(ctx context.Context, metaField string, blockNum int64, chainID *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, pkgtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, metaField, blockNum, chainID)
@@ -0,0 +1,114 @@
+package txmgr
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evmclient "github.com/smartcontractkit/chainlink-evm/pkg/client"
+)
+
+var (
+	// revertSelector is the selector of Error(string), see abi.UnpackRevert
+	revertSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+	// panicSelector is the selector of Panic(uint256), see abi.UnpackRevert
+	panicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+)
+
+// RevertDecoder decodes revert data into readable errors, like `ReceiverError(0x...)`, with the custom errors of the
+// registered ABIs, e.g. the ABIs of gethwrappers and gobindings. Error(string) and Panic(uint256) are always decoded.
+type RevertDecoder struct {
+	mu     sync.RWMutex
+	errors map[[4]byte]abi.Error
+}
+
+// NewRevertDecoder returns a new RevertDecoder of the custom errors of abis.
+func NewRevertDecoder(abis ...*abi.ABI) *RevertDecoder {
+	d := &RevertDecoder{errors: make(map[[4]byte]abi.Error)}
+	for _, a := range abis {
+		d.Register(a)
+	}
+	return d
+}
+
+// Register registers the custom errors of a. Errors with the same selector as an already registered error are ignored.
+func (d *RevertDecoder) Register(a *abi.ABI) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, e := range a.Errors {
+		if _, ok := d.errors[[4]byte(e.ID[:4])]; !ok {
+			d.errors[[4]byte(e.ID[:4])] = e
+		}
+	}
+}
+
+// Decode returns the error encoded in the revert data, and false if the data is not a known error.
+func (d *RevertDecoder) Decode(data []byte) (string, bool) {
+	if len(data) < 4 {
+		return "", false
+	}
+	if bytes.Equal(data[:4], revertSelector) || bytes.Equal(data[:4], panicSelector) {
+		reason, err := abi.UnpackRevert(data)
+		if err != nil {
+			return "", false
+		}
+		if bytes.Equal(data[:4], panicSelector) {
+			return fmt.Sprintf("Panic(%s)", reason), true
+		}
+		return fmt.Sprintf("Error(%q)", reason), true
+	}
+
+	d.mu.RLock()
+	e, ok := d.errors[[4]byte(data[:4])]
+	d.mu.RUnlock()
+	if !ok {
+		return "", false
+	}
+	values, err := e.Inputs.Unpack(data[4:])
+	if err != nil {
+		return "", false
+	}
+	args := make([]string, len(values))
+	for i, v := range values {
+		args[i] = formatErrorArg(v)
+	}
+	return fmt.Sprintf("%s(%s)", e.Name, strings.Join(args, ", ")), true
+}
+
+// DecodeRPCError returns the error encoded in the revert data of the RPC error, see evmclient.ExtractRPCError.
+func (d *RevertDecoder) DecodeRPCError(jErr *evmclient.JsonError) (string, bool) {
+	s, ok := jErr.Data.(string)
+	if !ok {
+		return "", false
+	}
+	// some RPCs prefix the data, e.g. parity: "Reverted 0xABC123..."
+	s = strings.TrimPrefix(s, "Reverted ")
+	data, err := hexutil.Decode(s)
+	if err != nil {
+		return "", false
+	}
+	return d.Decode(data)
+}
+
+func formatErrorArg(v any) string {
+	switch t := v.(type) {
+	case []byte:
+		return hexutil.Encode(t)
+	case string:
+		return fmt.Sprintf("%q", t)
+	case fmt.Stringer:
+		return t.String()
+	}
+	// fixed size byte arrays, e.g. bytes32
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Array && rv.Type().Elem().Kind() == reflect.Uint8 {
+		b := make([]byte, rv.Len())
+		reflect.Copy(reflect.ValueOf(b), rv)
+		return hexutil.Encode(b)
+	}
+	return fmt.Sprintf("%v", v)
+}
@@ -0,0 +1,76 @@
+package txmgr_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	evmclient "github.com/smartcontractkit/chainlink-evm/pkg/client"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr"
+)
+
+func TestRevertDecoder(t *testing.T) {
+	t.Parallel()
+
+	offRampABI, err := abi.JSON(strings.NewReader(`[
+		{"type":"error","name":"InsufficientLiquidity","inputs":[{"name":"token","type":"address"},{"name":"amount","type":"uint256"}]},
+		{"type":"error","name":"InvalidMessageID","inputs":[{"name":"messageID","type":"bytes32"}]},
+		{"type":"error","name":"Unauthorized","inputs":[]}
+	]`))
+	require.NoError(t, err)
+	d := txmgr.NewRevertDecoder(&offRampABI)
+
+	encode := func(name string, args ...any) []byte {
+		e := offRampABI.Errors[name]
+		b, err := e.Inputs.Pack(args...)
+		require.NoError(t, err)
+		return append(e.ID[:4:4], b...)
+	}
+
+	token := common.HexToAddress("0xff0Aac13eab788cb9a2D662D3FB661Aa5f58FA21")
+	for _, tt := range []struct {
+		name string
+		data []byte
+		exp  string
+	}{
+		{"custom error", encode("InsufficientLiquidity", token, big.NewInt(42)), "InsufficientLiquidity(0xff0Aac13eab788cb9a2D662D3FB661Aa5f58FA21, 42)"},
+		{"fixed bytes", encode("InvalidMessageID", [32]byte{0xab}), "InvalidMessageID(0xab00000000000000000000000000000000000000000000000000000000000000)"},
+		{"no arguments", encode("Unauthorized"), "Unauthorized()"},
+		{"error string", append([]byte{0x08, 0xc3, 0x79, 0xa0}, mustPack(t, "string", "not enough")...), `Error("not enough")`},
+		{"panic", append([]byte{0x4e, 0x48, 0x7b, 0x71}, mustPack(t, "uint256", big.NewInt(0x11))...), "Panic(arithmetic underflow or overflow)"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, ok := d.Decode(tt.data)
+			require.True(t, ok)
+			assert.Equal(t, tt.exp, reason)
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		_, ok := d.Decode([]byte{1, 2, 3, 4})
+		assert.False(t, ok)
+		_, ok = d.Decode([]byte{1})
+		assert.False(t, ok)
+	})
+
+	t.Run("rpc error", func(t *testing.T) {
+		reason, ok := d.DecodeRPCError(&evmclient.JsonError{Code: -32015, Data: "Reverted 0x" + common.Bytes2Hex(encode("Unauthorized"))})
+		require.True(t, ok)
+		assert.Equal(t, "Unauthorized()", reason)
+		_, ok = d.DecodeRPCError(&evmclient.JsonError{Code: 3, Data: []byte{1}})
+		assert.False(t, ok)
+	})
+}
+
+func mustPack(t *testing.T, typ string, v any) []byte {
+	abiType, err := abi.NewType(typ, "", nil)
+	require.NoError(t, err)
+	b, err := abi.Arguments{{Type: abiType}}.Pack(v)
+	require.NoError(t, err)
+	return b
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 	pkgerrors "github.com/pkg/errors"
 
@@ -43,13 +44,16 @@ var (
 // CheckerFactory is a real implementation of TransmitCheckerFactory.
 type CheckerFactory struct {
 	Client evmclient.Client
+	// StateOverrides and RevertDecoder are passed to the SimulateChecker, and are optional.
+	StateOverrides StateOverridesFunc
+	RevertDecoder  *RevertDecoder
 }
 
 // BuildChecker satisfies the TransmitCheckerFactory interface.
 func (c *CheckerFactory) BuildChecker(spec TransmitCheckerSpec) (TransmitChecker, error) {
 	switch spec.CheckerType {
 	case TransmitCheckerTypeSimulate:
-		return &SimulateChecker{c.Client}, nil
+		return &SimulateChecker{Client: c.Client, StateOverrides: c.StateOverrides, RevertDecoder: c.RevertDecoder}, nil
 	case TransmitCheckerTypeVRFV1:
 		if spec.VRFCoordinatorAddress == nil {
 			return nil, pkgerrors.Errorf("malformed checker, expected non-nil VRFCoordinatorAddress, got: %v", spec)
@@ -116,9 +120,18 @@ func (noChecker) Check(
 	return nil
 }
 
+// StateOverridesFunc returns the state overrides of the simulation of tx, e.g. to pretend that a pool has enough
+// liquidity, or nil to simulate tx against the latest state.
+type StateOverridesFunc func(ctx context.Context, tx Tx) (map[common.Address]gethclient.OverrideAccount, error)
+
 // SimulateChecker simulates transactions, producing an error if they revert on chain.
 type SimulateChecker struct {
 	Client evmclient.Client
+	// StateOverrides is optional.
+	StateOverrides StateOverridesFunc
+	// RevertDecoder decodes the revert data of the transactions reverting during simulation, so that the decoded
+	// error is recorded on the transaction. Optional.
+	RevertDecoder *RevertDecoder
 }
 
 // Check satisfies the TransmitChecker interface.
@@ -142,11 +155,28 @@ func (s *SimulateChecker) Check(
 		"value":                (*hexutil.Big)(&tx.Value),
 		"data":                 hexutil.Bytes(tx.EncodedPayload),
 	}
-	var b hexutil.Bytes
 	// always run simulation on "latest" block
-	err := s.Client.CallContext(ctx, &b, "eth_call", callArg, evmclient.ToBlockNumArg(nil))
+	args := []interface{}{callArg, evmclient.ToBlockNumArg(nil)}
+	if s.StateOverrides != nil {
+		overrides, err := s.StateOverrides(ctx, tx)
+		if err != nil {
+			l.Warnw("Failed to get simulation state overrides, will simulate without",
+				"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err)
+		} else if len(overrides) > 0 {
+			args = append(args, overrides)
+		}
+	}
+	var b hexutil.Bytes
+	err := s.Client.CallContext(ctx, &b, "eth_call", args...)
 	if err != nil {
 		if jErr := evmclient.ExtractRPCErrorOrNil(err); jErr != nil {
+			if s.RevertDecoder != nil {
+				if reason, ok := s.RevertDecoder.DecodeRPCError(jErr); ok {
+					l.Criticalw("Transaction reverted during simulation",
+						"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "rpcErr", jErr.String(), "revertReason", reason)
+					return pkgerrors.Errorf("transaction reverted during simulation with %s: %s", reason, jErr.String())
+				}
+			}
 			l.Criticalw("Transaction reverted during simulation",
 				"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "rpcErr", jErr.String(), "returnValue", b.String())
 			return pkgerrors.Errorf("transaction reverted during simulation: %s", jErr.String())
@@ -5,12 +5,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
@@ -170,6 +174,41 @@ func TestTransmitCheckers(t *testing.T) {
 			// to be passed to the caller
 			require.NoError(t, checker.Check(ctx, log, tx, attempt))
 		})
+
+		t.Run("state overrides", func(t *testing.T) {
+			pool := testutils.NewAddress()
+			overrides := map[common.Address]gethclient.OverrideAccount{pool: {Balance: big.NewInt(1e18)}}
+			checker := txmgr.SimulateChecker{Client: client, StateOverrides: func(_ context.Context, otx txmgr.Tx) (map[common.Address]gethclient.OverrideAccount, error) {
+				require.Equal(t, tx.ToAddress, otx.ToAddress)
+				return overrides, nil
+			}}
+			client.On("CallContext", mock.Anything,
+				mock.AnythingOfType("*hexutil.Bytes"), "eth_call",
+				mock.Anything, "latest", overrides).Return(nil).Once()
+
+			require.NoError(t, checker.Check(ctx, log, tx, attempt))
+		})
+
+		t.Run("decoded revert", func(t *testing.T) {
+			receiverABI, err := abi.JSON(strings.NewReader(`[{"type":"error","name":"ReceiverError","inputs":[{"name":"err","type":"bytes"}]}]`))
+			require.NoError(t, err)
+			receiverError := receiverABI.Errors["ReceiverError"]
+			args, err := receiverError.Inputs.Pack([]byte{0xde, 0xad})
+			require.NoError(t, err)
+			checker := txmgr.SimulateChecker{Client: client, RevertDecoder: txmgr.NewRevertDecoder(&receiverABI)}
+
+			jerr := evmclient.JsonError{
+				Code:    3,
+				Message: "execution reverted",
+				Data:    hexutil.Encode(append(receiverError.ID[:4:4], args...)),
+			}
+			client.On("CallContext", mock.Anything,
+				mock.AnythingOfType("*hexutil.Bytes"), "eth_call",
+				mock.Anything, "latest").Return(&jerr).Once()
+
+			err = checker.Check(ctx, log, tx, attempt)
+			require.ErrorContains(t, err, "transaction reverted during simulation with ReceiverError(0xdead): json-rpc error")
+		})
 	})
 
 	t.Run("VRF V1", func(t *testing.T) {